@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	defaultArchivalCodec        = "av1"
+	defaultArchivalMinAgeDays   = 365
+	defaultArchivalOffPeakStart = 1
+	defaultArchivalOffPeakEnd   = 5
+	defaultArchivalMaxJobs      = 4
+	archivalTimeout             = time.Hour * 24 // pending archivals are requested again after this, workers don't archive a VoD twice
+)
+
+// archivalSettings is the archival configuration with defaults for unset values
+type archivalSettings struct {
+	codec        string
+	crf          uint32
+	minAgeDays   int
+	offPeakStart int
+	offPeakEnd   int
+	maxJobs      int
+}
+
+// archivalConfig returns the archival settings and whether archival is enabled
+func archivalConfig() (settings archivalSettings, enabled bool) {
+	settings = archivalSettings{
+		codec:        defaultArchivalCodec,
+		minAgeDays:   defaultArchivalMinAgeDays,
+		offPeakStart: defaultArchivalOffPeakStart,
+		offPeakEnd:   defaultArchivalOffPeakEnd,
+		maxJobs:      defaultArchivalMaxJobs,
+	}
+	if tools.Cfg.Archival == nil {
+		return settings, false
+	}
+	if tools.Cfg.Archival.Codec == "av1" || tools.Cfg.Archival.Codec == "hevc" {
+		settings.codec = tools.Cfg.Archival.Codec
+	}
+	settings.crf = tools.Cfg.Archival.CRF
+	if tools.Cfg.Archival.MinAgeDays > 0 {
+		settings.minAgeDays = tools.Cfg.Archival.MinAgeDays
+	}
+	if tools.Cfg.Archival.OffPeakStart != tools.Cfg.Archival.OffPeakEnd {
+		settings.offPeakStart, settings.offPeakEnd = tools.Cfg.Archival.OffPeakStart, tools.Cfg.Archival.OffPeakEnd
+	}
+	if tools.Cfg.Archival.MaxJobs > 0 {
+		settings.maxJobs = tools.Cfg.Archival.MaxJobs
+	}
+	return settings, tools.Cfg.Archival.Enabled
+}
+
+// isOffPeak returns whether hour is in the off-peak window from start until end, which may span midnight
+func isOffPeak(hour int, start int, end int) bool {
+	if start <= end {
+		return hour >= start && hour < end
+	}
+	return hour >= start || hour < end
+}
+
+// needsArchivalFallback returns whether an H.264 fallback of the VoD is kept for players without support for the
+// archival codec. Only unpublished VoDs are kept in the archival codec exclusively.
+func needsArchivalFallback(stream model.Stream, course model.Course) bool {
+	return course.VODEnabled && !stream.Private
+}
+
+// ArchiveVoDs assigns VoDs of courses that archive them after their retention period and, if archival is enabled,
+// VoDs of old streams to idle workers for archival during off-peak hours
+func ArchiveVoDs(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		settings, enabled := archivalConfig()
+		archiveVoDs(daoWrapper, settings, enabled, time.Now(), requestArchival)
+	}
+}
+
+// archivalRequester asks a worker to archive a VoD
+type archivalRequester func(worker model.Worker, request *pb.ArchivalRequest) error
+
+func archiveVoDs(daoWrapper dao.DaoWrapper, settings archivalSettings, enabled bool, now time.Time, request archivalRequester) {
+	if !isOffPeak(now.Hour(), settings.offPeakStart, settings.offPeakEnd) {
+		return
+	}
+	if err := daoWrapper.VodArchiveDao.DeletePendingBefore(now.Add(-archivalTimeout)); err != nil {
+		log.WithError(err).Error("Can't delete stale archivals")
+	}
+	idle := idleWorkers(daoWrapper.WorkerDao.GetAliveWorkers())
+	if len(idle) > settings.maxJobs {
+		idle = idle[:settings.maxJobs]
+	}
+	if len(idle) == 0 {
+		return
+	}
+	// retention policies are binding, their archivals go first
+	idle = archiveRetainedCourses(daoWrapper, now, idle, settings, request)
+	if !enabled || len(idle) == 0 {
+		return
+	}
+	files, err := daoWrapper.VodArchiveDao.GetArchivableFiles(now.AddDate(0, 0, -settings.minAgeDays), len(idle))
+	if err != nil {
+		log.WithError(err).Error("Can't get archivable VoDs")
+		return
+	}
+	for i, file := range files {
+		stream, err := daoWrapper.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", file.StreamID))
+		if err != nil {
+			log.WithError(err).WithField("file", file.ID).Warn("Can't get stream of VoD to archive")
+			continue
+		}
+		course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+		if err != nil {
+			log.WithError(err).WithField("file", file.ID).Warn("Can't get course of VoD to archive")
+			continue
+		}
+		if err = requestFileArchival(daoWrapper, idle[i], file, stream, course, settings, request); err != nil {
+			log.WithError(err).WithField("file", file.ID).Warn("Can't request archival")
+		}
+	}
+}
+
+// idleWorkers returns the alive workers without jobs that aren't draining. Archival runs for hours, only workers
+// without other jobs get one.
+func idleWorkers(workers []model.Worker) (idle []model.Worker) {
+	for _, worker := range workers {
+		if worker.Workload == 0 && !worker.Draining {
+			idle = append(idle, worker)
+		}
+	}
+	return idle
+}
+
+// requestFileArchival stores a pending archival of the VoD file and assigns it to worker
+func requestFileArchival(daoWrapper dao.DaoWrapper, worker model.Worker, file model.File, stream model.Stream, course model.Course, settings archivalSettings, request archivalRequester) error {
+	archive := model.VodArchive{
+		FileID:   file.ID,
+		StreamID: stream.ID,
+		Codec:    settings.codec,
+		WorkerID: worker.WorkerID,
+		State:    model.VodArchivePending,
+	}
+	if err := daoWrapper.VodArchiveDao.CreateArchive(&archive); err != nil {
+		return fmt.Errorf("create archival: %w", err)
+	}
+	err := request(worker, &pb.ArchivalRequest{
+		WorkerID: worker.WorkerID,
+		FileID:   uint32(file.ID),
+		StreamID: uint32(stream.ID),
+		Path:     file.Path,
+		Codec:    settings.codec,
+		CRF:      settings.crf,
+		Fallback: needsArchivalFallback(stream, course),
+	})
+	if err != nil {
+		// archived again in the next run
+		if err := daoWrapper.VodArchiveDao.DeleteArchive(archive.ID); err != nil {
+			log.WithError(err).Error("Can't delete archival")
+		}
+		return err
+	}
+	return nil
+}
+
+func requestArchival(worker model.Worker, request *pb.ArchivalRequest) error {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return fmt.Errorf("dial worker: %w", err)
+	}
+	defer endConnection(conn)
+	resp, err := pb.NewToWorkerClient(conn).RequestArchival(context.Background(), request)
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected archival")
+	}
+	return nil
+}
+
+// NotifyArchivalFinished stores the result of the archival of a VoD
+func (s server) NotifyArchivalFinished(ctx context.Context, request *pb.ArchivalFinished) (*pb.Status, error) {
+	if _, err := s.DaoWrapper.WorkerDao.GetWorkerByID(ctx, request.GetWorkerID()); err != nil {
+		return nil, errors.New("authentication failed: invalid worker id")
+	}
+	if err := handleArchivalFinished(s.DaoWrapper, request); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+func handleArchivalFinished(daoWrapper dao.DaoWrapper, request *pb.ArchivalFinished) error {
+	archive, err := daoWrapper.VodArchiveDao.GetArchiveByFileID(uint(request.GetFileID()))
+	if err != nil {
+		return fmt.Errorf("get archival: %w", err)
+	}
+	if request.GetError() != "" && archive.State == model.VodArchiveDone {
+		// a worker that was asked again after the archival timed out found the archive of the first one
+		return nil
+	}
+	archive.OriginalSize = request.GetOriginalSize()
+	if request.GetError() != "" {
+		archive.State, archive.Error = model.VodArchiveFailed, request.GetError()
+		return daoWrapper.VodArchiveDao.SaveArchive(&archive)
+	}
+	archive.State, archive.Error = model.VodArchiveDone, ""
+	archive.ArchivePath = request.GetArchivePath()
+	archive.ArchiveSize, archive.FallbackSize = request.GetArchiveSize(), request.GetFallbackSize()
+	if archive.FallbackSize == 0 {
+		// the original was removed, the archive is the VoD file now
+		file, err := daoWrapper.FileDao.GetFileById(fmt.Sprintf("%d", request.GetFileID()))
+		if err != nil {
+			return fmt.Errorf("get file: %w", err)
+		}
+		file.Path = archive.ArchivePath
+		if err = daoWrapper.FileDao.UpdateFile(fmt.Sprintf("%d", file.ID), &file); err != nil {
+			return fmt.Errorf("update file: %w", err)
+		}
+	}
+	return daoWrapper.VodArchiveDao.SaveArchive(&archive)
+}
@@ -0,0 +1,234 @@
+package api
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	"gorm.io/gorm"
+	"testing"
+	"time"
+)
+
+func TestIsOffPeak(t *testing.T) {
+	tests := []struct {
+		hour, start, end int
+		want             bool
+	}{
+		{2, 1, 5, true},
+		{5, 1, 5, false},
+		{0, 1, 5, false},
+		{23, 22, 4, true},
+		{3, 22, 4, true},
+		{12, 22, 4, false},
+	}
+	for _, test := range tests {
+		if got := isOffPeak(test.hour, test.start, test.end); got != test.want {
+			t.Errorf("isOffPeak(%d, %d, %d) = %v, want %v", test.hour, test.start, test.end, got, test.want)
+		}
+	}
+}
+
+func TestArchiveVoDs(t *testing.T) {
+	settings := archivalSettings{codec: "av1", minAgeDays: 365, offPeakStart: 1, offPeakEnd: 5, maxJobs: 4}
+	offPeak := time.Date(2026, 3, 10, 2, 0, 0, 0, time.Local)
+
+	t.Run("not off-peak", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		daoWrapper := dao.DaoWrapper{VodArchiveDao: mock_dao.NewMockVodArchiveDao(ctrl), WorkerDao: mock_dao.NewMockWorkerDao(ctrl)}
+		archiveVoDs(daoWrapper, settings, true, time.Date(2026, 3, 10, 14, 0, 0, 0, time.Local), func(model.Worker, *pb.ArchivalRequest) error {
+			t.Errorf("archival requested outside of off-peak hours")
+			return nil
+		})
+	})
+
+	t.Run("idle workers only", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+
+		archiveMock.EXPECT().DeletePendingBefore(offPeak.Add(-archivalTimeout)).Return(nil)
+		workerMock.EXPECT().GetAliveWorkers().Return([]model.Worker{
+			{WorkerID: "busy", Workload: 3},
+			{WorkerID: "draining", Draining: true},
+			{WorkerID: "idle1"},
+			{WorkerID: "idle2"},
+		})
+		retentionMock.EXPECT().GetRetainedCourses().Return(nil, nil)
+		archiveMock.EXPECT().GetArchivableFiles(offPeak.AddDate(0, 0, -365), 2).Return([]model.File{
+			{Model: gorm.Model{ID: 10}, StreamID: 1, Path: "/mass/a.mp4"},
+			{Model: gorm.Model{ID: 11}, StreamID: 2, Path: "/mass/b.mp4"},
+		}, nil)
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(model.Stream{Model: gorm.Model{ID: 1}, CourseID: 5}, nil)
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "2").Return(model.Stream{Model: gorm.Model{ID: 2}, CourseID: 5, Private: true}, nil)
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), uint(5)).Return(model.Course{VODEnabled: true}, nil).Times(2)
+		archiveMock.EXPECT().CreateArchive(gomock.Any()).DoAndReturn(func(a *model.VodArchive) error {
+			a.ID = a.FileID + 100
+			return nil
+		}).Times(2)
+		// the second worker can't be reached, its archival is deleted to be requested again
+		archiveMock.EXPECT().DeleteArchive(uint(111)).Return(nil)
+
+		daoWrapper := dao.DaoWrapper{VodArchiveDao: archiveMock, WorkerDao: workerMock, RetentionDao: retentionMock, StreamsDao: streamsMock, CoursesDao: coursesMock}
+		var requested []*pb.ArchivalRequest
+		archiveVoDs(daoWrapper, settings, true, offPeak, func(w model.Worker, req *pb.ArchivalRequest) error {
+			if w.WorkerID != req.WorkerID {
+				t.Errorf("request for %s sent to %s", req.WorkerID, w.WorkerID)
+			}
+			requested = append(requested, req)
+			if w.WorkerID == "idle2" {
+				return errors.New("unreachable")
+			}
+			return nil
+		})
+		if len(requested) != 2 {
+			t.Fatalf("requested %d archivals, want 2", len(requested))
+		}
+		if requested[0].WorkerID != "idle1" || requested[0].Path != "/mass/a.mp4" || !requested[0].Fallback {
+			t.Errorf("published VoD must keep a fallback: %v", requested[0])
+		}
+		if requested[1].Fallback {
+			t.Errorf("private VoD must not keep a fallback: %v", requested[1])
+		}
+	})
+	t.Run("retention policy first", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+
+		vod := model.File{Model: gorm.Model{ID: 10}, StreamID: 1, Path: "/mass/eidi/a.mp4", Type: model.FILETYPE_VOD}
+		archived := model.File{Model: gorm.Model{ID: 12}, StreamID: 1, Path: "/mass/eidi/b.mp4", Type: model.FILETYPE_VOD}
+		thumb := model.File{Model: gorm.Model{ID: 11}, StreamID: 1, Path: "/mass/eidi/a.jpg", Type: model.FILETYPE_THUMB_COMB}
+		course := model.Course{Model: gorm.Model{ID: 5}, Year: 2024, TeachingTerm: "W", RetentionPolicy: model.RetentionArchive, RetentionPeriod: 0}
+		course.Streams = []model.Stream{{Model: gorm.Model{ID: 1}, CourseID: 5, Files: []model.File{archived, thumb, vod}}}
+		deleting := model.Course{Model: gorm.Model{ID: 6}, RetentionPolicy: model.RetentionDelete}
+		deleting.Streams = []model.Stream{{Model: gorm.Model{ID: 2}, CourseID: 6, Files: []model.File{{Model: gorm.Model{ID: 13}, StreamID: 2, Type: model.FILETYPE_VOD}}}}
+
+		archiveMock.EXPECT().DeletePendingBefore(offPeak.Add(-archivalTimeout)).Return(nil)
+		workerMock.EXPECT().GetAliveWorkers().Return([]model.Worker{{WorkerID: "busy", Workload: 3}, {WorkerID: "idle"}})
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{deleting, course}, nil)
+		archiveMock.EXPECT().GetArchiveByFileID(uint(12)).Return(model.VodArchive{State: model.VodArchiveDone}, nil)
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{}, gorm.ErrRecordNotFound)
+		archiveMock.EXPECT().CreateArchive(gomock.Any()).Return(nil)
+
+		// the only idle worker archives the VoD of the course, no old VoDs are archived
+		daoWrapper := dao.DaoWrapper{VodArchiveDao: archiveMock, WorkerDao: workerMock, RetentionDao: retentionMock}
+		var requested []*pb.ArchivalRequest
+		archiveVoDs(daoWrapper, settings, true, offPeak, func(w model.Worker, req *pb.ArchivalRequest) error {
+			requested = append(requested, req)
+			return nil
+		})
+		if len(requested) != 1 || requested[0].WorkerID != "idle" || requested[0].Path != vod.Path {
+			t.Errorf("VoD should be archived by the idle worker, got %v", requested)
+		}
+	})
+
+	t.Run("retention policy without archival", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+
+		vod := model.File{Model: gorm.Model{ID: 10}, StreamID: 1, Path: "/mass/eidi/a.mp4", Type: model.FILETYPE_VOD}
+		course := model.Course{Model: gorm.Model{ID: 5}, Year: 2024, TeachingTerm: "W", RetentionPolicy: model.RetentionArchive, RetentionPeriod: 0}
+		course.Streams = []model.Stream{{Model: gorm.Model{ID: 1}, CourseID: 5, Files: []model.File{vod}}}
+
+		// the window excludes the time retention is enforced at, VoDs are archived in the window nonetheless
+		window := archivalSettings{codec: "av1", minAgeDays: 365, offPeakStart: 22, offPeakEnd: 2, maxJobs: 4}
+		night := time.Date(2026, 3, 10, 23, 0, 0, 0, time.Local)
+		archiveMock.EXPECT().DeletePendingBefore(night.Add(-archivalTimeout)).Return(nil)
+		workerMock.EXPECT().GetAliveWorkers().Return([]model.Worker{{WorkerID: "idle1"}, {WorkerID: "idle2"}})
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{course}, nil)
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{}, gorm.ErrRecordNotFound)
+		archiveMock.EXPECT().CreateArchive(gomock.Any()).Return(nil)
+
+		// archival of old VoDs is disabled, the remaining idle worker gets none
+		daoWrapper := dao.DaoWrapper{VodArchiveDao: archiveMock, WorkerDao: workerMock, RetentionDao: retentionMock}
+		var requested []*pb.ArchivalRequest
+		request := func(w model.Worker, req *pb.ArchivalRequest) error {
+			requested = append(requested, req)
+			return nil
+		}
+		archiveVoDs(daoWrapper, window, false, night, request)
+		if len(requested) != 1 || requested[0].WorkerID != "idle1" || requested[0].Path != vod.Path {
+			t.Errorf("VoD should be archived in the off-peak window, got %v", requested)
+		}
+		// not at the time retention is enforced
+		archiveVoDs(daoWrapper, window, false, time.Date(2026, 3, 11, 3, 30, 0, 0, time.Local), request)
+		if len(requested) != 1 {
+			t.Errorf("archival requested outside of off-peak hours: %v", requested)
+		}
+	})
+}
+
+func TestHandleArchivalFinished(t *testing.T) {
+	t.Run("failed", func(t *testing.T) {
+		archiveMock := mock_dao.NewMockVodArchiveDao(gomock.NewController(t))
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{FileID: 10, State: model.VodArchivePending}, nil)
+		archiveMock.EXPECT().SaveArchive(gomock.Any()).DoAndReturn(func(a *model.VodArchive) error {
+			if a.State != model.VodArchiveFailed || a.Error != "archive is not smaller than the original" {
+				t.Errorf("archival not marked as failed: %+v", a)
+			}
+			return nil
+		})
+		err := handleArchivalFinished(dao.DaoWrapper{VodArchiveDao: archiveMock},
+			&pb.ArchivalFinished{FileID: 10, OriginalSize: 100, Error: "archive is not smaller than the original"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("failure after done", func(t *testing.T) {
+		// the worker asked again after the timeout found the archive of the first one, which reported meanwhile
+		archiveMock := mock_dao.NewMockVodArchiveDao(gomock.NewController(t))
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{FileID: 10, State: model.VodArchiveDone}, nil)
+		err := handleArchivalFinished(dao.DaoWrapper{VodArchiveDao: archiveMock},
+			&pb.ArchivalFinished{FileID: 10, Error: "archive already exists"})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("original removed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		fileMock := mock_dao.NewMockFileDao(ctrl)
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{FileID: 10, State: model.VodArchivePending}, nil)
+		fileMock.EXPECT().GetFileById("10").Return(model.File{Model: gorm.Model{ID: 10}, Path: "/mass/a.mp4"}, nil)
+		fileMock.EXPECT().UpdateFile("10", &model.File{Model: gorm.Model{ID: 10}, Path: "/mass/archive/a.mp4"}).Return(nil)
+		archiveMock.EXPECT().SaveArchive(gomock.Any()).DoAndReturn(func(a *model.VodArchive) error {
+			if a.State != model.VodArchiveDone || a.Savings() != 60 {
+				t.Errorf("archival not stored: %+v", a)
+			}
+			return nil
+		})
+		err := handleArchivalFinished(dao.DaoWrapper{VodArchiveDao: archiveMock, FileDao: fileMock},
+			&pb.ArchivalFinished{FileID: 10, ArchivePath: "/mass/archive/a.mp4", OriginalSize: 100, ArchiveSize: 40})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+
+	t.Run("fallback kept", func(t *testing.T) {
+		// the file still points to the fallback
+		archiveMock := mock_dao.NewMockVodArchiveDao(gomock.NewController(t))
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{FileID: 10, State: model.VodArchivePending}, nil)
+		archiveMock.EXPECT().SaveArchive(gomock.Any()).DoAndReturn(func(a *model.VodArchive) error {
+			if a.Savings() != 30 {
+				t.Errorf("savings = %d, want 30", a.Savings())
+			}
+			return nil
+		})
+		err := handleArchivalFinished(dao.DaoWrapper{VodArchiveDao: archiveMock},
+			&pb.ArchivalFinished{FileID: 10, ArchivePath: "/mass/archive/a.mp4", OriginalSize: 100, ArchiveSize: 40, FallbackSize: 30})
+		if err != nil {
+			t.Error(err)
+		}
+	})
+}
@@ -0,0 +1,219 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type presetSwitchRequest struct {
+	PresetID  int  `json:"presetID"`
+	Offset    uint `json:"offset"`    // minutes after the start of the lecture
+	Weekday   *int `json:"weekday"`   // 0 (sunday) to 6 (saturday), nil for switches by offset
+	TimeOfDay uint `json:"timeOfDay"` // minutes after midnight, only used with weekday
+}
+
+// getPresetSwitches returns the scheduled preset switches of the lecture series of a stream
+func (r coursesRoutes) getPresetSwitches(c *gin.Context) {
+	stream, ok := seriesStreamFromContext(c)
+	if !ok {
+		return
+	}
+	switches, err := r.CameraPresetDao.GetPresetSwitches(stream.SeriesIdentifier)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get preset switches",
+			Err:           err,
+		})
+		return
+	}
+	res := make([]gin.H, len(switches))
+	for i, s := range switches {
+		res[i] = s.Json()
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// createPresetSwitch schedules a preset switch for the lecture series of a stream
+func (r coursesRoutes) createPresetSwitch(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream, ok := seriesStreamFromContext(c)
+	if !ok {
+		return
+	}
+	var req presetSwitchRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	presetSwitch := model.CameraPresetSwitch{
+		SeriesIdentifier: stream.SeriesIdentifier,
+		LectureHallID:    stream.LectureHallID,
+		PresetID:         req.PresetID,
+		Offset:           req.Offset,
+		TimeOfDay:        req.TimeOfDay,
+	}
+	if req.Weekday != nil {
+		if *req.Weekday < int(time.Sunday) || *req.Weekday > int(time.Saturday) {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid weekday",
+			})
+			return
+		}
+		weekday := time.Weekday(*req.Weekday)
+		presetSwitch.Weekday = &weekday
+		presetSwitch.Offset = 0
+	} else {
+		presetSwitch.TimeOfDay = 0
+	}
+	if presetSwitch.Offset >= 24*60 || presetSwitch.TimeOfDay >= 24*60 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "offset and time of day must be less than a day",
+		})
+		return
+	}
+	if stream.LectureHallID == 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "the lecture isn't streamed from a lecture hall",
+		})
+		return
+	}
+	if _, err := r.LectureHallsDao.FindPreset(fmt.Sprintf("%d", stream.LectureHallID), fmt.Sprintf("%d", req.PresetID)); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find preset",
+			Err:           err,
+		})
+		return
+	}
+	if err := r.CameraPresetDao.CreatePresetSwitch(&presetSwitch); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create preset switch",
+			Err:           err,
+		})
+		return
+	}
+	if err := r.AuditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: fmt.Sprintf("'%s': scheduled preset %d (%d and series)", tumLiveContext.Course.Name, req.PresetID, stream.ID),
+		Type:    model.AuditStreamEdit,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+	c.JSON(http.StatusOK, presetSwitch.Json())
+}
+
+// deletePresetSwitch deletes a scheduled preset switch of the lecture series of a stream
+func (r coursesRoutes) deletePresetSwitch(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream, ok := seriesStreamFromContext(c)
+	if !ok {
+		return
+	}
+	switchID, err := strconv.ParseUint(c.Param("switchID"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid param 'switchID'",
+			Err:           err,
+		})
+		return
+	}
+	if err = r.CameraPresetDao.DeletePresetSwitch(uint(switchID), stream.SeriesIdentifier); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not delete preset switch",
+			Err:           err,
+		})
+		return
+	}
+	if err = r.AuditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: fmt.Sprintf("'%s': deleted scheduled preset switch %d (%d and series)", tumLiveContext.Course.Name, switchID, stream.ID),
+		Type:    model.AuditStreamEdit,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+}
+
+// seriesStreamFromContext returns the stream of the request if it belongs to the course and a lecture series.
+// Errors are written to the context and ok is false otherwise.
+func seriesStreamFromContext(c *gin.Context) (stream *model.Stream, ok bool) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream = tumLiveContext.Stream
+	if stream == nil || tumLiveContext.Course == nil || stream.CourseID != tumLiveContext.Course.ID {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find stream",
+		})
+		return nil, false
+	}
+	if stream.SeriesIdentifier == "" {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "the stream is not in a lecture series",
+		})
+		return nil, false
+	}
+	return stream, true
+}
+
+// SwitchScheduledCameraPresets moves the cameras of live lectures to the presets scheduled for the current minute.
+func SwitchScheduledCameraPresets(daoWrapper dao.DaoWrapper, presets tools.PresetUtility) func() {
+	return func() {
+		switchScheduledCameraPresets(daoWrapper, presets, time.Now())
+	}
+}
+
+func switchScheduledCameraPresets(daoWrapper dao.DaoWrapper, presets tools.PresetUtility, now time.Time) {
+	streams, err := daoWrapper.StreamsDao.GetCurrentLive(context.Background())
+	if err != nil {
+		log.WithError(err).Error("Can't get live streams")
+		return
+	}
+	minute := now.Truncate(time.Minute)
+	for _, stream := range streams {
+		if stream.SeriesIdentifier == "" || stream.LectureHallID == 0 {
+			continue
+		}
+		switches, err := daoWrapper.CameraPresetDao.GetPresetSwitches(stream.SeriesIdentifier)
+		if err != nil {
+			log.WithError(err).WithField("stream", stream.ID).Error("Can't get preset switches")
+			continue
+		}
+		for _, presetSwitch := range switches {
+			due, ok := presetSwitch.DueAt(stream, now)
+			if !ok || due.Before(minute) || !due.Before(minute.Add(time.Minute)) {
+				continue
+			}
+			if presetSwitch.LectureHallID != stream.LectureHallID {
+				log.WithFields(log.Fields{"stream": stream.ID, "switch": presetSwitch.ID}).
+					Warn("Skipping preset switch of another lecture hall")
+				continue
+			}
+			presets.UsePreset(model.CameraPreset{LectureHallID: presetSwitch.LectureHallID, PresetID: presetSwitch.PresetID})
+			if err = daoWrapper.AuditDao.Create(&model.Audit{
+				Message: fmt.Sprintf("lecture hall %d: scheduled switch to preset %d (stream %d)", presetSwitch.LectureHallID, presetSwitch.PresetID, stream.ID),
+				Type:    model.AuditCameraMoved,
+			}); err != nil {
+				log.Error("Create Audit:", err)
+			}
+		}
+	}
+}
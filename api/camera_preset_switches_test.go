@@ -0,0 +1,152 @@
+package api
+
+import (
+	"errors"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/mock_tools"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+	"github.com/matthiasreumann/gomino"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// presetSwitchRouter returns a router in which the stream of the request is stream
+func presetSwitchRouter(t *testing.T, stream model.Stream, configure func(wrapper *dao.DaoWrapper, ctrl *gomock.Controller)) func(r *gin.Engine) {
+	return func(r *gin.Engine) {
+		ctrl := gomock.NewController(t)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1969").Return(stream, nil).AnyTimes()
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), testutils.CourseFPV.ID).Return(testutils.CourseFPV, nil).AnyTimes()
+		wrapper := dao.DaoWrapper{StreamsDao: streamsMock, CoursesDao: coursesMock}
+		if configure != nil {
+			configure(&wrapper, ctrl)
+		}
+		configGinCourseRouter(r, wrapper)
+	}
+}
+
+func TestPresetSwitches(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middlewares := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+	weekday := 2
+	noSeries := testutils.StreamFPVLive
+	noSeries.SeriesIdentifier = ""
+
+	t.Run("POST /api/course/:courseID/stream/:streamID/presetSwitches", func(t *testing.T) {
+		gomino.TestCases{
+			"not in series": {
+				Router:       presetSwitchRouter(t, noSeries, nil),
+				Middlewares:  middlewares,
+				Body:         presetSwitchRequest{PresetID: 1, Offset: 30},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"invalid weekday": {
+				Router:       presetSwitchRouter(t, testutils.StreamFPVLive, nil),
+				Middlewares:  middlewares,
+				Body:         presetSwitchRequest{PresetID: 1, Weekday: func() *int { w := 7; return &w }()},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"invalid time of day": {
+				Router:       presetSwitchRouter(t, testutils.StreamFPVLive, nil),
+				Middlewares:  middlewares,
+				Body:         presetSwitchRequest{PresetID: 1, Weekday: &weekday, TimeOfDay: 24 * 60},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"preset not found": {
+				Router: presetSwitchRouter(t, testutils.StreamFPVLive, func(wrapper *dao.DaoWrapper, ctrl *gomock.Controller) {
+					lectureHallMock := mock_dao.NewMockLectureHallsDao(ctrl)
+					lectureHallMock.EXPECT().FindPreset("1", "5").Return(model.CameraPreset{}, errors.New("not found"))
+					wrapper.LectureHallsDao = lectureHallMock
+				}),
+				Middlewares:  middlewares,
+				Body:         presetSwitchRequest{PresetID: 5, Offset: 30},
+				ExpectedCode: http.StatusNotFound,
+			},
+			"success": {
+				Router: presetSwitchRouter(t, testutils.StreamFPVLive, func(wrapper *dao.DaoWrapper, ctrl *gomock.Controller) {
+					lectureHallMock := mock_dao.NewMockLectureHallsDao(ctrl)
+					lectureHallMock.EXPECT().FindPreset("1", "1").Return(testutils.CameraPreset, nil)
+					wrapper.LectureHallsDao = lectureHallMock
+					presetMock := mock_dao.NewMockCameraPresetDao(ctrl)
+					tuesday := time.Tuesday
+					presetMock.EXPECT().CreatePresetSwitch(&model.CameraPresetSwitch{
+						SeriesIdentifier: testutils.StreamFPVLive.SeriesIdentifier,
+						LectureHallID:    testutils.LectureHall.ID,
+						PresetID:         1,
+						Weekday:          &tuesday,
+						TimeOfDay:        10 * 60,
+					}).Return(nil)
+					wrapper.CameraPresetDao = presetMock
+					auditMock := mock_dao.NewMockAuditDao(ctrl)
+					auditMock.EXPECT().Create(gomock.Any()).Return(nil)
+					wrapper.AuditDao = auditMock
+				}),
+				Middlewares:  middlewares,
+				Body:         presetSwitchRequest{PresetID: 1, Offset: 30, Weekday: &weekday, TimeOfDay: 10 * 60},
+				ExpectedCode: http.StatusOK,
+				ExpectedResponse: gin.H{"id": 0, "lectureHallID": testutils.LectureHall.ID, "presetID": 1, "offset": 0,
+					"weekday": weekday, "timeOfDay": 10 * 60},
+			}}.
+			Method(http.MethodPost).
+			Url("/api/course/40/stream/1969/presetSwitches").
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("DELETE /api/course/:courseID/stream/:streamID/presetSwitches/:switchID", func(t *testing.T) {
+		gomino.TestCases{
+			"invalid id": {
+				Router:       presetSwitchRouter(t, testutils.StreamFPVLive, nil),
+				Middlewares:  middlewares,
+				Url:          "/api/course/40/stream/1969/presetSwitches/abc",
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: presetSwitchRouter(t, testutils.StreamFPVLive, func(wrapper *dao.DaoWrapper, ctrl *gomock.Controller) {
+					presetMock := mock_dao.NewMockCameraPresetDao(ctrl)
+					presetMock.EXPECT().DeletePresetSwitch(uint(3), testutils.StreamFPVLive.SeriesIdentifier).Return(nil)
+					wrapper.CameraPresetDao = presetMock
+					auditMock := mock_dao.NewMockAuditDao(ctrl)
+					auditMock.EXPECT().Create(gomock.Any()).Return(nil)
+					wrapper.AuditDao = auditMock
+				}),
+				Middlewares:  middlewares,
+				ExpectedCode: http.StatusOK,
+			}}.
+			Method(http.MethodDelete).
+			Url("/api/course/40/stream/1969/presetSwitches/3").
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestSwitchScheduledCameraPresets(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	stream := testutils.StreamFPVLive
+	stream.Start = time.Date(2022, time.November, 8, 10, 15, 0, 0, time.Local)
+	stream.End = stream.Start.Add(90 * time.Minute)
+	selfStream := testutils.StreamFPVLive
+	selfStream.LectureHallID = 0
+
+	streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+	streamsMock.EXPECT().GetCurrentLive(gomock.Any()).Return([]model.Stream{stream, selfStream}, nil)
+	presetMock := mock_dao.NewMockCameraPresetDao(ctrl)
+	presetMock.EXPECT().GetPresetSwitches(stream.SeriesIdentifier).Return([]model.CameraPresetSwitch{
+		{LectureHallID: stream.LectureHallID, PresetID: 1, Offset: 10},
+		{LectureHallID: stream.LectureHallID, PresetID: 2, Offset: 30},
+		{LectureHallID: stream.LectureHallID + 1, PresetID: 3, Offset: 30},
+	}, nil).Times(1)
+	auditMock := mock_dao.NewMockAuditDao(ctrl)
+	auditMock.EXPECT().Create(gomock.Any()).Return(nil).Times(1)
+	presets := mock_tools.NewMockPresetUtility(ctrl)
+	presets.EXPECT().UsePreset(model.CameraPreset{LectureHallID: stream.LectureHallID, PresetID: 2}).Times(1)
+
+	wrapper := dao.DaoWrapper{StreamsDao: streamsMock, CameraPresetDao: presetMock, AuditDao: auditMock}
+	switchScheduledCameraPresets(wrapper, presets, stream.Start.Add(30*time.Minute+20*time.Second))
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
 	"github.com/joschahenningsen/TUM-Live/tools/realtime"
 
 	"github.com/getsentry/sentry-go"
@@ -23,7 +24,8 @@ import (
 )
 
 const (
-	ChatRoomName = "chat/:streamID"
+	ChatRoomName      = "chat/:streamID"
+	ChatAdminRoomName = "chat/:streamID/admin" // messages only for the course admins, e.g. unapproved messages
 )
 
 var allowedReactions = map[string]struct{}{
@@ -87,6 +89,12 @@ func RegisterRealtimeChatChannel() {
 			}
 		},
 	})
+	RealtimeInstance.RegisterChannel(ChatAdminRoomName, realtime.ChannelHandlers{
+		SubscriptionMiddlewares: []realtime.SubscriptionMiddleware{
+			tools.InitStreamRealtime(),
+			tools.AdminOfCourseRealtime(),
+		},
+	})
 
 	//delete closed sessions every second
 	go func() {
@@ -441,6 +449,7 @@ func (r chatRoutes) handleMessage(ctx tools.TUMLiveContext, context *realtime.Co
 		}
 		return
 	}
+	metrics.ChatMessages.Inc()
 
 	if msg, err := json.Marshal(chatForDb); err == nil {
 		if ctx.Course.ModeratedChatEnabled && !isAdmin {
@@ -686,6 +695,7 @@ func NotifyViewersLiveState(streamId uint, live bool) {
 }
 
 func chatOnSubscribe(psc *realtime.Context) {
+	metrics.RealtimeConnections.WithLabelValues(ChatRoomName).Inc()
 	joinTime := time.Now()
 	psc.Set("chat.joinTime", joinTime)
 
@@ -693,6 +703,8 @@ func chatOnSubscribe(psc *realtime.Context) {
 }
 
 func chatOnUnsubscribe(psc *realtime.Context) {
+	metrics.RealtimeConnections.WithLabelValues(ChatRoomName).Dec()
+
 	var daoWrapper dao.DaoWrapper
 	if ctx, ok := psc.Client.Get("dao"); ok {
 		daoWrapper = ctx.(dao.DaoWrapper)
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -62,6 +63,9 @@ func configGinCourseRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			courses.Use(tools.AdminOfCourse)
 			courses.DELETE("/", routes.deleteCourse)
 			courses.POST("/uploadVOD", routes.uploadVOD)
+			courses.HEAD("/uploadVOD/:key", routes.resumeVODUpload)
+			courses.PATCH("/uploadVOD/:key", routes.resumeVODUpload)
+			courses.GET("/uploads", routes.getUploads)
 			courses.POST("/copy", routes.copyCourse)
 			courses.POST("/createLecture", routes.createLecture)
 			courses.POST("/presets", routes.updateSourceSettings)
@@ -79,6 +83,9 @@ func configGinCourseRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			{
 				stream.Use(tools.InitStream(daoWrapper))
 				stream.GET("/transcodingProgress", routes.getTranscodingProgress)
+				stream.GET("/presetSwitches", routes.getPresetSwitches)
+				stream.POST("/presetSwitches", routes.createPresetSwitch)
+				stream.DELETE("/presetSwitches/:switchID", routes.deletePresetSwitch)
 			}
 
 			stats := courses.Group("/stats")
@@ -381,6 +388,8 @@ func isUserAllowedToWatchPrivateCourse(course model.Course, user *model.User) bo
 	return false
 }
 
+// uploadVOD creates a resumable upload (tus protocol, creation extension) for a new lecture. The upload is stored by
+// the worker chosen here, the file is sent in chunks to the location returned (see resumeVODUpload).
 func (r coursesRoutes) uploadVOD(c *gin.Context) {
 	log.Info("uploadVOD")
 	var req uploadVodReq
@@ -393,6 +402,26 @@ func (r coursesRoutes) uploadVOD(c *gin.Context) {
 		})
 		return
 	}
+	size, err := strconv.ParseInt(c.GetHeader("Upload-Length"), 10, 64)
+	if err != nil || size <= 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid Upload-Length",
+			Err:           err,
+		})
+		return
+	}
+	workers := r.WorkerDao.GetSchedulableWorkers()
+	if len(workers) == 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "no workers available",
+			Err:           err,
+		})
+		return
+	}
+	w := workers[getWorkerWithLeastWorkload(workers)]
+
 	tlctx := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
 	stream := model.Stream{
 		Name:     req.Title,
@@ -409,8 +438,14 @@ func (r coursesRoutes) uploadVOD(c *gin.Context) {
 		})
 		return
 	}
-	key := uuid.NewV4().String()
-	err = r.UploadKeyDao.CreateUploadKey(key, stream.ID)
+	key := model.UploadKey{
+		UploadKey: uuid.NewV4().String(),
+		StreamID:  stream.ID,
+		WorkerID:  w.WorkerID,
+		Filename:  uploadMetadataValue(c.GetHeader("Upload-Metadata"), "filename"),
+		Size:      size,
+	}
+	err = r.UploadKeyDao.CreateUploadKey(&key)
 	if err != nil {
 		_ = c.Error(tools.RequestError{
 			Status:        http.StatusInternalServerError,
@@ -419,17 +454,37 @@ func (r coursesRoutes) uploadVOD(c *gin.Context) {
 		})
 		return
 	}
-	workers := r.WorkerDao.GetAliveWorkers()
-	if len(workers) == 0 {
+	r.proxyUpload(c, w, key.UploadKey)
+}
+
+// resumeVODUpload forwards the tus requests of an upload (HEAD for the current offset, PATCH for chunks) to the worker
+// storing it. Uploads can be resumed as long as this worker is alive.
+func (r coursesRoutes) resumeVODUpload(c *gin.Context) {
+	tlctx := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	key, err := r.UploadKeyDao.GetUploadKey(c.Param("key"))
+	if err != nil || key.Stream.CourseID != tlctx.Course.ID {
 		_ = c.Error(tools.RequestError{
-			Status:        http.StatusInternalServerError,
-			CustomMessage: "no workers available",
+			Status:        http.StatusNotFound,
+			CustomMessage: "upload not found",
 			Err:           err,
 		})
 		return
 	}
-	w := workers[getWorkerWithLeastWorkload(workers)]
-	u, err := url.Parse("http://" + w.Host + ":" + WorkerHTTPPort + "/upload?" + c.Request.URL.Query().Encode() + "&key=" + key)
+	w, err := r.WorkerDao.GetWorkerByID(c, key.WorkerID)
+	if err != nil || !w.IsAlive() {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusServiceUnavailable,
+			CustomMessage: "worker storing the upload is unavailable",
+			Err:           err,
+		})
+		return
+	}
+	r.proxyUpload(c, w, key.UploadKey)
+}
+
+// proxyUpload forwards a tus request for the upload identified by key to a worker and keeps track of the upload progress.
+func (r coursesRoutes) proxyUpload(c *gin.Context, w model.Worker, key string) {
+	u, err := url.Parse("http://" + w.Host + ":" + WorkerHTTPPort + "/upload/tus/" + key)
 	if err != nil {
 		_ = c.Error(tools.RequestError{
 			Status:        http.StatusInternalServerError,
@@ -444,11 +499,79 @@ func (r coursesRoutes) uploadVOD(c *gin.Context) {
 		req.URL.Host = u.Host
 		req.Host = u.Host
 		req.URL.Path = u.Path
-		req.URL.RawQuery = u.RawQuery
+		req.URL.RawQuery = ""
+	}
+	p.ModifyResponse = func(resp *http.Response) error {
+		if resp.Header.Get("Location") != "" {
+			resp.Header.Set("Location", fmt.Sprintf("/api/course/%s/uploadVOD/%s", c.Param("courseID"), key))
+		}
+		if c.Request.Method != http.MethodPatch {
+			return nil
+		}
+		if offset, err := strconv.ParseInt(resp.Header.Get("Upload-Offset"), 10, 64); err == nil {
+			if err = r.UploadKeyDao.UpdateUploadOffset(key, offset); err != nil {
+				log.WithError(err).Warn("can't save upload progress")
+			}
+		}
+		return nil
 	}
 	p.ServeHTTP(c.Writer, c.Request)
 }
 
+type uploadProgress struct {
+	Key        string `json:"key"`
+	StreamID   uint   `json:"streamID"`
+	StreamName string `json:"streamName"`
+	Filename   string `json:"filename"`
+	Size       int64  `json:"size"`
+	Offset     int64  `json:"offset"`
+	Progress   int    `json:"progress"`
+}
+
+// getUploads returns the progress of all unfinished VOD uploads of a course
+func (r coursesRoutes) getUploads(c *gin.Context) {
+	tlctx := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	keys, err := r.UploadKeyDao.GetUploadKeysForCourse(tlctx.Course.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get uploads",
+			Err:           err,
+		})
+		return
+	}
+	res := make([]uploadProgress, len(keys))
+	for i, key := range keys {
+		res[i] = uploadProgress{
+			Key:        key.UploadKey,
+			StreamID:   key.StreamID,
+			StreamName: key.Stream.Name,
+			Filename:   key.Filename,
+			Size:       key.Size,
+			Offset:     key.Offset,
+			Progress:   key.Progress(),
+		}
+	}
+	c.JSON(http.StatusOK, res)
+}
+
+// uploadMetadataValue returns the value of key in a tus Upload-Metadata header
+// (comma separated list of keys and base64 encoded values)
+func uploadMetadataValue(header string, key string) string {
+	for _, pair := range strings.Split(header, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), " ", 2)
+		if len(parts) != 2 || parts[0] != key {
+			continue
+		}
+		value, err := base64.StdEncoding.DecodeString(parts[1])
+		if err != nil {
+			return ""
+		}
+		return string(value)
+	}
+	return ""
+}
+
 // updateSourceSettings updates the CameraPresets of a course
 func (r coursesRoutes) updateSourceSettings(c *gin.Context) {
 	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
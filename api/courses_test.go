@@ -1960,6 +1960,17 @@ func TestUploadVOD(t *testing.T) {
 		url := fmt.Sprintf("%s?start=2022-07-04T10:00:00.000Z&title=VOD1", baseUrl)
 
 		ctrl := gomock.NewController(t)
+		uploadLength := func(c *gin.Context) {
+			c.Request.Header.Set("Upload-Length", "1024")
+		}
+		aliveWorkers := func() dao.WorkerDao {
+			workerMock := mock_dao.NewMockWorkerDao(ctrl)
+			workerMock.
+				EXPECT().
+				GetSchedulableWorkers().
+				Return([]model.Worker{testutils.Worker1})
+			return workerMock
+		}
 
 		gomino.TestCases{
 			"no context": {
@@ -1990,10 +2001,21 @@ func TestUploadVOD(t *testing.T) {
 				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
 				ExpectedCode: http.StatusBadRequest,
 			},
+			"invalid upload length": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						CoursesDao: testutils.GetCoursesMock(t),
+					}
+					configGinCourseRouter(r, wrapper)
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+			},
 			"can not create stream": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
 						CoursesDao: testutils.GetCoursesMock(t),
+						WorkerDao:  aliveWorkers(),
 						StreamsDao: func() dao.StreamsDao {
 							streamsMock := mock_dao.NewMockStreamsDao(ctrl)
 							streamsMock.
@@ -2005,13 +2027,14 @@ func TestUploadVOD(t *testing.T) {
 					}
 					configGinCourseRouter(r, wrapper)
 				},
-				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin), uploadLength),
 				ExpectedCode: http.StatusInternalServerError,
 			},
 			"can note create upload key": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
 						CoursesDao: testutils.GetCoursesMock(t),
+						WorkerDao:  aliveWorkers(),
 						StreamsDao: func() dao.StreamsDao {
 							streamsMock := mock_dao.NewMockStreamsDao(ctrl)
 							streamsMock.
@@ -2024,34 +2047,32 @@ func TestUploadVOD(t *testing.T) {
 							streamsMock := mock_dao.NewMockUploadKeyDao(ctrl)
 							streamsMock.
 								EXPECT().
-								CreateUploadKey(gomock.Any(), gomock.Any()).
+								CreateUploadKey(gomock.Any()).
 								Return(errors.New(""))
 							return streamsMock
 						}(),
 					}
 					configGinCourseRouter(r, wrapper)
 				},
-				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin), uploadLength),
 				ExpectedCode: http.StatusInternalServerError,
 			},
 			"no workers available": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
-						CoursesDao:   testutils.GetCoursesMock(t),
-						StreamsDao:   testutils.GetStreamMock(t),
-						UploadKeyDao: testutils.GetUploadKeyMock(t),
+						CoursesDao: testutils.GetCoursesMock(t),
 						WorkerDao: func() dao.WorkerDao {
 							streamsMock := mock_dao.NewMockWorkerDao(ctrl)
 							streamsMock.
 								EXPECT().
-								GetAliveWorkers().
+								GetSchedulableWorkers().
 								Return([]model.Worker{})
 							return streamsMock
 						}(),
 					}
 					configGinCourseRouter(r, wrapper)
 				},
-				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin), uploadLength),
 				ExpectedCode: http.StatusInternalServerError,
 			},
 			/*
@@ -2068,7 +2089,7 @@ func TestUploadVOD(t *testing.T) {
 							streamsMock := mock_dao.NewMockWorkerDao(ctrl)
 							streamsMock.
 								EXPECT().
-								GetAliveWorkers().
+								GetSchedulableWorkers().
 								Return([]model.Worker{testutils.Worker1})
 							return streamsMock
 						}(),
@@ -2083,6 +2104,102 @@ func TestUploadVOD(t *testing.T) {
 	})
 }
 
+func TestResumeVODUpload(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("PATCH/api/course/:courseID/uploadVOD/:key", func(t *testing.T) {
+		url := fmt.Sprintf("/api/course/%d/uploadVOD/abc", testutils.CourseFPV.ID)
+		key := model.UploadKey{UploadKey: "abc", StreamID: testutils.StreamFPVLive.ID, Stream: testutils.StreamFPVLive, WorkerID: testutils.Worker1.WorkerID}
+		otherCourseKey := key
+		otherCourseKey.Stream.CourseID = testutils.CourseFPV.ID + 1
+		uploadKeyMock := func(k model.UploadKey, err error) dao.UploadKeyDao {
+			m := mock_dao.NewMockUploadKeyDao(gomock.NewController(t))
+			m.EXPECT().GetUploadKey("abc").Return(k, err)
+			return m
+		}
+
+		gomino.TestCases{
+			"upload not found": {
+				Router: func(r *gin.Engine) {
+					configGinCourseRouter(r, dao.DaoWrapper{
+						CoursesDao:   testutils.GetCoursesMock(t),
+						UploadKeyDao: uploadKeyMock(model.UploadKey{}, errors.New("")),
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"upload of other course": {
+				Router: func(r *gin.Engine) {
+					configGinCourseRouter(r, dao.DaoWrapper{
+						CoursesDao:   testutils.GetCoursesMock(t),
+						UploadKeyDao: uploadKeyMock(otherCourseKey, nil),
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"worker unavailable": {
+				Router: func(r *gin.Engine) {
+					workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+					workerMock.
+						EXPECT().
+						GetWorkerByID(gomock.Any(), testutils.Worker1.WorkerID).
+						Return(model.Worker{WorkerID: testutils.Worker1.WorkerID, LastSeen: time.Now().Add(-time.Hour)}, nil)
+					configGinCourseRouter(r, dao.DaoWrapper{
+						CoursesDao:   testutils.GetCoursesMock(t),
+						UploadKeyDao: uploadKeyMock(key, nil),
+						WorkerDao:    workerMock,
+					})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusServiceUnavailable,
+			},
+		}.
+			Method(http.MethodPatch).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/course/:courseID/uploads", func(t *testing.T) {
+		url := fmt.Sprintf("/api/course/%d/uploads", testutils.CourseFPV.ID)
+		keys := []model.UploadKey{{UploadKey: "abc", StreamID: testutils.StreamFPVLive.ID, Stream: testutils.StreamFPVLive, Filename: "lecture.mp4", Size: 400, Offset: 100}}
+
+		gomino.TestCases{
+			"can not get uploads": {
+				Router: func(r *gin.Engine) {
+					uploadKeyMock := mock_dao.NewMockUploadKeyDao(gomock.NewController(t))
+					uploadKeyMock.EXPECT().GetUploadKeysForCourse(testutils.CourseFPV.ID).Return(nil, errors.New(""))
+					configGinCourseRouter(r, dao.DaoWrapper{CoursesDao: testutils.GetCoursesMock(t), UploadKeyDao: uploadKeyMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					uploadKeyMock := mock_dao.NewMockUploadKeyDao(gomock.NewController(t))
+					uploadKeyMock.EXPECT().GetUploadKeysForCourse(testutils.CourseFPV.ID).Return(keys, nil)
+					configGinCourseRouter(r, dao.DaoWrapper{CoursesDao: testutils.GetCoursesMock(t), UploadKeyDao: uploadKeyMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusOK,
+				ExpectedResponse: []uploadProgress{{
+					Key:        "abc",
+					StreamID:   testutils.StreamFPVLive.ID,
+					StreamName: testutils.StreamFPVLive.Name,
+					Filename:   "lecture.mp4",
+					Size:       400,
+					Offset:     100,
+					Progress:   25,
+				}},
+			},
+		}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+}
+
 func TestGetTranscodingProgress(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	t.Run("GET /api/course/:id/stream/:id/transcodingProgress", func(t *testing.T) {
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"strings"
+)
+
+// defaultEncodingProfile is used for streams if neither the course nor the lecture hall has an encoding profile
+const defaultEncodingProfile = "default"
+
+// getEncodingProfile returns the configured encoding profile with the given name as sent to workers or nil if there
+// is none, in which case workers use their defaults.
+func getEncodingProfile(name string) *pb.EncodingProfile {
+	if name == "" {
+		name = defaultEncodingProfile
+	}
+	profile, ok := tools.Cfg.GetEncodingProfile(name)
+	if !ok {
+		if name != defaultEncodingProfile {
+			log.WithField("profile", name).Warn("Unknown encoding profile, workers use their defaults")
+		}
+		return nil
+	}
+	return &pb.EncodingProfile{
+		Name:          strings.ToLower(name),
+		Codec:         profile.Codec,
+		Preset:        profile.Preset,
+		Bitrate:       profile.Bitrate,
+		GOP:           profile.GOP,
+		FPS:           profile.FPS,
+		Resolution:    profile.Resolution,
+		HardwareFlags: profile.HardwareFlags,
+		CRF:           profile.CRF,
+	}
+}
+
+// isValidEncodingProfile returns whether name is empty or the name of a configured encoding profile
+func isValidEncodingProfile(name string) bool {
+	if name == "" {
+		return true
+	}
+	_, ok := tools.Cfg.GetEncodingProfile(name)
+	return ok
+}
@@ -0,0 +1,33 @@
+package api
+
+import (
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"testing"
+)
+
+func TestGetEncodingProfile(t *testing.T) {
+	profiles := tools.Cfg.EncodingProfiles
+	defer func() { tools.Cfg.EncodingProfiles = profiles }()
+
+	tools.Cfg.EncodingProfiles = nil
+	if p := getEncodingProfile(""); p != nil {
+		t.Errorf("getEncodingProfile(\"\") = %v without profiles, want nil", p)
+	}
+
+	tools.Cfg.EncodingProfiles = map[string]tools.EncodingProfile{
+		"default": {Bitrate: 2500},
+		"nvenc":   {Codec: "h264_nvenc", HardwareFlags: []string{"-hwaccel", "cuda"}, CRF: 23},
+	}
+	if p := getEncodingProfile(""); p.GetName() != "default" || p.GetBitrate() != 2500 {
+		t.Errorf("getEncodingProfile(\"\") = %v, want the default profile", p)
+	}
+	if p := getEncodingProfile("NVENC"); p.GetCodec() != "h264_nvenc" || len(p.GetHardwareFlags()) != 2 || p.GetCRF() != 23 {
+		t.Errorf("getEncodingProfile(\"NVENC\") = %v, want the nvenc profile", p)
+	}
+	if p := getEncodingProfile("unknown"); p != nil {
+		t.Errorf("getEncodingProfile(\"unknown\") = %v, want nil", p)
+	}
+	if !isValidEncodingProfile("") || !isValidEncodingProfile("nvenc") || isValidEncodingProfile("unknown") {
+		t.Errorf("isValidEncodingProfile accepts unknown or rejects configured profiles")
+	}
+}
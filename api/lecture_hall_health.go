@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/bot"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const (
+	defaultHealthCheckBefore = time.Hour
+	defaultHealthAlertBefore = time.Minute * 30
+)
+
+// lectureHallHealthConfig returns how long before a lecture the sources of its lecture hall are checked and how long
+// before the lecture unreachable sources are reported or their defaults.
+func lectureHallHealthConfig() (checkBefore time.Duration, alertBefore time.Duration) {
+	checkBefore, alertBefore = defaultHealthCheckBefore, defaultHealthAlertBefore
+	if tools.Cfg.LectureHallHealth == nil {
+		return
+	}
+	if tools.Cfg.LectureHallHealth.CheckBeforeMinutes > 0 {
+		checkBefore = time.Duration(tools.Cfg.LectureHallHealth.CheckBeforeMinutes) * time.Minute
+	}
+	if tools.Cfg.LectureHallHealth.AlertBeforeMinutes > 0 {
+		alertBefore = time.Duration(tools.Cfg.LectureHallHealth.AlertBeforeMinutes) * time.Minute
+	}
+	return
+}
+
+// lectureHallSource is a configured source of a lecture hall, e.g. {"PRES", "10.0.0.1/extron3"}
+type lectureHallSource struct {
+	sourceType string
+	url        string
+}
+
+// lectureHallSources returns all configured sources of a lecture hall.
+func lectureHallSources(lectureHall model.LectureHall) []lectureHallSource {
+	var sources []lectureHallSource
+	for _, s := range []lectureHallSource{{"COMB", lectureHall.CombIP}, {"PRES", lectureHall.PresIP}, {"CAM", lectureHall.CamIP}} {
+		if s.url != "" {
+			sources = append(sources, s)
+		}
+	}
+	return sources
+}
+
+// sourceProber asks a worker whether it can reach the sources
+type sourceProber func(worker model.Worker, sources []string) (*pb.ProbeSourcesResponse, error)
+
+func probeSourcesOnWorker(worker model.Worker, sources []string) (*pb.ProbeSourcesResponse, error) {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return nil, fmt.Errorf("dial worker: %w", err)
+	}
+	defer endConnection(conn)
+	return pb.NewToWorkerClient(conn).ProbeSources(context.Background(), &pb.ProbeSourcesRequest{
+		WorkerID: worker.WorkerID,
+		Sources:  sources,
+	})
+}
+
+// CheckLectureHallHealth checks the sources of all lecture halls with upcoming streams and alerts if they are unreachable.
+func CheckLectureHallHealth(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		checkLectureHallHealth(daoWrapper, probeSourcesOnWorker, time.Now())
+	}
+}
+
+func checkLectureHallHealth(daoWrapper dao.DaoWrapper, probe sourceProber, now time.Time) {
+	checkBefore, _ := lectureHallHealthConfig()
+	streams, err := daoWrapper.StreamsDao.GetUpcomingLectureHallStreams(now.Add(checkBefore))
+	if err != nil {
+		log.WithError(err).Error("Can't get upcoming streams")
+		return
+	}
+	if len(streams) == 0 {
+		return
+	}
+	workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
+	if len(workers) == 0 {
+		log.Warn("No worker available to check lecture hall sources")
+		return
+	}
+	checked := make(map[uint]bool)
+	for _, stream := range streams {
+		// streams are ordered by start, only the next stream of each lecture hall is relevant
+		if checked[stream.LectureHallID] {
+			continue
+		}
+		checked[stream.LectureHallID] = true
+		worker := workers[getWorkerWithLeastWorkload(workers)]
+		if err := checkLectureHallSources(daoWrapper, probe, worker, stream, now); err != nil {
+			log.WithError(err).WithField("lectureHall", stream.LectureHallID).Error("Lecture hall health check failed")
+			sentry.CaptureException(err)
+		}
+	}
+}
+
+// checkLectureHallSources probes the sources of the lecture hall of stream, stores the results and sends an alert
+// once per stream if sources are unreachable shortly before the stream starts.
+func checkLectureHallSources(daoWrapper dao.DaoWrapper, probe sourceProber, worker model.Worker, stream model.Stream, now time.Time) error {
+	_, alertBefore := lectureHallHealthConfig()
+	lectureHall, err := daoWrapper.LectureHallsDao.GetLectureHallByID(stream.LectureHallID)
+	if err != nil {
+		return fmt.Errorf("get lecture hall: %w", err)
+	}
+	sources := lectureHallSources(lectureHall)
+	if len(sources) == 0 {
+		return nil
+	}
+	previous, err := daoWrapper.LectureHallsDao.GetHealth(lectureHall.ID)
+	if err != nil {
+		return fmt.Errorf("get previous health: %w", err)
+	}
+	alerted := make(map[string]uint)
+	for _, h := range previous {
+		alerted[h.SourceType] = h.AlertedStreamID
+	}
+
+	urls := make([]string, len(sources))
+	for i, s := range sources {
+		urls[i] = s.url
+	}
+	resp, err := probe(worker, urls)
+	if err != nil {
+		return fmt.Errorf("probe sources: %w", err)
+	}
+	results := make(map[string]*pb.ProbeSourcesResponse_Result)
+	for _, r := range resp.GetResults() {
+		results[r.GetSource()] = r
+	}
+
+	alert := stream.Start.Sub(now) <= alertBefore
+	var unreachable []model.LectureHallHealth
+	for _, s := range sources {
+		health := model.LectureHallHealth{
+			LectureHallID:   lectureHall.ID,
+			SourceType:      s.sourceType,
+			Source:          s.url,
+			CheckedAt:       now,
+			WorkerID:        worker.WorkerID,
+			StreamID:        stream.ID,
+			AlertedStreamID: alerted[s.sourceType],
+		}
+		if result, ok := results[s.url]; ok {
+			health.Reachable = result.GetReachable()
+			health.Error = result.GetError()
+		} else {
+			health.Error = "no result from worker"
+		}
+		if !health.Reachable && alert && health.AlertedStreamID != stream.ID {
+			health.AlertedStreamID = stream.ID
+			unreachable = append(unreachable, health)
+		}
+		if err := daoWrapper.LectureHallsDao.SaveHealth(&health); err != nil {
+			return fmt.Errorf("save health: %w", err)
+		}
+	}
+	if len(unreachable) == 0 {
+		return nil
+	}
+	courseName := ""
+	if course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID); err == nil {
+		courseName = course.Name
+	}
+	go sendLectureHallAlert(bot.LectureHallAlertMessage{LectureHall: lectureHall, Stream: stream, CourseName: courseName, Unreachable: unreachable})
+	return nil
+}
+
+func sendLectureHallAlert(alert bot.LectureHallAlertMessage) {
+	log.WithFields(log.Fields{"lectureHall": alert.LectureHall.Name, "stream": alert.Stream.ID}).Warn("lecture hall sources unreachable")
+	if tools.Cfg.Alerts == nil || tools.Cfg.Alerts.Matrix == nil {
+		return
+	}
+	var alertBot bot.Bot
+	alertBot.SetMessagingMethod(&bot.Matrix{})
+	if err := alertBot.SendLectureHallAlert(alert); err != nil {
+		sentry.CaptureException(err)
+		log.WithError(err).Error("can't send lecture hall alert")
+	}
+}
+
+// getLectureHallHealth returns the latest check results of the sources of a lecture hall
+func (r lectureHallRoutes) getLectureHallHealth(c *gin.Context) {
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid param 'id'",
+			Err:           err,
+		})
+		return
+	}
+	lectureHall, err := r.LectureHallsDao.GetLectureHallByID(uint(id))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find lecture hall",
+			Err:           err,
+		})
+		return
+	}
+	health, err := r.LectureHallsDao.GetHealth(lectureHall.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get health of lecture hall",
+			Err:           err,
+		})
+		return
+	}
+	res := make([]gin.H, len(health))
+	for i, h := range health {
+		res[i] = h.Json()
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,213 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	"github.com/matthiasreumann/gomino"
+	"gorm.io/gorm"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// fakeProber reports all sources as reachable except the ones in unreachable
+func fakeProber(calls *int, unreachable ...string) sourceProber {
+	return func(worker model.Worker, sources []string) (*pb.ProbeSourcesResponse, error) {
+		*calls++
+		resp := &pb.ProbeSourcesResponse{}
+		for _, source := range sources {
+			result := &pb.ProbeSourcesResponse_Result{Source: source, Reachable: true}
+			for _, u := range unreachable {
+				if u == source {
+					result.Reachable = false
+					result.Error = "connection refused"
+				}
+			}
+			resp.Results = append(resp.Results, result)
+		}
+		return resp, nil
+	}
+}
+
+func TestLectureHallSources(t *testing.T) {
+	sources := lectureHallSources(model.LectureHall{PresIP: "10.0.0.1/extron1", CamIP: "10.0.0.1/extron2"})
+	expected := []lectureHallSource{{"PRES", "10.0.0.1/extron1"}, {"CAM", "10.0.0.1/extron2"}}
+	if len(sources) != len(expected) {
+		t.Fatalf("lectureHallSources(...) = %v, want %v", sources, expected)
+	}
+	for i := range expected {
+		if sources[i] != expected[i] {
+			t.Errorf("lectureHallSources(...)[%d] = %v, want %v", i, sources[i], expected[i])
+		}
+	}
+}
+
+func TestCheckLectureHallSources(t *testing.T) {
+	now := time.Date(2022, 11, 8, 10, 0, 0, 0, time.Local)
+	worker := model.Worker{WorkerID: "w1"}
+	stream := model.Stream{Model: gorm.Model{ID: 3}, CourseID: testutils.CourseFPV.ID, LectureHallID: testutils.LectureHall.ID}
+
+	run := func(t *testing.T, start time.Time, previous []model.LectureHallHealth, expectAlert bool) []model.LectureHallHealth {
+		ctrl := gomock.NewController(t)
+		lectureHallMock := mock_dao.NewMockLectureHallsDao(ctrl)
+		lectureHallMock.EXPECT().GetLectureHallByID(testutils.LectureHall.ID).Return(testutils.LectureHall, nil)
+		lectureHallMock.EXPECT().GetHealth(testutils.LectureHall.ID).Return(previous, nil)
+		var saved []model.LectureHallHealth
+		lectureHallMock.EXPECT().SaveHealth(gomock.Any()).DoAndReturn(func(h *model.LectureHallHealth) error {
+			saved = append(saved, *h)
+			return nil
+		}).Times(3)
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		if expectAlert {
+			coursesMock.EXPECT().GetCourseById(gomock.Any(), testutils.CourseFPV.ID).Return(testutils.CourseFPV, nil)
+		}
+
+		s := stream
+		s.Start = start
+		calls := 0
+		err := checkLectureHallSources(dao.DaoWrapper{LectureHallsDao: lectureHallMock, CoursesDao: coursesMock},
+			fakeProber(&calls, testutils.LectureHall.PresIP), worker, s, now)
+		if err != nil {
+			t.Fatalf("checkLectureHallSources(...) = %v, want nil", err)
+		}
+		if calls != 1 {
+			t.Errorf("expected sources to be probed once, got %d", calls)
+		}
+		return saved
+	}
+
+	t.Run("results are stored", func(t *testing.T) {
+		saved := run(t, now.Add(time.Minute*45), nil, false)
+		for _, h := range saved {
+			if h.CheckedAt != now || h.WorkerID != "w1" || h.StreamID != stream.ID || h.AlertedStreamID != 0 {
+				t.Errorf("unexpected health %+v", h)
+			}
+			if h.Reachable != (h.SourceType != "PRES") {
+				t.Errorf("source %s: reachable = %v", h.SourceType, h.Reachable)
+			}
+		}
+	})
+
+	t.Run("alert before lecture", func(t *testing.T) {
+		saved := run(t, now.Add(time.Minute*10), nil, true)
+		for _, h := range saved {
+			if h.SourceType == "PRES" && h.AlertedStreamID != stream.ID {
+				t.Errorf("alert for unreachable source not recorded: %+v", h)
+			}
+			if h.SourceType != "PRES" && h.AlertedStreamID != 0 {
+				t.Errorf("alert recorded for reachable source: %+v", h)
+			}
+		}
+	})
+
+	t.Run("alert only once per stream", func(t *testing.T) {
+		previous := []model.LectureHallHealth{{LectureHallID: testutils.LectureHall.ID, SourceType: "PRES", AlertedStreamID: stream.ID}}
+		saved := run(t, now.Add(time.Minute*5), previous, false)
+		for _, h := range saved {
+			if h.SourceType == "PRES" && h.AlertedStreamID != stream.ID {
+				t.Errorf("previous alert lost: %+v", h)
+			}
+		}
+	})
+}
+
+func TestCheckLectureHallHealth(t *testing.T) {
+	now := time.Date(2022, 11, 8, 10, 0, 0, 0, time.Local)
+
+	t.Run("no workers", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetUpcomingLectureHallStreams(now.Add(defaultHealthCheckBefore)).
+			Return([]model.Stream{{LectureHallID: testutils.LectureHall.ID, Start: now.Add(time.Minute)}}, nil)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		workerMock.EXPECT().GetSchedulableWorkers().Return(nil)
+
+		calls := 0
+		checkLectureHallHealth(dao.DaoWrapper{StreamsDao: streamsMock, WorkerDao: workerMock}, fakeProber(&calls), now)
+		if calls != 0 {
+			t.Errorf("expected no probes without workers, got %d", calls)
+		}
+	})
+
+	t.Run("next stream per lecture hall", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetUpcomingLectureHallStreams(now.Add(defaultHealthCheckBefore)).Return([]model.Stream{
+			{Model: gorm.Model{ID: 1}, LectureHallID: testutils.LectureHall.ID, Start: now.Add(time.Minute * 40)},
+			{Model: gorm.Model{ID: 2}, LectureHallID: testutils.LectureHall.ID, Start: now.Add(time.Minute * 50)},
+		}, nil)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		workerMock.EXPECT().GetSchedulableWorkers().Return([]model.Worker{{WorkerID: "w1"}})
+		lectureHallMock := mock_dao.NewMockLectureHallsDao(ctrl)
+		lectureHallMock.EXPECT().GetLectureHallByID(testutils.LectureHall.ID).Return(testutils.LectureHall, nil)
+		lectureHallMock.EXPECT().GetHealth(testutils.LectureHall.ID).Return(nil, nil)
+		lectureHallMock.EXPECT().SaveHealth(gomock.Any()).DoAndReturn(func(h *model.LectureHallHealth) error {
+			if h.StreamID != 1 {
+				t.Errorf("health stored for stream %d, want 1", h.StreamID)
+			}
+			return nil
+		}).Times(3)
+
+		calls := 0
+		checkLectureHallHealth(dao.DaoWrapper{StreamsDao: streamsMock, WorkerDao: workerMock, LectureHallsDao: lectureHallMock}, fakeProber(&calls), now)
+		if calls != 1 {
+			t.Errorf("expected one probe, got %d", calls)
+		}
+	})
+}
+
+func TestGetLectureHallHealth(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	checkedAt := time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC)
+	health := []model.LectureHallHealth{
+		{LectureHallID: testutils.LectureHall.ID, SourceType: "PRES", Source: testutils.LectureHall.PresIP, Error: "connection refused", CheckedAt: checkedAt, WorkerID: "w1", StreamID: 3},
+	}
+	router := func(lectureHallErr error, healthErr error) func(r *gin.Engine) {
+		return func(r *gin.Engine) {
+			lectureHallMock := mock_dao.NewMockLectureHallsDao(gomock.NewController(t))
+			lectureHallMock.EXPECT().GetLectureHallByID(testutils.LectureHall.ID).Return(testutils.LectureHall, lectureHallErr).AnyTimes()
+			lectureHallMock.EXPECT().GetHealth(testutils.LectureHall.ID).Return(health, healthErr).AnyTimes()
+			configGinLectureHallApiRouter(r, dao.DaoWrapper{LectureHallsDao: lectureHallMock}, testutils.GetPresetUtilityMock(gomock.NewController(t)))
+		}
+	}
+	middlewares := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+
+	gomino.TestCases{
+		"lecture hall not found": {
+			Router:       router(errors.New("not found"), nil),
+			Middlewares:  middlewares,
+			ExpectedCode: http.StatusNotFound,
+		},
+		"database error": {
+			Router:       router(nil, errors.New("error")),
+			Middlewares:  middlewares,
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		"success": {
+			Router:       router(nil, nil),
+			Middlewares:  middlewares,
+			ExpectedCode: http.StatusOK,
+			ExpectedResponse: []gin.H{{
+				"lectureHallID": testutils.LectureHall.ID,
+				"sourceType":    "PRES",
+				"source":        testutils.LectureHall.PresIP,
+				"reachable":     false,
+				"error":         "connection refused",
+				"checkedAt":     checkedAt,
+				"workerID":      "w1",
+				"streamID":      3,
+			}},
+		}}.
+		Method(http.MethodGet).
+		Url(fmt.Sprintf("/api/lectureHall/%d/health", testutils.LectureHall.ID)).
+		Run(t, testutils.Equal)
+}
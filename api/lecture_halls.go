@@ -9,6 +9,8 @@ import (
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/camera"
+	"github.com/joschahenningsen/TUM-Live/tools/liveindicator"
 	log "github.com/sirupsen/logrus"
 	"net/http"
 	"strconv"
@@ -25,6 +27,8 @@ func configGinLectureHallApiRouter(router *gin.Engine, daoWrapper dao.DaoWrapper
 	admins.PUT("/lectureHall/:id", routes.updateLectureHall)
 	admins.POST("/lectureHall/:id/defaultPreset", routes.updateLectureHallsDefaultPreset)
 	admins.DELETE("/lectureHall/:id", routes.deleteLectureHall)
+	admins.POST("/lectureHall/:id/liveLight", routes.switchLiveLight)
+	admins.GET("/lectureHall/:id/health", routes.getLectureHallHealth)
 	admins.POST("/createLectureHall", routes.createLectureHall)
 	admins.POST("/takeSnapshot/:lectureHallID/:presetID", routes.takeSnapshot)
 	admins.GET("/course-schedule", routes.getSchedule)
@@ -37,6 +41,8 @@ func configGinLectureHallApiRouter(router *gin.Engine, daoWrapper dao.DaoWrapper
 	adminsOfCourse.Use(tools.InitStream(daoWrapper))
 	adminsOfCourse.Use(tools.AdminOfCourse)
 	adminsOfCourse.POST("/switchPreset/:lectureHallID/:presetID/:streamID", routes.switchPreset)
+	adminsOfCourse.POST("/moveCamera/:lectureHallID/:streamID", routes.moveCamera)
+	adminsOfCourse.POST("/saveCameraPreset/:lectureHallID/:streamID", routes.saveCameraPreset)
 
 	router.GET("/api/schedule.ics", routes.lectureHallIcal)
 }
@@ -52,6 +58,17 @@ type updateLectureHallReq struct {
 	PresIP    string `json:"presIp"`
 	CameraIp  string `json:"cameraIp"`
 	PwrCtrlIp string `json:"pwrCtrlIp"`
+
+	CameraType model.CameraType `json:"cameraType"` // unchanged if 0
+
+	LiveIndicator      model.LiveIndicatorType `json:"liveIndicator"` // unchanged if 0
+	LiveLightIndex     int                     `json:"liveLightIndex"`
+	LiveIndicatorTopic string                  `json:"liveIndicatorTopic"`
+
+	NormalizeLoudness bool `json:"normalizeLoudness"`
+	Denoise           bool `json:"denoise"`
+
+	EncodingProfile string `json:"encodingProfile"` // name of a configured encoding profile, empty uses the default
 }
 
 func (r lectureHallRoutes) updateLectureHall(c *gin.Context) {
@@ -84,11 +101,50 @@ func (r lectureHallRoutes) updateLectureHall(c *gin.Context) {
 		})
 		return
 	}
+	if req.CameraType != 0 {
+		if !req.CameraType.IsValid() {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid camera type",
+			})
+			return
+		}
+		lectureHall.CameraType = req.CameraType
+	}
+	if req.LiveIndicator != 0 {
+		if !req.LiveIndicator.IsValid() {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid live indicator type",
+			})
+			return
+		}
+		lectureHall.LiveIndicator = req.LiveIndicator
+	}
+	if req.LiveLightIndex < 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid live light index",
+		})
+		return
+	}
+	if !isValidEncodingProfile(req.EncodingProfile) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "unknown encoding profile",
+		})
+		return
+	}
+	lectureHall.LiveLightIndex = req.LiveLightIndex
+	lectureHall.LiveIndicatorTopic = req.LiveIndicatorTopic
 	lectureHall.CamIP = req.CamIp
 	lectureHall.CombIP = req.CombIp
 	lectureHall.PresIP = req.PresIP
 	lectureHall.CameraIP = req.CameraIp
 	lectureHall.PwrCtrlIp = req.PwrCtrlIp
+	lectureHall.NormalizeLoudness = req.NormalizeLoudness
+	lectureHall.Denoise = req.Denoise
+	lectureHall.EncodingProfile = req.EncodingProfile
 	err = r.LectureHallsDao.SaveLectureHall(lectureHall)
 	if err != nil {
 		log.WithError(err).Error("error while updating lecture hall")
@@ -169,6 +225,63 @@ func (r lectureHallRoutes) deleteLectureHall(c *gin.Context) {
 	}
 }
 
+// switchLiveLight turns the live light of a lecture hall on or off, e.g. to test its configuration
+func (r lectureHallRoutes) switchLiveLight(c *gin.Context) {
+	var req struct {
+		On bool `json:"on"`
+	}
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	id, err := strconv.ParseUint(c.Param("id"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid param 'id'",
+			Err:           err,
+		})
+		return
+	}
+	lectureHall, err := r.LectureHallsDao.GetLectureHallByID(uint(id))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find lecture hall",
+			Err:           err,
+		})
+		return
+	}
+	indicator, err := liveindicator.New(lectureHall, tools.Cfg.Auths.PwrCrtlAuth)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid live light configuration",
+			Err:           err,
+		})
+		return
+	}
+	lightLock.Lock()
+	defer lightLock.Unlock()
+	if req.On {
+		err = indicator.TurnOn()
+	} else {
+		err = indicator.TurnOff()
+	}
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadGateway,
+			CustomMessage: "can not switch live light",
+			Err:           err,
+		})
+		return
+	}
+}
+
 func (r lectureHallRoutes) refreshLectureHallPresets(c *gin.Context) {
 	lhIDStr := c.Param("lectureHallID")
 	lhID, err := strconv.Atoi(lhIDStr)
@@ -268,9 +381,199 @@ func (r lectureHallRoutes) switchPreset(c *gin.Context) {
 		return
 	}
 	r.presetUtility.UsePreset(preset)
+	if err = r.AuditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: fmt.Sprintf("lecture hall %d: switched to preset %d (stream %d)", preset.LectureHallID, preset.PresetID, tumLiveContext.Stream.ID),
+		Type:    model.AuditCameraMoved,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
 	time.Sleep(time.Second * 10)
 }
 
+// maxCameraPresets is the number of preset slots all supported cameras provide
+const maxCameraPresets = 100
+
+type moveCameraRequest struct {
+	Pan  float64 `json:"pan"`
+	Tilt float64 `json:"tilt"`
+	Zoom float64 `json:"zoom"`
+}
+
+// moveCamera moves the camera of a lecture hall relative to its current position while the stream is live there
+func (r lectureHallRoutes) moveCamera(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	var req moveCameraRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	for _, v := range []float64{req.Pan, req.Tilt, req.Zoom} {
+		if v < -1 || v > 1 {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "pan, tilt and zoom must be between -1 and 1",
+			})
+			return
+		}
+	}
+	if req.Pan == 0 && req.Tilt == 0 && req.Zoom == 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "empty move",
+		})
+		return
+	}
+
+	lectureHall, cam, ok := r.getLiveCamera(c)
+	if !ok {
+		return
+	}
+	if err := cam.Move(req.Pan, req.Tilt, req.Zoom); err != nil {
+		log.WithError(err).WithField("cam", lectureHall.CameraIP).Error("can not move camera")
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not move camera",
+			Err:           err,
+		})
+		return
+	}
+	if err := r.AuditDao.Create(&model.Audit{
+		User: tumLiveContext.User,
+		Message: fmt.Sprintf("%s: pan %.2f, tilt %.2f, zoom %.2f (stream %d)",
+			lectureHall.Name, req.Pan, req.Tilt, req.Zoom, tumLiveContext.Stream.ID),
+		Type: model.AuditCameraMoved,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+}
+
+type saveCameraPresetRequest struct {
+	Name string `json:"name"`
+}
+
+// saveCameraPreset stores the current position of the camera of a lecture hall as a new preset
+func (r lectureHallRoutes) saveCameraPreset(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+
+	var req saveCameraPresetRequest
+	if err := c.BindJSON(&req); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "can not bind body",
+			Err:           err,
+		})
+		return
+	}
+	req.Name = strings.TrimSpace(req.Name)
+	if req.Name == "" || len(req.Name) > 64 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "name must have between 1 and 64 characters",
+		})
+		return
+	}
+
+	lectureHall, cam, ok := r.getLiveCamera(c)
+	if !ok {
+		return
+	}
+	presetID := 1 // preset 0 is the home position of some cameras
+	for _, preset := range lectureHall.CameraPresets {
+		if preset.PresetID >= presetID {
+			presetID = preset.PresetID + 1
+		}
+	}
+	if presetID >= maxCameraPresets {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusConflict,
+			CustomMessage: "no free preset slot on camera",
+		})
+		return
+	}
+	if err := cam.SavePreset(presetID, req.Name); err != nil {
+		log.WithError(err).WithField("cam", lectureHall.CameraIP).Error("can not save camera preset")
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not save camera preset",
+			Err:           err,
+		})
+		return
+	}
+	preset := model.CameraPreset{Name: req.Name, PresetID: presetID, LectureHallID: lectureHall.ID}
+	if err := r.LectureHallsDao.SavePreset(preset); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not save preset",
+			Err:           err,
+		})
+		return
+	}
+	if err := r.AuditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: fmt.Sprintf("%s: saved position as preset %d '%s' (stream %d)", lectureHall.Name, presetID, req.Name, tumLiveContext.Stream.ID),
+		Type:    model.AuditCameraMoved,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+	c.JSON(http.StatusOK, gin.H{"presetID": preset.PresetID, "name": preset.Name, "lectureHallID": preset.LectureHallID})
+}
+
+// getLiveCamera returns the lecture hall of the request and its camera if the stream of the request is live in it.
+// Errors are written to the context and ok is false if that's not the case.
+func (r lectureHallRoutes) getLiveCamera(c *gin.Context) (lectureHall model.LectureHall, cam camera.Cam, ok bool) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	lectureHallID, err := strconv.ParseUint(c.Param("lectureHallID"), 10, 32)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid param 'lectureHallID'",
+			Err:           err,
+		})
+		return
+	}
+	stream := tumLiveContext.Stream
+	if stream == nil || tumLiveContext.Course == nil || stream.CourseID != tumLiveContext.Course.ID ||
+		!stream.LiveNow || stream.LectureHallID != uint(lectureHallID) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "stream is not live in this lecture hall",
+		})
+		return
+	}
+	lectureHall, err = r.LectureHallsDao.GetLectureHallByID(uint(lectureHallID))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find lecture hall",
+			Err:           err,
+		})
+		return
+	}
+	if lectureHall.CameraIP == "" {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "lecture hall has no camera",
+		})
+		return
+	}
+	cam, err = r.presetUtility.ProvideCamera(lectureHall.CameraType, lectureHall.CameraIP)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not access camera",
+			Err:           err,
+		})
+		return
+	}
+	return lectureHall, cam, true
+}
+
 func (r lectureHallRoutes) takeSnapshot(c *gin.Context) {
 	preset, err := r.LectureHallsDao.FindPreset(c.Param("lectureHallID"), c.Param("presetID"))
 	if err != nil {
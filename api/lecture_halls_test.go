@@ -9,12 +9,16 @@ import (
 	"github.com/golang/mock/gomock"
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/mock_tools"
+	"github.com/joschahenningsen/TUM-Live/mock_tools/mock_camera"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/camera"
 	"github.com/joschahenningsen/TUM-Live/tools/testutils"
 	"github.com/matthiasreumann/gomino"
 	"html/template"
 	"net/http"
+	"net/http/httptest"
 	"testing"
 	"time"
 )
@@ -149,6 +153,44 @@ func TestLectureHallsCRUD(t *testing.T) {
 				ExpectedCode: http.StatusInternalServerError,
 				Body:         updateLectureHallReq{CamIp: "0.0.0.0"},
 			},
+			"invalid camera type": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						LectureHallsDao: func() dao.LectureHallsDao {
+							lectureHallMock := mock_dao.NewMockLectureHallsDao(gomock.NewController(t))
+							lectureHallMock.
+								EXPECT().
+								GetLectureHallByID(testutils.LectureHall.ID).
+								Return(testutils.LectureHall, nil).
+								AnyTimes()
+							return lectureHallMock
+						}(),
+					}
+					configGinLectureHallApiRouter(r, wrapper, testutils.GetPresetUtilityMock(ctrl))
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+				Body:         updateLectureHallReq{CamIp: "0.0.0.0", CameraType: 99},
+			},
+			"unknown encoding profile": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						LectureHallsDao: func() dao.LectureHallsDao {
+							lectureHallMock := mock_dao.NewMockLectureHallsDao(gomock.NewController(t))
+							lectureHallMock.
+								EXPECT().
+								GetLectureHallByID(testutils.LectureHall.ID).
+								Return(testutils.LectureHall, nil).
+								AnyTimes()
+							return lectureHallMock
+						}(),
+					}
+					configGinLectureHallApiRouter(r, wrapper, testutils.GetPresetUtilityMock(ctrl))
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+				Body:         updateLectureHallReq{CamIp: "0.0.0.0", EncodingProfile: "unknown"},
+			},
 			"success": {
 				Router: func(r *gin.Engine) {
 					wrapper := dao.DaoWrapper{
@@ -1144,3 +1186,165 @@ func TestLectureHallSetLH(t *testing.T) {
 			Run(t, testutils.Equal)
 	})
 }
+
+// cameraControlRouter returns a router in which the stream of the request is stream and the camera of the lecture
+// hall is cam. The lecture hall has the preset 1.
+func cameraControlRouter(t *testing.T, stream model.Stream, cam camera.Cam, audits int) func(r *gin.Engine) {
+	return func(r *gin.Engine) {
+		ctrl := gomock.NewController(t)
+		lectureHall := testutils.LectureHall
+		lectureHall.CameraPresets = []model.CameraPreset{{Name: "Desk", PresetID: 1, LectureHallID: lectureHall.ID}}
+
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), fmt.Sprintf("%d", testutils.StreamFPVLive.ID)).Return(stream, nil).AnyTimes()
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), testutils.CourseFPV.ID).Return(testutils.CourseFPV, nil).AnyTimes()
+		lectureHallMock := mock_dao.NewMockLectureHallsDao(ctrl)
+		lectureHallMock.EXPECT().GetLectureHallByID(lectureHall.ID).Return(lectureHall, nil).AnyTimes()
+		lectureHallMock.EXPECT().SavePreset(model.CameraPreset{Name: "Board", PresetID: 2, LectureHallID: lectureHall.ID}).Return(nil).AnyTimes()
+		auditMock := mock_dao.NewMockAuditDao(ctrl)
+		auditMock.EXPECT().Create(gomock.Any()).Return(nil).Times(audits)
+		presetUtility := mock_tools.NewMockPresetUtility(ctrl)
+		presetUtility.EXPECT().ProvideCamera(lectureHall.CameraType, lectureHall.CameraIP).Return(cam, nil).AnyTimes()
+
+		wrapper := dao.DaoWrapper{StreamsDao: streamsMock, CoursesDao: coursesMock, LectureHallsDao: lectureHallMock, AuditDao: auditMock}
+		configGinLectureHallApiRouter(r, wrapper, presetUtility)
+	}
+}
+
+func TestLectureHallCameraControl(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	middlewares := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+	otherLectureHall := testutils.StreamFPVLive
+	otherLectureHall.LectureHallID = testutils.LectureHall.ID + 1
+
+	t.Run("POST/moveCamera/:lectureHallID/:streamID", func(t *testing.T) {
+		url := fmt.Sprintf("/api/course/%d/moveCamera/%d/%d", testutils.CourseFPV.ID, testutils.LectureHall.ID, testutils.StreamFPVLive.ID)
+		gomino.TestCases{
+			"stream not live": {
+				Router:       cameraControlRouter(t, testutils.StreamFPVNotLive, nil, 0),
+				Middlewares:  middlewares,
+				Body:         moveCameraRequest{Pan: 0.1},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"stream live in other lecture hall": {
+				Router:       cameraControlRouter(t, otherLectureHall, nil, 0),
+				Middlewares:  middlewares,
+				Body:         moveCameraRequest{Pan: 0.1},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"invalid move": {
+				Router:       cameraControlRouter(t, testutils.StreamFPVLive, nil, 0),
+				Middlewares:  middlewares,
+				Body:         moveCameraRequest{Pan: 2},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"camera error": {
+				Router: func(r *gin.Engine) {
+					cam := mock_camera.NewMockCam(gomock.NewController(t))
+					cam.EXPECT().Move(0.1, 0.0, 0.0).Return(errors.New("unreachable"))
+					cameraControlRouter(t, testutils.StreamFPVLive, cam, 0)(r)
+				},
+				Middlewares:  middlewares,
+				Body:         moveCameraRequest{Pan: 0.1},
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					cam := mock_camera.NewMockCam(gomock.NewController(t))
+					cam.EXPECT().Move(0.0, -0.05, 0.2).Return(nil)
+					cameraControlRouter(t, testutils.StreamFPVLive, cam, 1)(r)
+				},
+				Middlewares:  middlewares,
+				Body:         moveCameraRequest{Tilt: -0.05, Zoom: 0.2},
+				ExpectedCode: http.StatusOK,
+			}}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("POST/saveCameraPreset/:lectureHallID/:streamID", func(t *testing.T) {
+		url := fmt.Sprintf("/api/course/%d/saveCameraPreset/%d/%d", testutils.CourseFPV.ID, testutils.LectureHall.ID, testutils.StreamFPVLive.ID)
+		gomino.TestCases{
+			"stream not live": {
+				Router:       cameraControlRouter(t, testutils.StreamFPVNotLive, nil, 0),
+				Middlewares:  middlewares,
+				Body:         saveCameraPresetRequest{Name: "Board"},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"empty name": {
+				Router:       cameraControlRouter(t, testutils.StreamFPVLive, nil, 0),
+				Middlewares:  middlewares,
+				Body:         saveCameraPresetRequest{Name: " "},
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					cam := mock_camera.NewMockCam(gomock.NewController(t))
+					cam.EXPECT().SavePreset(2, "Board").Return(nil)
+					cameraControlRouter(t, testutils.StreamFPVLive, cam, 1)(r)
+				},
+				Middlewares:      middlewares,
+				Body:             saveCameraPresetRequest{Name: "Board"},
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: gin.H{"presetID": 2, "name": "Board", "lectureHallID": testutils.LectureHall.ID},
+			}}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestLectureHallLiveLight(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	var states []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		states = append(states, r.URL.Query().Get("turn"))
+	}))
+	defer srv.Close()
+
+	withLight := testutils.LectureHall
+	withLight.PwrCtrlIp = srv.URL + "/relay/{index}?turn={state}"
+	withLight.LiveIndicator = model.HTTPIndicator
+	withoutLight := testutils.LectureHall
+	withoutLight.PwrCtrlIp = ""
+
+	router := func(lectureHall model.LectureHall, err error) func(r *gin.Engine) {
+		return func(r *gin.Engine) {
+			lectureHallMock := mock_dao.NewMockLectureHallsDao(gomock.NewController(t))
+			lectureHallMock.EXPECT().GetLectureHallByID(testutils.LectureHall.ID).Return(lectureHall, err).AnyTimes()
+			configGinLectureHallApiRouter(r, dao.DaoWrapper{LectureHallsDao: lectureHallMock}, testutils.GetPresetUtilityMock(gomock.NewController(t)))
+		}
+	}
+	middlewares := testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin))
+
+	gomino.TestCases{
+		"lecture hall not found": {
+			Router:       router(model.LectureHall{}, errors.New("not found")),
+			Middlewares:  middlewares,
+			Body:         gin.H{"on": true},
+			ExpectedCode: http.StatusNotFound,
+		},
+		"no live light": {
+			Router:       router(withoutLight, nil),
+			Middlewares:  middlewares,
+			Body:         gin.H{"on": true},
+			ExpectedCode: http.StatusBadRequest,
+		},
+		"success": {
+			Router:       router(withLight, nil),
+			Middlewares:  middlewares,
+			Body:         gin.H{"on": true},
+			ExpectedCode: http.StatusOK,
+		}}.
+		Method(http.MethodPost).
+		Url(fmt.Sprintf("/api/lectureHall/%d/liveLight", testutils.LectureHall.ID)).
+		Run(t, testutils.Equal)
+
+	if len(states) != 1 || states[0] != "on" {
+		t.Errorf("expected the light to be turned on once, got %v", states)
+	}
+}
@@ -0,0 +1,134 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"sync"
+)
+
+// signalStates holds the last reported live signal of the sources of live streams by stream id and source type
+var (
+	signalStates     = map[uint]map[string]*pb.SignalStatus{}
+	signalStatesLock sync.Mutex
+)
+
+// NotifySignalStatus handles a change of the live signal of a source, e.g. a black presentation or silent audio.
+func (s server) NotifySignalStatus(ctx context.Context, request *pb.SignalStatus) (*pb.Status, error) {
+	if _, err := s.DaoWrapper.WorkerDao.GetWorkerByID(ctx, request.GetWorkerID()); err != nil {
+		return nil, errors.New("authentication failed: invalid worker id")
+	}
+	stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", request.GetStreamID()))
+	if err != nil {
+		return nil, fmt.Errorf("get stream: %w", err)
+	}
+	course, err := s.CoursesDao.GetCourseById(ctx, stream.CourseID)
+	if err != nil {
+		return nil, fmt.Errorf("get course: %w", err)
+	}
+	handleSignalStatus(s.DaoWrapper, stream, course, request)
+	return &pb.Status{Ok: true}, nil
+}
+
+// publishedSource returns the source that is shown to viewers by default according to the course's source mode.
+func publishedSource(course model.Course, lectureHallID uint) string {
+	switch course.GetSourceModeForLectureHall(lectureHallID) {
+	case 1:
+		return "PRES"
+	case 2:
+		return "CAM"
+	default:
+		return "COMB"
+	}
+}
+
+// handleSignalStatus compares the signal with the last one reported for the source. If the presentation turns black,
+// viewers are switched to the camera if possible, otherwise the course admins are warned. A frozen presentation is
+// usually just a slide that isn't changed, so admins are only warned about it. Admins are also warned about silence on
+// the published source.
+func handleSignalStatus(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course, status *pb.SignalStatus) {
+	signalStatesLock.Lock()
+	if signalStates[stream.ID] == nil {
+		signalStates[stream.ID] = map[string]*pb.SignalStatus{}
+	}
+	previous, ok := signalStates[stream.ID][status.GetSourceType()]
+	signalStates[stream.ID][status.GetSourceType()] = status
+	signalStatesLock.Unlock()
+	if !ok {
+		previous = &pb.SignalStatus{}
+	}
+
+	if status.GetSourceType() == "PRES" {
+		if status.GetBlack() && !previous.GetBlack() {
+			handlePresentationLost(daoWrapper, stream, course)
+		} else if !status.GetBlack() && (previous.GetBlack() || stream.LiveSource != "") {
+			handlePresentationBack(daoWrapper, stream)
+		}
+		if status.GetFrozen() && !previous.GetFrozen() {
+			sendAdminServerMessage(stream.ID, "The presentation didn't change for a while. Please check that it isn't frozen.", TypeServerWarn)
+		}
+	}
+
+	if status.GetSourceType() != publishedSource(course, stream.LectureHallID) {
+		return
+	}
+	if status.GetSilent() && !previous.GetSilent() {
+		sendAdminServerMessage(stream.ID, "The audio of the stream is silent. Please check the microphone.", TypeServerWarn)
+	} else if !status.GetSilent() && previous.GetSilent() {
+		sendAdminServerMessage(stream.ID, "The audio of the stream is back.", TypeServerInfo)
+	}
+}
+
+func handlePresentationLost(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course) {
+	log.WithField("stream", stream.ID).Warn("presentation lost")
+	// only viewers of the combined view can be switched, presentation only courses have no camera stream
+	if publishedSource(course, stream.LectureHallID) != "COMB" || stream.PlaylistUrlCAM == "" || stream.LiveSource != "" {
+		sendAdminServerMessage(stream.ID, "The presentation is black. Please check the presentation source in the lecture hall.", TypeServerWarn)
+		return
+	}
+	if err := daoWrapper.StreamsDao.SetLiveSource(stream.ID, "CAM"); err != nil {
+		log.WithError(err).Error("Can't switch live source")
+		sendAdminServerMessage(stream.ID, "The presentation is black. Please check the presentation source in the lecture hall.", TypeServerWarn)
+		return
+	}
+	notifyViewersLiveSource(stream.ID, "CAM")
+	sendAdminServerMessage(stream.ID, "The presentation is black. Viewers were switched to the camera until it is back.", TypeServerWarn)
+}
+
+func handlePresentationBack(daoWrapper dao.DaoWrapper, stream model.Stream) {
+	if stream.LiveSource == "" {
+		sendAdminServerMessage(stream.ID, "The presentation is back.", TypeServerInfo)
+		return
+	}
+	if err := daoWrapper.StreamsDao.SetLiveSource(stream.ID, ""); err != nil {
+		log.WithError(err).Error("Can't reset live source")
+		return
+	}
+	notifyViewersLiveSource(stream.ID, "")
+	sendAdminServerMessage(stream.ID, "The presentation is back. Viewers were switched back.", TypeServerInfo)
+}
+
+// clearSignalStates forgets the live signal of a stream once it ended
+func clearSignalStates(streamID uint) {
+	signalStatesLock.Lock()
+	defer signalStatesLock.Unlock()
+	delete(signalStates, streamID)
+}
+
+// notifyViewersLiveSource tells viewers of the default version that the published source changed
+func notifyViewersLiveSource(streamID uint, source string) {
+	msg, _ := json.Marshal(gin.H{"liveSource": source})
+	broadcastStream(streamID, msg)
+}
+
+// sendAdminServerMessage sends a server message to the course admins watching the stream
+func sendAdminServerMessage(streamID uint, msg string, t string) {
+	msgBytes, _ := json.Marshal(gin.H{"server": msg, "type": t})
+	broadcastStreamToAdmins(streamID, msgBytes)
+}
@@ -0,0 +1,82 @@
+package api
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	"gorm.io/gorm"
+	"testing"
+)
+
+func TestPublishedSource(t *testing.T) {
+	tests := map[model.SourceMode]string{0: "COMB", 1: "PRES", 2: "CAM"}
+	for mode, expected := range tests {
+		course := model.Course{}
+		course.SetSourcePreference([]model.SourcePreference{{LectureHallID: 1, SourceMode: mode}})
+		if got := publishedSource(course, 1); got != expected {
+			t.Errorf("publishedSource(mode %d) = %s, want %s", mode, got, expected)
+		}
+	}
+}
+
+func TestHandleSignalStatus(t *testing.T) {
+	stream := model.Stream{Model: gorm.Model{ID: 77}, LectureHallID: 1, PlaylistUrlCAM: "https://cam.m3u8"}
+	presOnly := model.Course{}
+	presOnly.SetSourcePreference([]model.SourcePreference{{LectureHallID: 1, SourceMode: 1}})
+
+	t.Run("switch to camera and back", func(t *testing.T) {
+		defer clearSignalStates(stream.ID)
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		gomock.InOrder(
+			streamsMock.EXPECT().SetLiveSource(stream.ID, "CAM").Return(nil),
+			streamsMock.EXPECT().SetLiveSource(stream.ID, "").Return(nil),
+		)
+		daoWrapper := dao.DaoWrapper{StreamsDao: streamsMock}
+
+		handleSignalStatus(daoWrapper, stream, model.Course{}, &pb.SignalStatus{SourceType: "PRES", Black: true})
+		// reported again while still black: nothing changes
+		handleSignalStatus(daoWrapper, stream, model.Course{}, &pb.SignalStatus{SourceType: "PRES", Black: true, Silent: true})
+
+		switched := stream
+		switched.LiveSource = "CAM"
+		handleSignalStatus(daoWrapper, switched, model.Course{}, &pb.SignalStatus{SourceType: "PRES"})
+	})
+
+	t.Run("presentation only", func(t *testing.T) {
+		defer clearSignalStates(stream.ID)
+		// no camera to switch to, admins are only warned
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		handleSignalStatus(dao.DaoWrapper{StreamsDao: streamsMock}, stream, presOnly, &pb.SignalStatus{SourceType: "PRES", Black: true})
+	})
+
+	t.Run("frozen presentation", func(t *testing.T) {
+		defer clearSignalStates(stream.ID)
+		// a slide that isn't changed looks frozen, viewers stay on the presentation
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		handleSignalStatus(dao.DaoWrapper{StreamsDao: streamsMock}, stream, model.Course{}, &pb.SignalStatus{SourceType: "PRES", Frozen: true})
+		handleSignalStatus(dao.DaoWrapper{StreamsDao: streamsMock}, stream, model.Course{}, &pb.SignalStatus{SourceType: "PRES"})
+	})
+
+	t.Run("stale live source", func(t *testing.T) {
+		defer clearSignalStates(stream.ID)
+		// the source was switched before a restart of TUM-Live, the first good signal resets it
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streamsMock.EXPECT().SetLiveSource(stream.ID, "").Return(nil)
+		switched := stream
+		switched.LiveSource = "CAM"
+		handleSignalStatus(dao.DaoWrapper{StreamsDao: streamsMock}, switched, model.Course{}, &pb.SignalStatus{SourceType: "PRES"})
+	})
+
+	t.Run("silent combined view", func(t *testing.T) {
+		defer clearSignalStates(stream.ID)
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		handleSignalStatus(dao.DaoWrapper{StreamsDao: streamsMock}, stream, model.Course{}, &pb.SignalStatus{SourceType: "COMB", Silent: true})
+		signalStatesLock.Lock()
+		defer signalStatesLock.Unlock()
+		if !signalStates[stream.ID]["COMB"].GetSilent() {
+			t.Errorf("signal state of COMB not stored")
+		}
+	})
+}
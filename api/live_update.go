@@ -9,18 +9,25 @@ import (
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
 	"github.com/joschahenningsen/TUM-Live/tools/realtime"
 	"github.com/joschahenningsen/TUM-Live/tools/tum"
 	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strconv"
+	"strings"
 	"sync"
 )
 
 const (
-	LiveUpdateRoomName       = "live-update"
+	LiveUpdateRoomName       = "live-update/:userID" // users that aren't logged in share the path of user 0
 	UpdateTypeCourseWentLive = "course_went_live"
 )
 
 var liveUpdateListenerMutex sync.RWMutex
+
+// liveUpdateListener contains the courses of users that subscribed to live updates. Users without sessions are kept
+// while their subscription can be resumed, so that they get the updates they missed after reconnecting.
 var liveUpdateListener = map[uint]*liveUpdateUserSessionsWrapper{}
 
 type liveUpdateUserSessionsWrapper struct {
@@ -30,12 +37,37 @@ type liveUpdateUserSessionsWrapper struct {
 
 func RegisterLiveUpdateRealtimeChannel() {
 	RealtimeInstance.RegisterChannel(LiveUpdateRoomName, realtime.ChannelHandlers{
-		OnSubscribe:   liveUpdateOnSubscribe,
-		OnUnsubscribe: liveUpdateOnUnsubscribe,
+		SubscriptionMiddlewares: []realtime.SubscriptionMiddleware{liveUpdateOwnPath},
+		OnSubscribe:             liveUpdateOnSubscribe,
+		OnUnsubscribe:           liveUpdateOnUnsubscribe,
 	})
 }
 
+// liveUpdatePath returns the channel path of the live updates of the user
+func liveUpdatePath(userId uint) string {
+	return strings.Replace(LiveUpdateRoomName, ":userID", strconv.Itoa(int(userId)), -1)
+}
+
+// liveUpdateOwnPath only allows users to subscribe to their own live updates
+func liveUpdateOwnPath(psc *realtime.Context) *realtime.Error {
+	ctx, _ := psc.Client.Get("ctx") // get gin context
+	foundContext, exists := ctx.(*gin.Context).Get("TUMLiveContext")
+	if !exists {
+		return realtime.NewError(http.StatusBadRequest, "context should exist but doesn't")
+	}
+	var userId uint = 0
+	if user := foundContext.(tools.TUMLiveContext).User; user != nil {
+		userId = user.ID
+	}
+	if psc.FullPath != liveUpdatePath(userId) {
+		return realtime.NewError(http.StatusForbidden, "forbidden to subscribe to live updates of other users")
+	}
+	return nil
+}
+
 func liveUpdateOnUnsubscribe(psc *realtime.Context) {
+	metrics.RealtimeConnections.WithLabelValues(LiveUpdateRoomName).Dec()
+
 	ctx, _ := psc.Client.Get("ctx") // get gin context
 	foundContext, exists := ctx.(*gin.Context).Get("TUMLiveContext")
 	if !exists {
@@ -52,20 +84,22 @@ func liveUpdateOnUnsubscribe(psc *realtime.Context) {
 
 	liveUpdateListenerMutex.Lock()
 	defer liveUpdateListenerMutex.Unlock()
+	if liveUpdateListener[userId] == nil {
+		return
+	}
 	var newSessions []*realtime.Context
 	for _, session := range liveUpdateListener[userId].sessions {
 		if session != psc {
 			newSessions = append(newSessions, session)
 		}
 	}
-	if len(newSessions) == 0 {
-		delete(liveUpdateListener, userId)
-	} else {
-		liveUpdateListener[userId].sessions = newSessions
-	}
+	// the courses are kept, the user may resume the subscription
+	liveUpdateListener[userId].sessions = newSessions
 }
 
 func liveUpdateOnSubscribe(psc *realtime.Context) {
+	metrics.RealtimeConnections.WithLabelValues(LiveUpdateRoomName).Inc()
+
 	ctx, _ := psc.Client.Get("ctx") // get gin context
 	daoWrapper, _ := psc.Client.Get("dao")
 
@@ -110,18 +144,25 @@ func liveUpdateOnSubscribe(psc *realtime.Context) {
 	liveUpdateListenerMutex.Unlock()
 }
 
+// NotifyLiveUpdateCourseWentLive broadcasts to the users of the course that it went live. Users that reconnect get the
+// update when resuming their subscription.
 func NotifyLiveUpdateCourseWentLive(courseId uint) {
 	updateMessage, _ := json.Marshal(gin.H{"type": UpdateTypeCourseWentLive, "data": gin.H{"courseId": courseId}})
 	liveUpdateListenerMutex.Lock()
-	for _, userWrap := range liveUpdateListener {
+	defer liveUpdateListenerMutex.Unlock()
+	for userId, userWrap := range liveUpdateListener {
+		path := liveUpdatePath(userId)
+		if len(userWrap.sessions) == 0 && !RealtimeInstance.IsResumable(path) {
+			delete(liveUpdateListener, userId)
+			continue
+		}
 		for _, course := range userWrap.courses {
 			if course == courseId {
-				for _, session := range userWrap.sessions {
-					_ = session.Send(updateMessage)
+				if err := RealtimeInstance.Broadcast(path, updateMessage); err != nil {
+					log.WithError(err).Warn("can't broadcast live update")
 				}
 				break
 			}
 		}
 	}
-	liveUpdateListenerMutex.Unlock()
 }
@@ -0,0 +1,94 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/realtime"
+	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+)
+
+func TestLiveUpdateResume(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	connector := realtime.NewConnector(nil)
+	oldInstance, oldListener := RealtimeInstance, liveUpdateListener
+	RealtimeInstance, liveUpdateListener = realtime.New(connector), map[uint]*liveUpdateUserSessionsWrapper{}
+	defer func() { RealtimeInstance, liveUpdateListener = oldInstance, oldListener }()
+	RegisterLiveUpdateRealtimeChannel()
+
+	coursesMock := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+	coursesMock.EXPECT().GetPublicAndLoggedInCourses(gomock.Any(), gomock.Any()).
+		Return([]model.Course{testutils.CourseFPV}, nil).AnyTimes()
+	daoWrapper := dao.DaoWrapper{CoursesDao: coursesMock}
+
+	// join connects a fake client of the user that stores the received messages in received
+	join := func(user *model.User, received *[]realtime.Message) *realtime.Client {
+		c, _ := gin.CreateTestContext(httptest.NewRecorder())
+		c.Set("TUMLiveContext", tools.TUMLiveContext{User: user})
+		return connector.Join(func(msg []byte) error {
+			var m realtime.Message
+			if err := json.Unmarshal(msg, &m); err != nil {
+				t.Errorf("could not unmarshal message: %v", err)
+			}
+			*received = append(*received, m)
+			return nil
+		}, map[string]interface{}{"ctx": c, "dao": daoWrapper})
+	}
+	subscribe := func(client *realtime.Client, path string, resume *realtime.ResumeRequest) {
+		message := realtime.Message{Type: realtime.MessageTypeSubscribe, Channel: path}
+		if resume != nil {
+			message.Payload, _ = json.Marshal(resume)
+		}
+		data, _ := json.Marshal(message)
+		connector.Message(client.Id, data)
+	}
+
+	studentPath := liveUpdatePath(testutils.Student.ID)
+	var received []realtime.Message
+	client := join(&testutils.Student, &received)
+	subscribe(client, studentPath, nil)
+	if len(received) != 1 || received[0].Type != realtime.MessageTypeSubscribed {
+		t.Fatalf("received = %+v, want subscription of %s", received, studentPath)
+	}
+	var state realtime.SubscriptionState
+	_ = json.Unmarshal(received[0].Payload, &state)
+
+	var receivedOther []realtime.Message
+	other := join(&testutils.Student, &receivedOther)
+	subscribe(other, liveUpdatePath(testutils.Admin.ID), nil)
+	if len(receivedOther) != 1 || receivedOther[0].Type == realtime.MessageTypeSubscribed {
+		t.Errorf("subscription to live updates of other user wasn't rejected: %+v", receivedOther)
+	}
+
+	connector.Leave(client.Id)
+	NotifyLiveUpdateCourseWentLive(testutils.CourseFPV.ID)
+
+	received = nil
+	client = join(&testutils.Student, &received)
+	subscribe(client, studentPath, &realtime.ResumeRequest{ResumeToken: state.ResumeToken, LastSeq: state.Seq})
+	if len(received) != 2 {
+		t.Fatalf("len(received) = %d, want %d", len(received), 2)
+	}
+	_ = json.Unmarshal(received[0].Payload, &state)
+	if !state.Resumed {
+		t.Errorf("state = %+v, want resumed subscription", state)
+	}
+	var update struct {
+		Type string `json:"type"`
+		Data struct {
+			CourseId uint `json:"courseId"`
+		} `json:"data"`
+	}
+	_ = json.Unmarshal(received[1].Payload, &update)
+	if update.Type != UpdateTypeCourseWentLive || update.Data.CourseId != testutils.CourseFPV.ID {
+		t.Errorf("replayed update = %+v, want %s of course %d", update, UpdateTypeCourseWentLive, testutils.CourseFPV.ID)
+	}
+}
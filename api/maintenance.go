@@ -20,6 +20,7 @@ func configMaintenanceRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 		g.GET("/generateThumbnails/status", routes.getThumbGenProgress)
 		g.GET("/transcodingFailures", routes.getTranscodingFailures)
 		g.DELETE("/transcodingFailures/:id", routes.deleteTranscodingFailure)
+		g.GET("/archival", routes.getArchivalSavings)
 	}
 
 	cronGroup := g.Group("/cron")
@@ -142,3 +143,30 @@ func (r *maintenanceRoutes) deleteTranscodingFailure(c *gin.Context) {
 		})
 	}
 }
+
+// getArchivalSavings reports the archived VoDs by codec and state and the space saved by archiving them
+func (r *maintenanceRoutes) getArchivalSavings(c *gin.Context) {
+	stats, err := r.VodArchiveDao.GetArchiveStats()
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "Can't get archival stats",
+			Err:           err,
+		})
+		return
+	}
+	var saved int64
+	for _, s := range stats {
+		if s.State == model.VodArchiveDone {
+			saved += s.OriginalSize - s.ArchiveSize - s.FallbackSize
+		}
+	}
+	settings, enabled := archivalConfig()
+	c.JSON(http.StatusOK, gin.H{
+		"enabled":    enabled,
+		"codec":      settings.codec,
+		"minAgeDays": settings.minAgeDays,
+		"stats":      stats,
+		"savedBytes": saved,
+	})
+}
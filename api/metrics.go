@@ -0,0 +1,29 @@
+package api
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
+)
+
+// defaultAdminAddr is the address of the admin listener if none is configured
+const defaultAdminAddr = "127.0.0.1:8082"
+
+// AdminServer serves endpoints for operators, e.g. prometheus metrics, on a listener separate from the public router.
+// Requests authenticate with an admin token in the Authorization header, e.g. "Authorization: Bearer <token>".
+func AdminServer(daoWrapper dao.DaoWrapper) error {
+	addr := defaultAdminAddr
+	if tools.Cfg.Admin != nil && tools.Cfg.Admin.Addr != "" {
+		addr = tools.Cfg.Admin.Addr
+	}
+	return newAdminRouter(daoWrapper).Run(addr)
+}
+
+func newAdminRouter(daoWrapper dao.DaoWrapper) *gin.Engine {
+	router := gin.New()
+	router.Use(gin.Recovery())
+	admin := router.Group("/admin", tools.AdminBearerToken(daoWrapper))
+	admin.GET("/metrics", metrics.Handler())
+	return router
+}
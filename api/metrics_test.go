@@ -0,0 +1,43 @@
+package api
+
+import (
+	"errors"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAdminRouter(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	tokenMock := mock_dao.NewMockTokenDao(ctrl)
+	adminToken := model.Token{Token: "admin", Scope: model.TokenScopeAdmin}
+	tokenMock.EXPECT().GetToken("admin").Return(adminToken, nil).AnyTimes()
+	tokenMock.EXPECT().GetToken("unknown").Return(model.Token{}, errors.New("not found")).AnyTimes()
+	tokenMock.EXPECT().TokenUsed(adminToken).Return(nil).AnyTimes()
+	router := newAdminRouter(dao.DaoWrapper{TokenDao: tokenMock})
+
+	tests := []struct {
+		url, auth string
+		status    int
+	}{
+		{"/admin/metrics", "Bearer admin", http.StatusOK},
+		{"/admin/metrics", "Bearer unknown", http.StatusForbidden},
+		{"/admin/metrics", "admin", http.StatusUnauthorized},
+		{"/admin/metrics?token=admin", "", http.StatusUnauthorized},
+	}
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		req, _ := http.NewRequest(http.MethodGet, test.url, nil)
+		if test.auth != "" {
+			req.Header.Set("Authorization", test.auth)
+		}
+		router.ServeHTTP(w, req)
+		if w.Code != test.status {
+			t.Errorf("GET %s with %q = %d, want %d", test.url, test.auth, w.Code, test.status)
+		}
+	}
+}
@@ -0,0 +1,308 @@
+package api
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	uuid "github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+	"gorm.io/gorm"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+func configGinPodcastRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
+	routes := podcastRoutes{daoWrapper}
+	router.GET("/api/podcast/:year/:term/:slug/feed.xml", routes.getFeed)
+	router.GET("/api/podcast/audio/:id", routes.getAudio)
+	router.GET("/api/podcast/token", tools.LoggedIn, routes.getToken)
+}
+
+type podcastRoutes struct {
+	dao.DaoWrapper
+}
+
+var podcastErr = tools.RequestError{
+	Status:        http.StatusForbidden,
+	CustomMessage: "not allowed to access podcast",
+}
+
+// authorize checks whether the request may access the podcast of the course. Public courses are accessible without
+// token, all others require the podcast token of a user that is allowed to watch the course.
+// The token of the request is returned, so it can be added to the urls of the audio files.
+func (r podcastRoutes) authorize(c *gin.Context, course model.Course) (string, bool) {
+	if !course.VODEnabled {
+		return "", false
+	}
+	token := c.Query("token")
+	if course.Visibility == "public" {
+		return token, true
+	}
+	if token == "" {
+		return "", false
+	}
+	t, err := r.TokenDao.GetToken(token)
+	if err != nil || t.Scope != model.TokenScopePodcast {
+		return "", false
+	}
+	user, err := r.UsersDao.GetUserByID(c, t.UserID)
+	if err != nil || !user.IsEligibleToWatchCourse(course) {
+		return "", false
+	}
+	if err = r.TokenDao.TokenUsed(t); err != nil {
+		log.WithError(err).Warn("error marking token as used")
+	}
+	return token, true
+}
+
+func (r podcastRoutes) getFeed(c *gin.Context) {
+	slug, term := c.Param("slug"), c.Param("term")
+	year, err := strconv.Atoi(c.Param("year"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadRequest,
+			CustomMessage: "invalid year",
+			Err:           err,
+		})
+		return
+	}
+	course, err := r.CoursesDao.GetCourseBySlugYearAndTerm(c, slug, term, year)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find course",
+			Err:           err,
+		})
+		return
+	}
+	token, ok := r.authorize(c, course)
+	if !ok {
+		_ = c.Error(podcastErr)
+		return
+	}
+	streams, err := r.StreamsDao.GetPodcastStreams(c, course.ID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get recordings",
+			Err:           err,
+		})
+		return
+	}
+
+	c.Header("Content-Type", "application/rss+xml; charset=utf-8")
+	c.String(http.StatusOK, xml.Header)
+	if err = xml.NewEncoder(c.Writer).Encode(newPodcastFeed(course, streams, token)); err != nil {
+		log.WithError(err).Error("can not encode podcast feed")
+	}
+}
+
+func (r podcastRoutes) getAudio(c *gin.Context) {
+	file, err := r.FileDao.GetFileById(c.Param("id"))
+	if err != nil || file.Type != model.FILETYPE_AUDIO {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find file",
+			Err:           err,
+		})
+		return
+	}
+	stream, err := r.StreamsDao.GetStreamByID(c, fmt.Sprintf("%d", file.StreamID))
+	if err != nil || stream.Private {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find stream",
+			Err:           err,
+		})
+		return
+	}
+	course, err := r.CoursesDao.GetCourseById(c, stream.CourseID)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get course",
+			Err:           err,
+		})
+		return
+	}
+	if _, ok := r.authorize(c, course); !ok {
+		_ = c.Error(podcastErr)
+		return
+	}
+	// the path is reported by the worker, don't serve files outside the storage
+	if !inStorage(file.Path) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find file",
+		})
+		return
+	}
+	if _, err = os.Stat(file.Path); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not open file",
+			Err:           err,
+		})
+		return
+	}
+	c.Header("Content-Type", "audio/mp4")
+	c.File(file.Path)
+}
+
+// inStorage returns true if path is located in the mass storage
+func inStorage(path string) bool {
+	if tools.Cfg.Paths.Mass == "" {
+		return false
+	}
+	storage := filepath.Clean(tools.Cfg.Paths.Mass) + string(filepath.Separator)
+	return strings.HasPrefix(filepath.Clean(path), storage)
+}
+
+// getToken returns the private podcast token of the user and creates one if the user has none yet
+func (r podcastRoutes) getToken(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	token, err := r.TokenDao.GetUserToken(tumLiveContext.User.ID, model.TokenScopePodcast)
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get token",
+			Err:           err,
+		})
+		return
+	}
+	if err != nil {
+		token = model.Token{
+			UserID: tumLiveContext.User.ID,
+			Token:  uuid.NewV4().String(),
+			Scope:  model.TokenScopePodcast,
+		}
+		if err = r.TokenDao.AddToken(token); err != nil {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusInternalServerError,
+				CustomMessage: "can not create token",
+				Err:           err,
+			})
+			return
+		}
+	}
+	c.JSON(http.StatusOK, gin.H{"token": token.Token})
+}
+
+// podcastFeed is a RSS 2.0 feed with the iTunes podcast extension and podlove simple chapters
+type podcastFeed struct {
+	XMLName xml.Name       `xml:"rss"`
+	Version string         `xml:"version,attr"`
+	Itunes  string         `xml:"xmlns:itunes,attr"`
+	Psc     string         `xml:"xmlns:psc,attr"`
+	Channel podcastChannel `xml:"channel"`
+}
+
+type podcastChannel struct {
+	Title       string        `xml:"title"`
+	Link        string        `xml:"link"`
+	Description string        `xml:"description"`
+	Explicit    string        `xml:"itunes:explicit"`
+	Items       []podcastItem `xml:"item"`
+}
+
+type podcastItem struct {
+	Title       string           `xml:"title"`
+	Link        string           `xml:"link"`
+	Description string           `xml:"description,omitempty"`
+	GUID        podcastGUID      `xml:"guid"`
+	PubDate     string           `xml:"pubDate"`
+	Enclosure   podcastEnclosure `xml:"enclosure"`
+	Duration    uint32           `xml:"itunes:duration,omitempty"` // seconds
+	Chapters    *podcastChapters `xml:"psc:chapters,omitempty"`
+}
+
+type podcastGUID struct {
+	IsPermaLink bool   `xml:"isPermaLink,attr"`
+	Value       string `xml:",chardata"`
+}
+
+type podcastEnclosure struct {
+	URL    string `xml:"url,attr"`
+	Length int64  `xml:"length,attr"`
+	Type   string `xml:"type,attr"`
+}
+
+type podcastChapters struct {
+	Version  string           `xml:"version,attr"`
+	Chapters []podcastChapter `xml:"psc:chapter"`
+}
+
+type podcastChapter struct {
+	Start string `xml:"start,attr"` // hh:mm:ss
+	Title string `xml:"title,attr"`
+}
+
+// newPodcastFeed creates the feed of the course. streams must have their audio file and video sections preloaded.
+// Streams whose audio file can not be read are omitted.
+func newPodcastFeed(course model.Course, streams []model.Stream, token string) podcastFeed {
+	channel := podcastChannel{
+		Title:       course.Name,
+		Link:        tools.Cfg.WebUrl + course.GetUrl(),
+		Description: fmt.Sprintf("Lecture recordings of %s (%s %d)", course.Name, course.TeachingTerm, course.Year),
+		Explicit:    "false",
+		Items:       []podcastItem{},
+	}
+	for _, s := range streams {
+		var audio *model.File
+		for i := range s.Files {
+			if s.Files[i].Type == model.FILETYPE_AUDIO {
+				audio = &s.Files[i]
+			}
+		}
+		if audio == nil {
+			continue
+		}
+		stat, err := os.Stat(audio.Path)
+		if err != nil {
+			log.WithError(err).WithField("file", audio.ID).Warn("can not read podcast audio file")
+			continue
+		}
+		channel.Items = append(channel.Items, newPodcastItem(course, s, *audio, stat.Size(), token))
+	}
+	return podcastFeed{
+		Version: "2.0",
+		Itunes:  "http://www.itunes.com/dtds/podcast-1.0.dtd",
+		Psc:     "http://podlove.org/simple-chapters",
+		Channel: channel,
+	}
+}
+
+func newPodcastItem(course model.Course, stream model.Stream, audio model.File, size int64, token string) podcastItem {
+	audioURL := fmt.Sprintf("%s/api/podcast/audio/%d", tools.Cfg.WebUrl, audio.ID)
+	if token != "" {
+		audioURL += "?token=" + url.QueryEscape(token)
+	}
+	item := podcastItem{
+		Title:       stream.GetName(),
+		Link:        tools.Cfg.WebUrl + course.GetStreamUrl(stream),
+		Description: stream.Description,
+		GUID:        podcastGUID{Value: fmt.Sprintf("%s/api/podcast/audio/%d", tools.Cfg.WebUrl, audio.ID)},
+		PubDate:     stream.Start.Format(time.RFC1123Z),
+		Enclosure:   podcastEnclosure{URL: audioURL, Length: size, Type: "audio/mp4"},
+		Duration:    stream.Duration,
+	}
+	if len(stream.VideoSections) > 0 {
+		item.Chapters = &podcastChapters{Version: "1.2"}
+		for _, section := range stream.VideoSections {
+			item.Chapters.Chapters = append(item.Chapters.Chapters, podcastChapter{
+				Start: fmt.Sprintf("%02d:%02d:%02d", section.StartHours, section.StartMinutes, section.StartSeconds),
+				Title: section.Description,
+			})
+		}
+	}
+	return item
+}
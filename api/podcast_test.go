@@ -0,0 +1,312 @@
+package api
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+	"github.com/matthiasreumann/gomino"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestPodcast(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	tools.Cfg.Paths.Mass = t.TempDir()
+	t.Cleanup(func() { tools.Cfg.Paths.Mass = "" })
+	audioPath := filepath.Join(tools.Cfg.Paths.Mass, "1.m4a")
+	if err := os.WriteFile(audioPath, []byte("audio"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	outsidePath := filepath.Join(t.TempDir(), "2.m4a")
+	if err := os.WriteFile(outsidePath, []byte("secret"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	audio := model.File{Model: gorm.Model{ID: 7}, StreamID: 1, Path: audioPath, Type: model.FILETYPE_AUDIO}
+	stream := model.Stream{
+		Model:         gorm.Model{ID: 1},
+		Name:          "Lecture 1",
+		CourseID:      40,
+		Start:         time.Date(2022, 10, 20, 10, 0, 0, 0, time.UTC),
+		Recording:     true,
+		Duration:      5400,
+		Files:         []model.File{audio},
+		VideoSections: []model.VideoSection{{Description: "Intro", StartMinutes: 1}},
+	}
+	publicCourse := testutils.CourseFPV
+	publicCourse.VODEnabled = true
+	enrolledCourse := publicCourse
+	enrolledCourse.Visibility = "enrolled"
+
+	year, term, slug := publicCourse.Year, publicCourse.TeachingTerm, publicCourse.Slug
+
+	coursesMock := func(course model.Course) dao.CoursesDao {
+		courseMock := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+		courseMock.EXPECT().GetCourseBySlugYearAndTerm(gomock.Any(), slug, term, year).Return(course, nil).AnyTimes()
+		courseMock.EXPECT().GetCourseById(gomock.Any(), course.ID).Return(course, nil).AnyTimes()
+		return courseMock
+	}
+	tokenMock := func(token model.Token) dao.TokenDao {
+		tokenMock := mock_dao.NewMockTokenDao(gomock.NewController(t))
+		tokenMock.EXPECT().GetToken(token.Token).Return(token, nil).AnyTimes()
+		tokenMock.EXPECT().TokenUsed(gomock.Any()).Return(nil).AnyTimes()
+		return tokenMock
+	}
+	usersMock := func(user model.User) dao.UsersDao {
+		usersMock := mock_dao.NewMockUsersDao(gomock.NewController(t))
+		usersMock.EXPECT().GetUserByID(gomock.Any(), user.ID).Return(user, nil).AnyTimes()
+		return usersMock
+	}
+	streamsMock := func() dao.StreamsDao {
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streamsMock.EXPECT().GetPodcastStreams(gomock.Any(), publicCourse.ID).Return([]model.Stream{stream}, nil).AnyTimes()
+		streamsMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(stream, nil).AnyTimes()
+		return streamsMock
+	}
+	filesMock := func() dao.FileDao {
+		filesMock := mock_dao.NewMockFileDao(gomock.NewController(t))
+		filesMock.EXPECT().GetFileById("7").Return(audio, nil).AnyTimes()
+		return filesMock
+	}
+	podcastToken := model.Token{UserID: testutils.Student.ID, Token: "podcast", Scope: model.TokenScopePodcast}
+	adminToken := model.Token{UserID: testutils.Student.ID, Token: "admin", Scope: model.TokenScopeAdmin}
+	enrolledStudent := testutils.Student
+	enrolledStudent.Courses = []model.Course{enrolledCourse}
+
+	t.Run("GET/api/podcast/:year/:term/:slug/feed.xml", func(t *testing.T) {
+		url := fmt.Sprintf("/api/podcast/%d/%s/%s/feed.xml", year, term, slug)
+
+		feed := func(token string) []byte {
+			var res bytes.Buffer
+			res.WriteString(xml.Header)
+			_ = xml.NewEncoder(&res).Encode(newPodcastFeed(publicCourse, []model.Stream{stream}, token))
+			return res.Bytes()
+		}
+
+		gomino.TestCases{
+			"invalid year": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{})
+				},
+				Url:          fmt.Sprintf("/api/podcast/abc/%s/%s/feed.xml", term, slug),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"can not get course": {
+				Router: func(r *gin.Engine) {
+					courseMock := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+					courseMock.EXPECT().GetCourseBySlugYearAndTerm(gomock.Any(), slug, term, year).Return(model.Course{}, errors.New("")).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: courseMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"vods disabled": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(testutils.CourseFPV)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"missing token": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(enrolledCourse)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"token with wrong scope": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(enrolledCourse), TokenDao: tokenMock(adminToken)})
+				},
+				Url:          url + "?token=admin",
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"user not enrolled": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{
+						CoursesDao: coursesMock(enrolledCourse),
+						TokenDao:   tokenMock(podcastToken),
+						UsersDao:   usersMock(testutils.Student),
+					})
+				},
+				Url:          url + "?token=podcast",
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"can not get recordings": {
+				Router: func(r *gin.Engine) {
+					streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+					streamsMock.EXPECT().GetPodcastStreams(gomock.Any(), publicCourse.ID).Return(nil, errors.New("")).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(publicCourse), StreamsDao: streamsMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success public": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{CoursesDao: coursesMock(publicCourse), StreamsDao: streamsMock()})
+				},
+				ExpectedHeader:   gomino.HttpHeader{"Content-Type": "application/rss+xml; charset=utf-8"},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: feed(""),
+			},
+			"success enrolled": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{
+						CoursesDao: coursesMock(enrolledCourse),
+						TokenDao:   tokenMock(podcastToken),
+						UsersDao:   usersMock(enrolledStudent),
+						StreamsDao: streamsMock(),
+					})
+				},
+				Url:              url + "?token=podcast",
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: feed("podcast"),
+			}}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/podcast/audio/:id", func(t *testing.T) {
+		gomino.TestCases{
+			"can not find file": {
+				Router: func(r *gin.Engine) {
+					filesMock := mock_dao.NewMockFileDao(gomock.NewController(t))
+					filesMock.EXPECT().GetFileById("7").Return(model.File{}, errors.New("")).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{FileDao: filesMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"not an audio file": {
+				Router: func(r *gin.Engine) {
+					filesMock := mock_dao.NewMockFileDao(gomock.NewController(t))
+					filesMock.EXPECT().GetFileById("7").Return(model.File{StreamID: 1, Path: audioPath, Type: model.FILETYPE_VOD}, nil).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{FileDao: filesMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"missing token": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{FileDao: filesMock(), StreamsDao: streamsMock(), CoursesDao: coursesMock(enrolledCourse)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusForbidden,
+			},
+			"outside of storage": {
+				Router: func(r *gin.Engine) {
+					filesMock := mock_dao.NewMockFileDao(gomock.NewController(t))
+					filesMock.EXPECT().GetFileById("7").Return(model.File{StreamID: 1, Path: outsidePath, Type: model.FILETYPE_AUDIO}, nil).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{FileDao: filesMock, StreamsDao: streamsMock(), CoursesDao: coursesMock(publicCourse)})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{FileDao: filesMock(), StreamsDao: streamsMock(), CoursesDao: coursesMock(publicCourse)})
+				},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: []byte("audio"),
+			}}.
+			Method(http.MethodGet).
+			Url("/api/podcast/audio/7").
+			Run(t, testutils.Equal)
+	})
+
+	t.Run("GET/api/podcast/token", func(t *testing.T) {
+		gomino.TestCases{
+			"not logged in": {
+				Router: func(r *gin.Engine) {
+					configGinPodcastRouter(r, dao.DaoWrapper{})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextUserNil)),
+				ExpectedCode: http.StatusFound,
+			},
+			"can not get token": {
+				Router: func(r *gin.Engine) {
+					tokenMock := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokenMock.EXPECT().GetUserToken(testutils.Student.ID, model.TokenScopePodcast).Return(model.Token{}, errors.New("")).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokenMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"existing token": {
+				Router: func(r *gin.Engine) {
+					tokenMock := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokenMock.EXPECT().GetUserToken(testutils.Student.ID, model.TokenScopePodcast).Return(podcastToken, nil).AnyTimes()
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokenMock})
+				},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: gin.H{"token": "podcast"},
+			},
+			"new token": {
+				Router: func(r *gin.Engine) {
+					tokenMock := mock_dao.NewMockTokenDao(gomock.NewController(t))
+					tokenMock.EXPECT().GetUserToken(testutils.Student.ID, model.TokenScopePodcast).Return(model.Token{}, gorm.ErrRecordNotFound).AnyTimes()
+					tokenMock.EXPECT().AddToken(gomock.Any()).Return(nil).Times(1)
+					configGinPodcastRouter(r, dao.DaoWrapper{TokenDao: tokenMock})
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextStudent)),
+				ExpectedCode: http.StatusOK,
+			}}.
+			Method(http.MethodGet).
+			Url("/api/podcast/token").
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestNewPodcastFeed(t *testing.T) {
+	audioPath := filepath.Join(t.TempDir(), "1.m4a")
+	if err := os.WriteFile(audioPath, []byte("audio"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	streams := []model.Stream{
+		{
+			Model:    gorm.Model{ID: 1},
+			Duration: 60,
+			Files:    []model.File{{Model: gorm.Model{ID: 7}, Path: audioPath, Type: model.FILETYPE_AUDIO}},
+			VideoSections: []model.VideoSection{
+				{Description: "Intro"},
+				{Description: "Recursion", StartHours: 1, StartMinutes: 2, StartSeconds: 3},
+			},
+		},
+		{
+			Model: gorm.Model{ID: 2},
+			Files: []model.File{{Model: gorm.Model{ID: 8}, Path: filepath.Join(t.TempDir(), "missing.m4a"), Type: model.FILETYPE_AUDIO}},
+		},
+	}
+
+	feed := newPodcastFeed(testutils.CourseFPV, streams, "secret")
+
+	assert.Equal(t, testutils.CourseFPV.Name, feed.Channel.Title)
+	assert.Len(t, feed.Channel.Items, 1, "streams with missing audio files should be omitted")
+	item := feed.Channel.Items[0]
+	assert.Equal(t, "/api/podcast/audio/7?token=secret", item.Enclosure.URL)
+	assert.Equal(t, int64(5), item.Enclosure.Length)
+	assert.Equal(t, "/api/podcast/audio/7", item.GUID.Value, "guid must not contain the private token")
+	assert.Equal(t, uint32(60), item.Duration)
+	assert.Equal(t, []podcastChapter{{Start: "00:00:00", Title: "Intro"}, {Start: "01:02:03", Title: "Recursion"}}, item.Chapters.Chapters)
+}
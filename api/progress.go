@@ -6,6 +6,7 @@ import (
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
 	"gorm.io/gorm"
 	"net/http"
 	"strconv"
@@ -47,6 +48,7 @@ func (b *progressBuffer) flush() error {
 	if len(b.progresses) == 0 {
 		return nil
 	}
+	metrics.ProgressFlushSize.Observe(float64(len(b.progresses)))
 	err := dao.Progress.SaveProgresses(b.progresses)
 	b.progresses = []model.StreamProgress{}
 	return err
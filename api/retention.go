@@ -0,0 +1,378 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"gorm.io/gorm"
+	"html/template"
+	"time"
+)
+
+// defaultRetentionNoticeDays is how many days before the deletion of recordings course admins are notified by default
+const defaultRetentionNoticeDays = 14
+
+// retentionNoticePeriod returns how long before the deletion of recordings course admins are notified
+func retentionNoticePeriod() time.Duration {
+	days := defaultRetentionNoticeDays
+	if tools.Cfg.Retention != nil && tools.Cfg.Retention.NoticeDays > 0 {
+		days = tools.Cfg.Retention.NoticeDays
+	}
+	return time.Hour * 24 * time.Duration(days)
+}
+
+// RetentionMailTmpl is the notification of a course admin about upcoming deletions
+type RetentionMailTmpl struct {
+	Name     string
+	Course   model.Course
+	Entirely bool // whether the streams are deleted entirely or only their recordings
+	Streams  []RetentionMailStream
+}
+
+// RetentionMailStream is a stream that's going to be deleted at DueAt
+type RetentionMailStream struct {
+	Stream model.Stream
+	DueAt  time.Time
+}
+
+// retentionEnforcer carries out the actions of retention policies, replaced in tests
+type retentionEnforcer struct {
+	deleteFiles         func(worker model.Worker, paths []string) error
+	deleteRawRecordings func(worker model.Worker, course model.Course, stream model.Stream) error
+	deleteVods          func(worker model.Worker, course model.Course, stream model.Stream) error
+	notify              func(admin model.User, mail RetentionMailTmpl) error
+}
+
+// EnforceRetention deletes recordings of courses according to their retention policies. Course admins are notified
+// before recordings are deleted. VoDs of courses that archive them are archived by ArchiveVoDs.
+func EnforceRetention(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		enforceRetention(daoWrapper, time.Now(), retentionNoticePeriod(), retentionEnforcer{
+			deleteFiles:         deleteFilesOnWorker,
+			deleteRawRecordings: deleteRawRecordingsOnWorker,
+			deleteVods:          deleteVodsOnWorker,
+			notify:              notifyRetention,
+		})
+	}
+}
+
+func enforceRetention(daoWrapper dao.DaoWrapper, now time.Time, noticePeriod time.Duration, enforcer retentionEnforcer) {
+	courses, err := daoWrapper.RetentionDao.GetRetainedCourses()
+	if err != nil {
+		log.WithError(err).Error("Can't get courses with retention policy")
+		return
+	}
+	workers := daoWrapper.WorkerDao.GetAliveWorkers()
+	for _, course := range courses {
+		if course.RetentionPolicy == model.RetentionDeleteRaw || course.RetentionPolicy == model.RetentionDelete {
+			deleteCourseRecordings(daoWrapper, course, now, noticePeriod, workers, enforcer)
+		}
+	}
+}
+
+// archiveRetainedCourses assigns the archival of VoDs of courses with model.RetentionArchive that are due to the idle
+// workers and returns the workers that are still idle afterwards
+func archiveRetainedCourses(daoWrapper dao.DaoWrapper, now time.Time, idle []model.Worker, settings archivalSettings, request archivalRequester) []model.Worker {
+	courses, err := daoWrapper.RetentionDao.GetRetainedCourses()
+	if err != nil {
+		log.WithError(err).Error("Can't get courses with retention policy")
+		return idle
+	}
+	for _, course := range courses {
+		if len(idle) == 0 {
+			break
+		}
+		if course.RetentionPolicy == model.RetentionArchive {
+			idle = archiveCourse(daoWrapper, course, now, idle, settings, request)
+		}
+	}
+	return idle
+}
+
+// archiveCourse assigns the archival of VoDs of the course that are due to the idle workers and returns the workers
+// that are still idle afterwards
+func archiveCourse(daoWrapper dao.DaoWrapper, course model.Course, now time.Time, idle []model.Worker, settings archivalSettings, request archivalRequester) []model.Worker {
+	for _, stream := range course.Streams {
+		if due, _ := course.RetentionDueAt(stream); stream.LiveNow || now.Before(due) {
+			continue
+		}
+		for _, file := range stream.Files {
+			if len(idle) == 0 {
+				return idle
+			}
+			if file.Type != model.FILETYPE_VOD || file.IsURL() {
+				continue
+			}
+			if _, err := daoWrapper.VodArchiveDao.GetArchiveByFileID(file.ID); !errors.Is(err, gorm.ErrRecordNotFound) {
+				if err != nil {
+					log.WithError(err).WithField("file", file.ID).Error("Can't get archival")
+				}
+				continue
+			}
+			if err := requestFileArchival(daoWrapper, idle[0], file, stream, course, settings, request); err != nil {
+				log.WithError(err).WithField("file", file.ID).Warn("Can't request archival")
+			}
+			idle = idle[1:]
+		}
+	}
+	return idle
+}
+
+// deleteCourseRecordings notifies the course admins about streams whose deletion is due within the notice period and
+// deletes the streams, or for model.RetentionDeleteRaw their raw recordings, that were announced before
+func deleteCourseRecordings(daoWrapper dao.DaoWrapper, course model.Course, now time.Time, noticePeriod time.Duration, workers []model.Worker, enforcer retentionEnforcer) {
+	notices, err := daoWrapper.RetentionDao.GetNotices(course.ID)
+	if err != nil {
+		log.WithError(err).WithField("course", course.ID).Error("Can't get retention notices")
+		return
+	}
+	noticed := make(map[uint]model.RetentionNotice, len(notices))
+	for _, notice := range notices {
+		noticed[notice.StreamID] = notice
+	}
+	var upcoming []RetentionMailStream
+	for _, stream := range course.Streams {
+		if stream.LiveNow || stream.End.After(now) {
+			continue
+		}
+		// streams that never went live have no raw recordings
+		if course.RetentionPolicy == model.RetentionDeleteRaw && stream.LiveNowTimestamp.IsZero() {
+			continue
+		}
+		due, _ := course.RetentionDueAt(stream)
+		notice, ok := noticed[stream.ID]
+		if !ok {
+			if now.Before(due.Add(-noticePeriod)) {
+				continue
+			}
+			// admins always get the full notice period, even if the policy was set after the stream was due
+			if due.Before(now.Add(noticePeriod)) {
+				due = now.Add(noticePeriod)
+			}
+			notice = model.RetentionNotice{StreamID: stream.ID, CourseID: course.ID, Policy: course.RetentionPolicy, DueAt: due}
+			if err = daoWrapper.RetentionDao.CreateNotice(&notice); err != nil {
+				log.WithError(err).WithField("stream", stream.ID).Error("Can't create retention notice")
+				continue
+			}
+			upcoming = append(upcoming, RetentionMailStream{Stream: stream, DueAt: due})
+			continue
+		}
+		if notice.Enforced || now.Before(due) || now.Before(notice.DueAt) {
+			continue
+		}
+		if course.RetentionPolicy == model.RetentionDeleteRaw {
+			if err = deleteRawRecordings(daoWrapper, course, stream, workers, enforcer.deleteRawRecordings); err != nil {
+				log.WithError(err).WithField("stream", stream.ID).Error("Can't delete raw recordings")
+				continue
+			}
+			// the notice stays, so that the admins aren't notified again
+			if err = daoWrapper.RetentionDao.SetNoticeEnforced(notice.ID); err != nil {
+				log.WithError(err).WithField("stream", stream.ID).Error("Can't update retention notice")
+			}
+			continue
+		}
+		if err = deleteStream(daoWrapper, course, stream, workers, enforcer); err != nil {
+			log.WithError(err).WithField("stream", stream.ID).Error("Can't delete stream")
+			continue
+		}
+		if err = daoWrapper.RetentionDao.DeleteNotice(notice.ID); err != nil {
+			log.WithError(err).WithField("stream", stream.ID).Error("Can't delete retention notice")
+		}
+	}
+	if len(upcoming) > 0 {
+		notifyRetentionAdmins(daoWrapper, course, upcoming, enforcer.notify)
+	}
+}
+
+// storedPaths returns the files of the stream in the storage, including the archives of its VoDs
+func storedPaths(daoWrapper dao.DaoWrapper, stream model.Stream) (paths []string) {
+	for _, file := range stream.Files {
+		if file.IsURL() {
+			continue
+		}
+		paths = append(paths, file.Path)
+		if file.Type != model.FILETYPE_VOD {
+			continue
+		}
+		if archive, err := daoWrapper.VodArchiveDao.GetArchiveByFileID(file.ID); err == nil && archive.ArchivePath != "" && archive.ArchivePath != file.Path {
+			paths = append(paths, archive.ArchivePath)
+		}
+	}
+	return paths
+}
+
+// deleteRawRecordings has all workers delete the raw recordings of the stream. Recordings stay on the worker that
+// recorded them, which isn't known after the stream. Files of the stream are kept.
+func deleteRawRecordings(daoWrapper dao.DaoWrapper, course model.Course, stream model.Stream, workers []model.Worker, deleteRaw func(worker model.Worker, course model.Course, stream model.Stream) error) error {
+	if len(workers) == 0 {
+		return errors.New("no workers available")
+	}
+	for _, worker := range workers {
+		if err := deleteRaw(worker, course, stream); err != nil {
+			return fmt.Errorf("delete raw recordings on %s: %w", worker.Host, err)
+		}
+	}
+	if err := daoWrapper.AuditDao.Create(&model.Audit{
+		Message: fmt.Sprintf("'%s': deleted raw recordings of stream %d (%s) after retention period", course.Name, stream.ID, stream.FriendlyTime()),
+		Type:    model.AuditRetention,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+	return nil
+}
+
+// deleteStream has the workers delete the vods of the stream from their vod storage and a worker delete the files of the
+// stream from the storage, then removes them and the stream from the database. Vods are stored by the worker that
+// recorded them, which isn't known after the stream, so all workers delete them.
+func deleteStream(daoWrapper dao.DaoWrapper, course model.Course, stream model.Stream, workers []model.Worker, enforcer retentionEnforcer) error {
+	paths := storedPaths(daoWrapper, stream)
+	if (stream.Recording || len(paths) > 0) && len(workers) == 0 {
+		return errors.New("no workers available")
+	}
+	if stream.Recording {
+		for _, worker := range workers {
+			if err := enforcer.deleteVods(worker, course, stream); err != nil {
+				return fmt.Errorf("delete vods on %s: %w", worker.Host, err)
+			}
+		}
+	}
+	if len(paths) > 0 {
+		if err := enforcer.deleteFiles(workers[getWorkerWithLeastWorkload(workers)], paths); err != nil {
+			return fmt.Errorf("delete files: %w", err)
+		}
+	}
+	for _, file := range stream.Files {
+		if file.IsURL() {
+			continue
+		}
+		if err := daoWrapper.FileDao.DeleteFile(file.ID); err != nil {
+			return fmt.Errorf("delete file %d: %w", file.ID, err)
+		}
+		if file.Type != model.FILETYPE_VOD {
+			continue
+		}
+		if archive, err := daoWrapper.VodArchiveDao.GetArchiveByFileID(file.ID); err == nil {
+			if err = daoWrapper.VodArchiveDao.DeleteArchive(archive.ID); err != nil {
+				log.WithError(err).WithField("file", file.ID).Error("Can't delete archival")
+			}
+		}
+	}
+	daoWrapper.StreamsDao.DeleteStream(fmt.Sprintf("%d", stream.ID))
+	if err := daoWrapper.AuditDao.Create(&model.Audit{
+		Message: fmt.Sprintf("'%s': deleted stream %d (%s) after retention period", course.Name, stream.ID, stream.FriendlyTime()),
+		Type:    model.AuditRetention,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+	return nil
+}
+
+// notifyRetentionAdmins mails the admins of the course about the upcoming deletion of streams
+func notifyRetentionAdmins(daoWrapper dao.DaoWrapper, course model.Course, upcoming []RetentionMailStream, notify func(admin model.User, mail RetentionMailTmpl) error) {
+	admins, err := daoWrapper.CoursesDao.GetCourseAdmins(course.ID)
+	if err != nil {
+		log.WithError(err).WithField("course", course.ID).Error("Can't get course admins")
+	}
+	notified := 0
+	for _, admin := range admins {
+		err = notify(admin, RetentionMailTmpl{
+			Name:     admin.Name,
+			Course:   course,
+			Entirely: course.RetentionPolicy == model.RetentionDelete,
+			Streams:  upcoming,
+		})
+		if err != nil {
+			log.WithError(err).WithField("user", admin.ID).Error("Can't send retention notice")
+			continue
+		}
+		notified++
+	}
+	if err = daoWrapper.AuditDao.Create(&model.Audit{
+		Message: fmt.Sprintf("'%s': notified %d admins about the deletion of %d streams", course.Name, notified, len(upcoming)),
+		Type:    model.AuditRetention,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+}
+
+func notifyRetention(admin model.User, mail RetentionMailTmpl) error {
+	if !admin.Email.Valid || admin.Email.String == "" {
+		return errors.New("admin has no email address")
+	}
+	templ, err := template.ParseFS(staticFS, "template/*.gotemplate")
+	if err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err = templ.ExecuteTemplate(&body, "mail-retention-notice.gotemplate", mail); err != nil {
+		return err
+	}
+	subject := fmt.Sprintf("Löschung von Aufzeichnungen %s | Deletion of recordings %s", mail.Course.Name, mail.Course.Name)
+	return tools.SendMail(tools.Cfg.Mail.Server, tools.Cfg.Mail.Sender, subject, body.String(), []string{admin.Email.String})
+}
+
+func deleteRawRecordingsOnWorker(worker model.Worker, course model.Course, stream model.Stream) error {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return fmt.Errorf("dial worker: %w", err)
+	}
+	defer endConnection(conn)
+	resp, err := pb.NewToWorkerClient(conn).DeleteRawRecordings(context.Background(), &pb.DeleteRawRecordingsRequest{
+		WorkerID:   worker.WorkerID,
+		CourseSlug: course.Slug,
+		Start:      timestamppb.New(stream.Start),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected deletion")
+	}
+	return nil
+}
+
+func deleteVodsOnWorker(worker model.Worker, course model.Course, stream model.Stream) error {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return fmt.Errorf("dial worker: %w", err)
+	}
+	defer endConnection(conn)
+	resp, err := pb.NewToWorkerClient(conn).DeleteVods(context.Background(), &pb.DeleteVodsRequest{
+		WorkerID:   worker.WorkerID,
+		CourseSlug: course.Slug,
+		Start:      timestamppb.New(stream.Start),
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected deletion")
+	}
+	return nil
+}
+
+func deleteFilesOnWorker(worker model.Worker, paths []string) error {
+	conn, err := dialIn(worker)
+	if err != nil {
+		return fmt.Errorf("dial worker: %w", err)
+	}
+	defer endConnection(conn)
+	resp, err := pb.NewToWorkerClient(conn).DeleteFiles(context.Background(), &pb.DeleteFilesRequest{
+		WorkerID: worker.WorkerID,
+		Paths:    paths,
+	})
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("worker rejected deletion")
+	}
+	return nil
+}
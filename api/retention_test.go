@@ -0,0 +1,216 @@
+package api
+
+import (
+	"bytes"
+	"database/sql"
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"gorm.io/gorm"
+	"html/template"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEnforceRetention(t *testing.T) {
+	now := time.Date(2026, 3, 10, 3, 30, 0, 0, time.Local)
+	noticePeriod := time.Hour * 24 * 14
+	workers := []model.Worker{{WorkerID: "busy", Workload: 3}, {WorkerID: "idle"}}
+	unexpected := retentionEnforcer{
+		deleteFiles: func(model.Worker, []string) error {
+			t.Errorf("unexpected deletion")
+			return nil
+		},
+		deleteRawRecordings: func(model.Worker, model.Course, model.Stream) error {
+			t.Errorf("unexpected deletion of raw recordings")
+			return nil
+		},
+		deleteVods: func(model.Worker, model.Course, model.Stream) error {
+			t.Errorf("unexpected deletion of vods")
+			return nil
+		},
+		notify: func(model.User, RetentionMailTmpl) error {
+			t.Errorf("unexpected notification")
+			return nil
+		},
+	}
+	vod := model.File{Model: gorm.Model{ID: 10}, StreamID: 1, Path: "/mass/eidi/a.mp4", Type: model.FILETYPE_VOD}
+	thumb := model.File{Model: gorm.Model{ID: 11}, StreamID: 1, Path: "/mass/eidi/a.jpg", Type: model.FILETYPE_THUMB_COMB}
+	wentLive := time.Date(2026, 1, 1, 10, 0, 0, 0, time.Local)
+
+	t.Run("notify admins before deletion", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		auditMock := mock_dao.NewMockAuditDao(ctrl)
+
+		course := model.Course{Model: gorm.Model{ID: 5}, Name: "eidi", RetentionPolicy: model.RetentionDeleteRaw, RetentionPeriod: 30}
+		course.Streams = []model.Stream{
+			// due in a week, admins get the full notice period
+			{Model: gorm.Model{ID: 1}, CourseID: 5, End: now.AddDate(0, 0, -23), LiveNowTimestamp: wentLive, Files: []model.File{vod}},
+			// not due within the notice period
+			{Model: gorm.Model{ID: 2}, CourseID: 5, End: now.AddDate(0, 0, -1), LiveNowTimestamp: wentLive, Files: []model.File{vod}},
+			// never went live, no raw recordings to delete
+			{Model: gorm.Model{ID: 3}, CourseID: 5, End: now.AddDate(0, 0, -60)},
+		}
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{course}, nil)
+		workerMock.EXPECT().GetAliveWorkers().Return(workers)
+		retentionMock.EXPECT().GetNotices(uint(5)).Return(nil, nil)
+		retentionMock.EXPECT().CreateNotice(&model.RetentionNotice{
+			StreamID: 1, CourseID: 5, Policy: model.RetentionDeleteRaw, DueAt: now.Add(noticePeriod),
+		}).Return(nil)
+		coursesMock.EXPECT().GetCourseAdmins(uint(5)).Return([]model.User{
+			{Name: "Admin", Email: sql.NullString{String: "admin@tum.de", Valid: true}},
+		}, nil)
+		auditMock.EXPECT().Create(&model.Audit{
+			Message: "'eidi': notified 1 admins about the deletion of 1 streams",
+			Type:    model.AuditRetention,
+		}).Return(nil)
+
+		enforcer := unexpected
+		var mails []RetentionMailTmpl
+		enforcer.notify = func(admin model.User, mail RetentionMailTmpl) error {
+			mails = append(mails, mail)
+			return nil
+		}
+		daoWrapper := dao.DaoWrapper{RetentionDao: retentionMock, WorkerDao: workerMock, CoursesDao: coursesMock, AuditDao: auditMock}
+		enforceRetention(daoWrapper, now, noticePeriod, enforcer)
+		if len(mails) != 1 || mails[0].Entirely || len(mails[0].Streams) != 1 || mails[0].Streams[0].Stream.ID != 1 {
+			t.Errorf("admin should be notified about the deletion of stream 1, got %+v", mails)
+		}
+	})
+
+	t.Run("delete raw recordings after notice", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		// VoD files, their archives and rows are kept, calls to these mocks fail the test
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		fileMock := mock_dao.NewMockFileDao(ctrl)
+		auditMock := mock_dao.NewMockAuditDao(ctrl)
+
+		course := model.Course{Model: gorm.Model{ID: 5}, Name: "eidi", Slug: "eidi", RetentionPolicy: model.RetentionDeleteRaw, RetentionPeriod: 30}
+		end := time.Date(2026, 1, 1, 12, 0, 0, 0, time.Local)
+		course.Streams = []model.Stream{
+			{Model: gorm.Model{ID: 1}, CourseID: 5, End: end, LiveNowTimestamp: wentLive, Files: []model.File{vod, thumb}},
+			// the notice period isn't over yet
+			{Model: gorm.Model{ID: 2}, CourseID: 5, End: end, LiveNowTimestamp: wentLive, Files: []model.File{vod}},
+			// the raw recordings were deleted before
+			{Model: gorm.Model{ID: 3}, CourseID: 5, End: end, LiveNowTimestamp: wentLive, Files: []model.File{vod}},
+		}
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{course}, nil)
+		workerMock.EXPECT().GetAliveWorkers().Return(workers)
+		retentionMock.EXPECT().GetNotices(uint(5)).Return([]model.RetentionNotice{
+			{Model: gorm.Model{ID: 7}, StreamID: 1, CourseID: 5, Policy: model.RetentionDeleteRaw, DueAt: now.AddDate(0, 0, -1)},
+			{Model: gorm.Model{ID: 8}, StreamID: 2, CourseID: 5, Policy: model.RetentionDeleteRaw, DueAt: now.AddDate(0, 0, 1)},
+			{Model: gorm.Model{ID: 9}, StreamID: 3, CourseID: 5, Policy: model.RetentionDeleteRaw, DueAt: now.AddDate(0, 0, -10), Enforced: true},
+		}, nil)
+		retentionMock.EXPECT().SetNoticeEnforced(uint(7)).Return(nil)
+		auditMock.EXPECT().Create(&model.Audit{
+			Message: "'eidi': deleted raw recordings of stream 1 (" + course.Streams[0].FriendlyTime() + ") after retention period",
+			Type:    model.AuditRetention,
+		}).Return(nil)
+
+		enforcer := unexpected
+		var cleaned []string
+		enforcer.deleteRawRecordings = func(worker model.Worker, c model.Course, stream model.Stream) error {
+			if c.Slug != "eidi" || stream.ID != 1 {
+				t.Errorf("unexpected deletion of raw recordings of stream %d", stream.ID)
+			}
+			cleaned = append(cleaned, worker.WorkerID)
+			return nil
+		}
+		daoWrapper := dao.DaoWrapper{RetentionDao: retentionMock, WorkerDao: workerMock, VodArchiveDao: archiveMock, FileDao: fileMock, AuditDao: auditMock}
+		enforceRetention(daoWrapper, now, noticePeriod, enforcer)
+		// the worker that recorded the stream isn't known, all workers delete its raw recordings
+		if want := []string{"busy", "idle"}; !reflect.DeepEqual(cleaned, want) {
+			t.Errorf("raw recordings deleted on %v, want %v", cleaned, want)
+		}
+	})
+
+	t.Run("delete streams entirely", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+		archiveMock := mock_dao.NewMockVodArchiveDao(ctrl)
+		fileMock := mock_dao.NewMockFileDao(ctrl)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		auditMock := mock_dao.NewMockAuditDao(ctrl)
+
+		// summer term 2025 ended in October, one semester later it's due
+		course := model.Course{Model: gorm.Model{ID: 5}, Name: "eidi", Year: 2025, TeachingTerm: "S", RetentionPolicy: model.RetentionDelete, RetentionPeriod: 1}
+		course.Streams = []model.Stream{{Model: gorm.Model{ID: 1}, CourseID: 5, End: time.Date(2025, 7, 1, 12, 0, 0, 0, time.Local), Recording: true, Files: []model.File{vod, thumb}}}
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{course}, nil)
+		workerMock.EXPECT().GetAliveWorkers().Return(workers)
+		retentionMock.EXPECT().GetNotices(uint(5)).Return([]model.RetentionNotice{
+			{Model: gorm.Model{ID: 7}, StreamID: 1, CourseID: 5, Policy: model.RetentionDelete, DueAt: time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)},
+		}, nil)
+		archiveMock.EXPECT().GetArchiveByFileID(uint(10)).Return(model.VodArchive{}, gorm.ErrRecordNotFound).Times(2)
+		fileMock.EXPECT().DeleteFile(uint(10)).Return(nil)
+		fileMock.EXPECT().DeleteFile(uint(11)).Return(nil)
+		streamsMock.EXPECT().DeleteStream("1")
+		retentionMock.EXPECT().DeleteNotice(uint(7)).Return(nil)
+		auditMock.EXPECT().Create(gomock.Any()).Return(nil)
+
+		enforcer := unexpected
+		var deleted []string
+		enforcer.deleteFiles = func(worker model.Worker, paths []string) error {
+			deleted = append(deleted, paths...)
+			return nil
+		}
+		var deletedVods []string
+		enforcer.deleteVods = func(worker model.Worker, c model.Course, stream model.Stream) error {
+			deletedVods = append(deletedVods, worker.WorkerID)
+			return nil
+		}
+		daoWrapper := dao.DaoWrapper{RetentionDao: retentionMock, WorkerDao: workerMock, VodArchiveDao: archiveMock, FileDao: fileMock, StreamsDao: streamsMock, AuditDao: auditMock}
+		// not before the day the notice announced
+		enforceRetention(daoWrapper, time.Date(2026, 4, 1, 3, 30, 0, 0, time.Local), noticePeriod, enforcer)
+		if want := []string{"/mass/eidi/a.mp4", "/mass/eidi/a.jpg"}; !reflect.DeepEqual(deleted, want) {
+			t.Errorf("deleted %v, want %v", deleted, want)
+		}
+		// the worker that stored the vods isn't known, all delete them
+		if want := []string{"busy", "idle"}; !reflect.DeepEqual(deletedVods, want) {
+			t.Errorf("vods deleted by %v, want %v", deletedVods, want)
+		}
+	})
+
+	t.Run("archive by archival", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		retentionMock := mock_dao.NewMockRetentionDao(ctrl)
+		workerMock := mock_dao.NewMockWorkerDao(ctrl)
+
+		// archived by ArchiveVoDs during the off-peak window
+		course := model.Course{Model: gorm.Model{ID: 5}, Year: 2024, TeachingTerm: "W", RetentionPolicy: model.RetentionArchive, RetentionPeriod: 0}
+		course.Streams = []model.Stream{{Model: gorm.Model{ID: 1}, CourseID: 5, Files: []model.File{vod}}}
+		retentionMock.EXPECT().GetRetainedCourses().Return([]model.Course{course}, nil)
+		workerMock.EXPECT().GetAliveWorkers().Return(workers)
+
+		daoWrapper := dao.DaoWrapper{RetentionDao: retentionMock, WorkerDao: workerMock}
+		enforceRetention(daoWrapper, now, noticePeriod, unexpected)
+	})
+}
+
+func TestRetentionNoticeTemplate(t *testing.T) {
+	templ, err := template.ParseFS(staticFS, "template/*.gotemplate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var body bytes.Buffer
+	err = templ.ExecuteTemplate(&body, "mail-retention-notice.gotemplate", RetentionMailTmpl{
+		Name:     "Admin",
+		Course:   model.Course{Model: gorm.Model{ID: 5}, Name: "eidi"},
+		Entirely: true,
+		Streams:  []RetentionMailStream{{Stream: model.Stream{Start: time.Date(2025, 7, 1, 10, 0, 0, 0, time.Local)}, DueAt: time.Date(2026, 4, 1, 0, 0, 0, 0, time.Local)}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(body.String(), "on 2026-04-01") || !strings.Contains(body.String(), "/admin/course/5") {
+		t.Errorf("notice should contain the deletion date and the course settings, got %s", body.String())
+	}
+}
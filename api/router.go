@@ -34,6 +34,7 @@ func ConfigGinRouter(router *gin.Engine) {
 	configGinCourseRouter(router, daoWrapper)
 	configGinDownloadRouter(router, daoWrapper)
 	configGinDownloadICSRouter(router, daoWrapper)
+	configGinPodcastRouter(router, daoWrapper)
 	configGinLectureHallApiRouter(router, daoWrapper, tools.NewPresetUtility(daoWrapper.LectureHallsDao))
 	configProgressRouter(router, daoWrapper)
 	configSeekStatsRouter(router, daoWrapper)
@@ -44,6 +44,7 @@ func configGinStreamRestRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			streamById.GET("/subtitles/:lang", routes.getSubtitles)
 
 			streamById.GET("/playlist", routes.getStreamPlaylist)
+			streamById.GET("/playlist/skip-silences.m3u8", routes.getSkipSilencesPlaylist)
 
 			thumbs := streamById.Group("/thumbs")
 			{
@@ -60,6 +61,7 @@ func configGinStreamRestRouter(router *gin.Engine, daoWrapper dao.DaoWrapper) {
 			admins.GET("/end", routes.endStream)
 			admins.GET("/thumb", routes.RegenerateThumbs)
 			admins.POST("/issue", routes.reportStreamIssue)
+			admins.GET("/health", routes.getStreamHealth)
 			admins.PATCH("/visibility", routes.updateStreamVisibility)
 			admins.PATCH("/chat/enabled", routes.updateChatEnabled)
 			sections := admins.Group("/sections")
@@ -357,6 +359,47 @@ func (r streamRoutes) getStreamPlaylist(c *gin.Context) {
 	c.JSON(http.StatusOK, result)
 }
 
+// getSkipSilencesPlaylist returns the HLS playlist of a recording without the segments of its detected silences.
+// The version of the recording is selected with the query parameter "version" (COMB, PRES, CAM or PIP).
+func (r streamRoutes) getSkipSilencesPlaylist(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	stream := *tumLiveContext.Stream
+	if !stream.Recording || len(stream.Silences) == 0 {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "stream has no silences",
+		})
+		return
+	}
+	version := c.Query("version")
+	if stream.GetPlaylistUrl(version) == "" {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "version not available",
+		})
+		return
+	}
+	if err := tools.SetSignedPlaylists(&stream, tumLiveContext.User, false); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not create signed stream playlists",
+			Err:           err,
+		})
+		return
+	}
+	playlist, err := tools.GetPlaylistWithoutSilences(c, stream.GetPlaylistUrl(version), stream.Silences)
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusBadGateway,
+			CustomMessage: "can not get playlist",
+			Err:           err,
+		})
+		return
+	}
+	c.Header("Cache-Control", "no-store") // the playlist contains the signed urls of the user
+	c.Data(http.StatusOK, "application/vnd.apple.mpegurl", []byte(playlist))
+}
+
 func (r streamRoutes) getVideoSections(c *gin.Context) {
 	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
 	sections, err := r.VideoSectionDao.GetByStreamId(tumLiveContext.Stream.ID)
@@ -0,0 +1,228 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	"time"
+)
+
+// defaultFailoverTimeout is the time without heartbeat after which a primary worker is considered dead.
+// Workers send a heartbeat every minute, so this allows for one missed heartbeat.
+const defaultFailoverTimeout = time.Second * 150
+
+// streamFailoverConfig returns whether standby workers should be assigned to streams and the failover timeout.
+func streamFailoverConfig() (enabled bool, timeout time.Duration) {
+	if tools.Cfg.StreamFailover == nil || !tools.Cfg.StreamFailover.Enabled {
+		return false, defaultFailoverTimeout
+	}
+	if tools.Cfg.StreamFailover.TimeoutSeconds > 0 {
+		return true, time.Duration(tools.Cfg.StreamFailover.TimeoutSeconds) * time.Second
+	}
+	return true, defaultFailoverTimeout
+}
+
+// getStandbyWorker returns the index of the worker with the least workload except the primary worker or -1 if there is none.
+func getStandbyWorker(workers []model.Worker, primary int) int {
+	standby := -1
+	for i := range workers {
+		if i == primary {
+			continue
+		}
+		if standby == -1 || workers[i].Workload < workers[standby].Workload {
+			standby = i
+		}
+	}
+	return standby
+}
+
+// requestStandby asks a second worker to record the source of req as a backup for the primary worker.
+// req must already have been sent to the primary worker.
+func requestStandby(daoWrapper dao.DaoWrapper, stream model.Stream, workers []model.Worker, primary int, req *pb.StreamRequest) {
+	standbyIndex := getStandbyWorker(workers, primary)
+	if standbyIndex == -1 {
+		log.WithField("stream", stream.ID).Warn("No worker available as standby")
+		return
+	}
+	standby := &workers[standbyIndex]
+	standby.Workload += 1 // standby workers only copy the source
+
+	conn, err := dialIn(*standby)
+	if err != nil {
+		log.WithError(err).Error("Unable to dial standby worker")
+		return
+	}
+	defer endConnection(conn)
+	req.WorkerId = standby.WorkerID
+	req.Standby = true
+	resp, err := pb.NewToWorkerClient(conn).RequestStream(context.Background(), req)
+	if err != nil || !resp.Ok {
+		log.WithError(err).Error("could not assign standby for stream")
+		return
+	}
+	// the standby is stopped together with the primary worker at the end of the stream
+	if err = daoWrapper.StreamsDao.SaveWorkerForStream(stream, *standby); err != nil {
+		log.WithError(err).Error("Could not save standby worker for stream")
+	}
+	err = daoWrapper.StreamStandbyDao.CreateStandby(&model.StreamStandby{
+		StreamID:        stream.ID,
+		SourceType:      req.SourceType,
+		PrimaryWorkerID: workers[primary].WorkerID,
+		StandbyWorkerID: standby.WorkerID,
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not save standby")
+	}
+}
+
+// CheckStreamFailover lets standby workers take over the ingest of streams whose primary worker stopped sending heartbeats.
+func CheckStreamFailover(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		if enabled, _ := streamFailoverConfig(); !enabled {
+			return
+		}
+		standbys, err := daoWrapper.StreamStandbyDao.GetActiveStandbys()
+		if err != nil {
+			log.WithError(err).Error("Can't get active standbys")
+			return
+		}
+		for _, standby := range standbys {
+			if err := checkStandby(daoWrapper, standby); err != nil {
+				log.WithError(err).WithField("stream", standby.StreamID).Error("Stream failover failed")
+				sentry.CaptureException(err)
+			}
+		}
+	}
+}
+
+// checkStandby asks the standby to take over if the primary worker timed out.
+func checkStandby(daoWrapper dao.DaoWrapper, standby model.StreamStandby) error {
+	_, timeout := streamFailoverConfig()
+	primary, err := daoWrapper.WorkerDao.GetWorkerByID(context.Background(), standby.PrimaryWorkerID)
+	if err != nil {
+		return fmt.Errorf("get primary worker: %w", err)
+	}
+	if time.Since(primary.LastSeen) < timeout {
+		return nil // primary worker is fine
+	}
+	standbyWorker, err := daoWrapper.WorkerDao.GetWorkerByID(context.Background(), standby.StandbyWorkerID)
+	if err != nil {
+		return fmt.Errorf("get standby worker: %w", err)
+	}
+	if time.Since(standbyWorker.LastSeen) >= timeout {
+		return fmt.Errorf("primary worker and standby worker %s timed out", standbyWorker.Host)
+	}
+
+	log.WithFields(log.Fields{"stream": standby.StreamID, "source": standby.SourceType, "primary": primary.Host, "standby": standbyWorker.Host}).
+		Warn("primary worker timed out, standby takes over")
+	conn, err := dialIn(standbyWorker)
+	if err != nil {
+		return fmt.Errorf("dial standby worker: %w", err)
+	}
+	defer endConnection(conn)
+	resp, err := pb.NewToWorkerClient(conn).RequestTakeover(context.Background(), &pb.TakeoverRequest{
+		StreamID:   uint32(standby.StreamID),
+		SourceType: standby.SourceType,
+		WorkerID:   standbyWorker.WorkerID,
+	})
+	if err != nil {
+		return fmt.Errorf("request takeover: %w", err)
+	}
+	if !resp.Ok {
+		return fmt.Errorf("standby worker %s refused takeover", standbyWorker.Host)
+	}
+	return daoWrapper.StreamStandbyDao.SetTakenOver(standby.ID, time.Now())
+}
+
+// takenOverStandby returns the standby of the source of a stream if it took over the ingest from the primary worker
+func takenOverStandby(daoWrapper dao.DaoWrapper, streamID uint, sourceType string) (model.StreamStandby, bool) {
+	standby, err := daoWrapper.StreamStandbyDao.GetStandby(streamID, sourceType)
+	if err != nil || !standby.TookOver() {
+		return model.StreamStandby{}, false
+	}
+	return standby, true
+}
+
+// isSupersededByStandby returns true if files of the worker for the source of a stream must not be published, because a
+// standby took over after the worker had failed. The recording of the standby is published instead, it contains the
+// whole stream.
+func isSupersededByStandby(daoWrapper dao.DaoWrapper, streamID uint, sourceType string, workerID string) bool {
+	standby, ok := takenOverStandby(daoWrapper, streamID, sourceType)
+	return ok && standby.PrimaryWorkerID == workerID
+}
+
+// keepPartialRecording stores the transcoded recording of a primary worker that failed during the stream, so that it
+// can be stitched with the recording of the standby that took over. It returns false if the worker didn't fail.
+func keepPartialRecording(daoWrapper dao.DaoWrapper, stream model.Stream, request *pb.TranscodingFinished) (bool, error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	standby, ok := takenOverStandby(daoWrapper, stream.ID, request.SourceType)
+	if !ok || standby.PrimaryWorkerID != request.WorkerID {
+		return false, nil
+	}
+	log.WithField("stream", stream.ID).Info("Keeping partial recording of failed worker to stitch it with the recording of the standby")
+	standby.PrimaryRecording = request.FilePath
+	standby.PrimaryDuration = request.Duration
+	if err := daoWrapper.StreamStandbyDao.SaveStandby(&standby); err != nil {
+		return true, err
+	}
+	stitchRecordings(daoWrapper, stream, standby)
+	return true, nil
+}
+
+// stitchRecordings requests a worker to stitch the partial recording of the failed primary worker with the recording
+// of the standby once both are available. The vod of the standby is published without waiting for the stitch, a primary
+// worker that died never reports its recording. The caller must hold mutex.
+func stitchRecordings(daoWrapper dao.DaoWrapper, stream model.Stream, standby model.StreamStandby) {
+	if standby.PrimaryRecording == "" || !standby.StandbyUploaded || standby.Stitched {
+		return
+	}
+	standby.Stitched = true
+	if err := daoWrapper.StreamStandbyDao.SaveStandby(&standby); err != nil {
+		log.WithError(err).WithField("stream", stream.ID).Error("Can't save standby")
+		return
+	}
+	go requestStitch(daoWrapper, stream, standby)
+}
+
+// requestStitch requests stitching the recordings from the standby worker, the stitched recording replaces its vod
+func requestStitch(daoWrapper dao.DaoWrapper, stream model.Stream, standby model.StreamStandby) {
+	course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID)
+	if err != nil {
+		log.WithError(err).WithField("stream", stream.ID).Error("Can't get course to stitch recordings")
+		return
+	}
+	w, err := daoWrapper.WorkerDao.GetWorkerByID(context.Background(), standby.StandbyWorkerID)
+	if err != nil {
+		log.WithError(err).WithField("stream", stream.ID).Warn("Can't get standby worker to stitch recordings")
+		return
+	}
+	wConn, err := dialIn(w)
+	if err != nil {
+		log.WithError(err).Warn("error dialing in")
+		return
+	}
+	defer endConnection(wConn)
+	resp, err := pb.NewToWorkerClient(wConn).StitchRecordings(context.Background(), &pb.StitchRequest{
+		WorkerID:        w.WorkerID,
+		StreamID:        uint32(stream.ID),
+		CourseSlug:      course.Slug,
+		CourseTerm:      course.TeachingTerm,
+		CourseYear:      uint32(course.Year),
+		Start:           timestamppb.New(stream.Start),
+		End:             timestamppb.New(stream.End),
+		SourceType:      standby.SourceType,
+		PublishVoD:      true,
+		PrimaryPath:     standby.PrimaryRecording,
+		PrimaryDuration: standby.PrimaryDuration,
+	})
+	if err != nil || !resp.Ok {
+		log.WithError(err).WithField("stream", stream.ID).Warn("error requesting to stitch recordings")
+	}
+}
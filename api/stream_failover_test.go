@@ -0,0 +1,260 @@
+package api
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	"gorm.io/gorm"
+)
+
+func TestGetStandbyWorker(t *testing.T) {
+	workers := []model.Worker{{WorkerID: "a", Workload: 1}, {WorkerID: "b", Workload: 3}, {WorkerID: "c", Workload: 2}}
+	if got := getStandbyWorker(workers, 0); got != 2 {
+		t.Errorf("getStandbyWorker(workers, 0) = %d, want %d", got, 2)
+	}
+	if got := getStandbyWorker(workers, 2); got != 0 {
+		t.Errorf("getStandbyWorker(workers, 2) = %d, want %d", got, 0)
+	}
+	if got := getStandbyWorker(workers[:1], 0); got != -1 {
+		t.Errorf("getStandbyWorker(workers[:1], 0) = %d, want %d", got, -1)
+	}
+}
+
+func TestCheckStandby(t *testing.T) {
+	standby := model.StreamStandby{StreamID: 1, SourceType: "CAM", PrimaryWorkerID: "primary", StandbyWorkerID: "standby"}
+
+	t.Run("primary alive", func(t *testing.T) {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.
+			EXPECT().
+			GetWorkerByID(gomock.Any(), "primary").
+			Return(model.Worker{WorkerID: "primary", LastSeen: time.Now()}, nil)
+
+		if err := checkStandby(dao.DaoWrapper{WorkerDao: workerDaoMock}, standby); err != nil {
+			t.Errorf("checkStandby(...) = %v, want nil", err)
+		}
+	})
+
+	t.Run("database error", func(t *testing.T) {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.
+			EXPECT().
+			GetWorkerByID(gomock.Any(), "primary").
+			Return(model.Worker{}, errors.New("connection refused"))
+
+		// no takeover is requested, the standby worker isn't even looked up
+		if err := checkStandby(dao.DaoWrapper{WorkerDao: workerDaoMock}, standby); err == nil {
+			t.Errorf("checkStandby(...) = nil, want error")
+		}
+	})
+
+	t.Run("primary and standby dead", func(t *testing.T) {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.
+			EXPECT().
+			GetWorkerByID(gomock.Any(), "primary").
+			Return(model.Worker{WorkerID: "primary", LastSeen: time.Now().Add(-time.Hour)}, nil)
+		workerDaoMock.
+			EXPECT().
+			GetWorkerByID(gomock.Any(), "standby").
+			Return(model.Worker{WorkerID: "standby", LastSeen: time.Now().Add(-time.Hour)}, nil)
+
+		if err := checkStandby(dao.DaoWrapper{WorkerDao: workerDaoMock}, standby); err == nil {
+			t.Errorf("checkStandby(...) = nil, want error")
+		}
+	})
+}
+
+func TestIsSupersededByStandby(t *testing.T) {
+	standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+	standbyDaoMock.
+		EXPECT().
+		GetStandby(uint(1), "CAM").
+		Return(model.StreamStandby{PrimaryWorkerID: "primary", StandbyWorkerID: "standby", TakenOverAt: sql.NullTime{Time: time.Now(), Valid: true}}, nil).
+		AnyTimes()
+	standbyDaoMock.
+		EXPECT().
+		GetStandby(uint(1), "PRES").
+		Return(model.StreamStandby{}, errors.New("not found")).
+		AnyTimes()
+	wrapper := dao.DaoWrapper{StreamStandbyDao: standbyDaoMock}
+
+	if !isSupersededByStandby(wrapper, 1, "CAM", "primary") {
+		t.Errorf("isSupersededByStandby(1, CAM, primary) = false, want true")
+	}
+	if isSupersededByStandby(wrapper, 1, "CAM", "standby") {
+		t.Errorf("isSupersededByStandby(1, CAM, standby) = true, want false")
+	}
+	if isSupersededByStandby(wrapper, 1, "PRES", "primary") {
+		t.Errorf("isSupersededByStandby(1, PRES, primary) = true, want false")
+	}
+}
+
+func TestKeepPartialRecording(t *testing.T) {
+	tookOver := model.StreamStandby{
+		Model:           gorm.Model{ID: 5},
+		StreamID:        1,
+		SourceType:      "CAM",
+		PrimaryWorkerID: "primary",
+		StandbyWorkerID: "standby",
+		TakenOverAt:     sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	request := &pb.TranscodingFinished{WorkerID: "primary", StreamID: 1, SourceType: "CAM", FilePath: "/mass/eidi-2021-09-23-08-00CAM.mp4", Duration: 1800}
+
+	t.Run("standby didn't take over", func(t *testing.T) {
+		standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+		standbyDaoMock.
+			EXPECT().
+			GetStandby(uint(1), "CAM").
+			Return(model.StreamStandby{PrimaryWorkerID: "primary", StandbyWorkerID: "standby"}, nil)
+
+		partial, err := keepPartialRecording(dao.DaoWrapper{StreamStandbyDao: standbyDaoMock}, model.Stream{Model: gorm.Model{ID: 1}}, request)
+		if partial || err != nil {
+			t.Errorf("keepPartialRecording(...) = %v, %v, want false, nil", partial, err)
+		}
+	})
+
+	t.Run("recording of the standby", func(t *testing.T) {
+		standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+		standbyDaoMock.
+			EXPECT().
+			GetStandby(uint(1), "CAM").
+			Return(tookOver, nil)
+
+		standbyRequest := &pb.TranscodingFinished{WorkerID: "standby", StreamID: 1, SourceType: "CAM", FilePath: "/mass/eidi-2021-09-23-08-00standbyCAM.mp4"}
+		partial, err := keepPartialRecording(dao.DaoWrapper{StreamStandbyDao: standbyDaoMock}, model.Stream{Model: gorm.Model{ID: 1}}, standbyRequest)
+		if partial || err != nil {
+			t.Errorf("keepPartialRecording(...) = %v, %v, want false, nil", partial, err)
+		}
+	})
+
+	t.Run("kept until the standby published its vod", func(t *testing.T) {
+		standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+		standbyDaoMock.
+			EXPECT().
+			GetStandby(uint(1), "CAM").
+			Return(tookOver, nil)
+		standbyDaoMock.
+			EXPECT().
+			SaveStandby(gomock.Any()).
+			DoAndReturn(func(standby *model.StreamStandby) error {
+				if standby.PrimaryRecording != request.FilePath || standby.PrimaryDuration != request.Duration {
+					t.Errorf("partial recording not saved: %+v", standby)
+				}
+				if standby.Stitched {
+					t.Errorf("stitched before the standby published its vod")
+				}
+				return nil
+			})
+
+		partial, err := keepPartialRecording(dao.DaoWrapper{StreamStandbyDao: standbyDaoMock}, model.Stream{Model: gorm.Model{ID: 1}}, request)
+		if !partial || err != nil {
+			t.Errorf("keepPartialRecording(...) = %v, %v, want true, nil", partial, err)
+		}
+	})
+}
+
+func TestStitchRecordingsOnlyOnce(t *testing.T) {
+	// neither the course nor the standby worker may be looked up
+	wrapper := dao.DaoWrapper{
+		CoursesDao:       mock_dao.NewMockCoursesDao(gomock.NewController(t)),
+		StreamStandbyDao: mock_dao.NewMockStreamStandbyDao(gomock.NewController(t)),
+		WorkerDao:        mock_dao.NewMockWorkerDao(gomock.NewController(t)),
+	}
+	stream := model.Stream{Model: gorm.Model{ID: 1}}
+
+	stitchRecordings(wrapper, stream, model.StreamStandby{PrimaryRecording: "/mass/a.mp4", StandbyUploaded: true, Stitched: true})
+	stitchRecordings(wrapper, stream, model.StreamStandby{PrimaryRecording: "/mass/a.mp4"})
+	stitchRecordings(wrapper, stream, model.StreamStandby{StandbyUploaded: true})
+}
+
+func TestNotifyUploadFinishedOfStandby(t *testing.T) {
+	failover := tools.Cfg.StreamFailover
+	defer func() { tools.Cfg.StreamFailover = failover }()
+	tools.Cfg.StreamFailover = &struct {
+		Enabled        bool `yaml:"enabled"`
+		TimeoutSeconds int  `yaml:"timeoutSeconds"`
+	}{Enabled: true}
+
+	// the primary worker died and never reported its recording
+	tookOver := model.StreamStandby{
+		Model:           gorm.Model{ID: 5},
+		StreamID:        1,
+		SourceType:      "CAM",
+		PrimaryWorkerID: "primary",
+		StandbyWorkerID: "standby",
+		TakenOverAt:     sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+	workerDaoMock.EXPECT().GetWorkerByID(gomock.Any(), "standby").Return(model.Worker{WorkerID: "standby"}, nil)
+	streamsDaoMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+	streamsDaoMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(model.Stream{Model: gorm.Model{ID: 1}, CourseID: 2}, nil)
+	streamsDaoMock.EXPECT().SaveStream(gomock.Any()).DoAndReturn(func(stream *model.Stream) error {
+		if stream.PlaylistUrlCAM != "https://vod/standby.m3u8" || !stream.Recording {
+			t.Errorf("vod of the standby not published: %+v", stream)
+		}
+		return nil
+	})
+	coursesDaoMock := mock_dao.NewMockCoursesDao(gomock.NewController(t))
+	coursesDaoMock.EXPECT().GetCourseById(gomock.Any(), uint(2)).Return(model.Course{}, nil)
+	standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+	standbyDaoMock.EXPECT().GetStandby(uint(1), "CAM").Return(tookOver, nil)
+	standbyDaoMock.EXPECT().SaveStandby(gomock.Any()).DoAndReturn(func(standby *model.StreamStandby) error {
+		if !standby.StandbyUploaded || standby.Stitched {
+			t.Errorf("standby = %+v, want uploaded and not stitched", standby)
+		}
+		return nil
+	})
+
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerDaoMock, StreamsDao: streamsDaoMock, CoursesDao: coursesDaoMock, StreamStandbyDao: standbyDaoMock}}
+	resp, err := s.NotifyUploadFinished(context.Background(), &pb.UploadFinished{WorkerID: "standby", StreamID: 1, SourceType: "CAM", HLSUrl: "https://vod/standby.m3u8"})
+	if err != nil || !resp.Ok {
+		t.Errorf("NotifyUploadFinished(...) = %v, %v, want ok", resp, err)
+	}
+}
+
+func TestNotifyAudioFinishedOfPrimary(t *testing.T) {
+	failover := tools.Cfg.StreamFailover
+	defer func() { tools.Cfg.StreamFailover = failover }()
+	tools.Cfg.StreamFailover = &struct {
+		Enabled        bool `yaml:"enabled"`
+		TimeoutSeconds int  `yaml:"timeoutSeconds"`
+	}{Enabled: true}
+
+	// the presentation is the published source of the course, its standby took over
+	tookOver := model.StreamStandby{
+		StreamID:        1,
+		SourceType:      "PRES",
+		PrimaryWorkerID: "primary",
+		StandbyWorkerID: "standby",
+		TakenOverAt:     sql.NullTime{Time: time.Now(), Valid: true},
+	}
+	workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+	workerDaoMock.EXPECT().GetWorkerByID(gomock.Any(), gomock.Any()).Return(model.Worker{}, nil).Times(2)
+	streamsDaoMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+	streamsDaoMock.EXPECT().GetStreamByID(gomock.Any(), "1").Return(model.Stream{Model: gorm.Model{ID: 1}}, nil).Times(2)
+	standbyDaoMock := mock_dao.NewMockStreamStandbyDao(gomock.NewController(t))
+	standbyDaoMock.EXPECT().GetStandby(uint(1), "PRES").Return(tookOver, nil).Times(2)
+	fileDaoMock := mock_dao.NewMockFileDao(gomock.NewController(t))
+	fileDaoMock.EXPECT().SetFile(uint(1), model.File{StreamID: 1, Path: "/recordings/standby.aac", Type: model.FILETYPE_AUDIO}).Return(nil)
+
+	s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerDaoMock, StreamsDao: streamsDaoMock, StreamStandbyDao: standbyDaoMock, FileDao: fileDaoMock}}
+	// the audio of the primary is incomplete and must not be listed in the podcast feed
+	resp, err := s.NotifyAudioFinished(context.Background(), &pb.AudioFinished{WorkerID: "primary", StreamID: 1, SourceType: "PRES", FilePath: "/recordings/primary.aac"})
+	if err != nil || !resp.Ok {
+		t.Errorf("NotifyAudioFinished(primary) = %v, %v, want ok", resp, err)
+	}
+	resp, err = s.NotifyAudioFinished(context.Background(), &pb.AudioFinished{WorkerID: "standby", StreamID: 1, SourceType: "PRES", FilePath: "/recordings/standby.aac"})
+	if err != nil || !resp.Ok {
+		t.Errorf("NotifyAudioFinished(standby) = %v, %v, want ok", resp, err)
+	}
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/gin-gonic/gin"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/bot"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const (
+	streamHealthWindow    = time.Minute * 5  // reports older than this are not shown in the health panel
+	streamIssueBackoff    = time.Minute * 10 // minimum time between two automatic issues about a source
+	minStreamBitrate      = 100              // kbit/s below which a stream is considered broken
+	minStreamSpeed        = 0.9              // below realtime the worker falls behind the source
+	maxDroppedFramesShare = 0.01             // share of frames dropped since the last report
+)
+
+// NotifyStreamHealth stores the metrics a worker reported for a source of a live stream and opens an issue if they
+// indicate problems.
+func (s server) NotifyStreamHealth(ctx context.Context, request *pb.StreamHealth) (*pb.Status, error) {
+	if _, err := s.DaoWrapper.WorkerDao.GetWorkerByID(ctx, request.GetWorkerID()); err != nil {
+		return nil, errors.New("authentication failed: invalid worker id")
+	}
+	stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", request.GetStreamID()))
+	if err != nil {
+		return nil, fmt.Errorf("get stream: %w", err)
+	}
+	health := model.StreamHealth{
+		StreamID:         stream.ID,
+		SourceType:       request.GetSourceType(),
+		WorkerID:         request.GetWorkerID(),
+		Bitrate:          request.GetBitrate(),
+		FPS:              request.GetFPS(),
+		Speed:            request.GetSpeed(),
+		Frames:           request.GetFrames(),
+		DroppedFrames:    request.GetDroppedFrames(),
+		DuplicatedFrames: request.GetDuplicatedFrames(),
+		Restarts:         request.GetRestarts(),
+	}
+	if err = handleStreamHealth(s.DaoWrapper, stream, health); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+func handleStreamHealth(daoWrapper dao.DaoWrapper, stream model.Stream, health model.StreamHealth) error {
+	reports, err := daoWrapper.StreamsDao.GetHealth(stream.ID, time.Now().Add(-streamHealthWindow))
+	if err != nil {
+		return fmt.Errorf("get previous health: %w", err)
+	}
+	var previous *model.StreamHealth
+	for i := range reports {
+		if reports[i].SourceType == health.SourceType && reports[i].WorkerID == health.WorkerID {
+			previous = &reports[i]
+		}
+	}
+	if err = daoWrapper.StreamsDao.AddHealth(&health); err != nil {
+		return fmt.Errorf("add health: %w", err)
+	}
+	if problems := streamHealthProblems(health, previous); len(problems) != 0 {
+		openStreamIssue(daoWrapper, stream, health, problems)
+	}
+	return nil
+}
+
+// streamHealthProblems describes the problems a report indicates compared to the previous report of the same source.
+func streamHealthProblems(health model.StreamHealth, previous *model.StreamHealth) []string {
+	var problems []string
+	if previous != nil && health.Restarts > previous.Restarts {
+		problems = append(problems, fmt.Sprintf("ffmpeg restarted %d times", health.Restarts-previous.Restarts))
+	}
+	if health.Bitrate > 0 && health.Bitrate < minStreamBitrate {
+		problems = append(problems, fmt.Sprintf("bitrate of %.0f kbit/s", health.Bitrate))
+	}
+	if health.Speed > 0 && health.Speed < minStreamSpeed {
+		problems = append(problems, fmt.Sprintf("worker falls behind with speed %.2fx", health.Speed))
+	}
+	// frame counters are reset when ffmpeg restarts
+	if previous != nil && health.Restarts == previous.Restarts && health.Frames > previous.Frames && health.DroppedFrames > previous.DroppedFrames {
+		share := float64(health.DroppedFrames-previous.DroppedFrames) / float64(health.Frames-previous.Frames)
+		if share > maxDroppedFramesShare {
+			problems = append(problems, fmt.Sprintf("%.0f%% dropped frames", share*100))
+		}
+	}
+	return problems
+}
+
+// openStreamIssue warns the course admins watching the stream and reports the problems to the alert room, at most once
+// per streamIssueBackoff and source.
+func openStreamIssue(daoWrapper dao.DaoWrapper, stream model.Stream, health model.StreamHealth, problems []string) {
+	cacheKey := fmt.Sprintf("streamHealthIssue_%d_%s", stream.ID, health.SourceType)
+	if _, found := tools.GetCacheItem(cacheKey); found {
+		return
+	}
+	tools.SetCacheItem(cacheKey, true, streamIssueBackoff)
+
+	log.WithFields(log.Fields{"stream": stream.ID, "source": health.SourceType, "problems": problems}).Warn("stream unhealthy")
+	sendAdminServerMessage(stream.ID, fmt.Sprintf("The %s stream has problems (%s). An issue was reported automatically.",
+		health.SourceType, strings.Join(problems, ", ")), TypeServerWarn)
+
+	alert := bot.StreamHealthAlertMessage{Stream: stream, Health: health, Problems: problems}
+	if course, err := daoWrapper.CoursesDao.GetCourseById(context.Background(), stream.CourseID); err == nil {
+		alert.CourseName = course.Name
+		alert.StreamUrl = tools.Cfg.WebUrl + "/w/" + course.Slug + "/" + fmt.Sprintf("%d", stream.ID)
+	}
+	if stream.LectureHallID != 0 {
+		if lectureHall, err := daoWrapper.LectureHallsDao.GetLectureHallByID(stream.LectureHallID); err == nil {
+			alert.LectureHall = lectureHall.Name
+		}
+	}
+	go sendStreamHealthAlert(alert)
+}
+
+func sendStreamHealthAlert(alert bot.StreamHealthAlertMessage) {
+	if tools.Cfg.Alerts == nil || tools.Cfg.Alerts.Matrix == nil {
+		return
+	}
+	var alertBot bot.Bot
+	alertBot.SetMessagingMethod(&bot.Matrix{})
+	if err := alertBot.SendStreamHealthAlert(alert); err != nil {
+		sentry.CaptureException(err)
+		log.WithError(err).Error("can't send stream health alert")
+	}
+}
+
+// CleanupStreamHealth deletes health reports of streams older than the heartbeat retention
+func CleanupStreamHealth(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		_, _, retentionDays := workerHealthConfig()
+		if err := daoWrapper.StreamsDao.DeleteHealthBefore(time.Now().AddDate(0, 0, -retentionDays)); err != nil {
+			log.WithError(err).Error("can't delete old stream health reports")
+		}
+	}
+}
+
+// getStreamHealth returns the latest health report of every source of the stream for the health panel
+func (r streamRoutes) getStreamHealth(c *gin.Context) {
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	reports, err := r.StreamsDao.GetHealth(tumLiveContext.Stream.ID, time.Now().Add(-streamHealthWindow))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get stream health",
+			Err:           err,
+		})
+		return
+	}
+	var sources []string
+	latest := make(map[string]model.StreamHealth)
+	for _, report := range reports {
+		if _, ok := latest[report.SourceType]; !ok {
+			sources = append(sources, report.SourceType)
+		}
+		latest[report.SourceType] = report
+	}
+	res := make([]gin.H, len(sources))
+	for i, source := range sources {
+		res[i] = latest[source].Json()
+	}
+	c.JSON(http.StatusOK, res)
+}
@@ -0,0 +1,83 @@
+package api
+
+import (
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+	"gorm.io/gorm"
+	"reflect"
+	"testing"
+)
+
+func TestStreamHealthProblems(t *testing.T) {
+	previous := model.StreamHealth{Bitrate: 2500, Speed: 1, Frames: 1000, DroppedFrames: 2, Restarts: 1}
+	tests := map[string]struct {
+		health   model.StreamHealth
+		previous *model.StreamHealth
+		expected []string
+	}{
+		"healthy": {
+			health:   model.StreamHealth{Bitrate: 2500, Speed: 1, Frames: 1900, DroppedFrames: 3, Restarts: 1},
+			previous: &previous,
+		},
+		"first report": {
+			health: model.StreamHealth{Bitrate: 2500, Speed: 1, Frames: 900, DroppedFrames: 400, Restarts: 3},
+		},
+		"restarted": {
+			// frames were reset by the restart, they can't be compared
+			health:   model.StreamHealth{Bitrate: 2500, Speed: 1, Frames: 10, Restarts: 3},
+			previous: &previous,
+			expected: []string{"ffmpeg restarted 2 times"},
+		},
+		"low bitrate and slow": {
+			health:   model.StreamHealth{Bitrate: 50, Speed: 0.5, Frames: 1900, DroppedFrames: 2, Restarts: 1},
+			previous: &previous,
+			expected: []string{"bitrate of 50 kbit/s", "worker falls behind with speed 0.50x"},
+		},
+		"dropped frames": {
+			health:   model.StreamHealth{Bitrate: 2500, Speed: 1, Frames: 1900, DroppedFrames: 92, Restarts: 1},
+			previous: &previous,
+			expected: []string{"10% dropped frames"},
+		},
+	}
+	for name, test := range tests {
+		if got := streamHealthProblems(test.health, test.previous); !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("%s: streamHealthProblems(...) = %v, want %v", name, got, test.expected)
+		}
+	}
+}
+
+func TestHandleStreamHealth(t *testing.T) {
+	stream := model.Stream{Model: gorm.Model{ID: 4242}, CourseID: testutils.CourseFPV.ID}
+
+	t.Run("healthy", func(t *testing.T) {
+		streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+		streamsMock.EXPECT().GetHealth(stream.ID, gomock.Any()).Return(nil, nil)
+		streamsMock.EXPECT().AddHealth(gomock.Any()).Return(nil)
+		// no issue is opened, so the course isn't needed
+		err := handleStreamHealth(dao.DaoWrapper{StreamsDao: streamsMock}, stream, model.StreamHealth{StreamID: stream.ID, SourceType: "COMB", Bitrate: 2500, Speed: 1})
+		if err != nil {
+			t.Errorf("handleStreamHealth(...) = %v, want nil", err)
+		}
+	})
+
+	t.Run("restarts open an issue", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+		streamsMock.EXPECT().GetHealth(stream.ID, gomock.Any()).Return([]model.StreamHealth{
+			{StreamID: stream.ID, SourceType: "COMB", WorkerID: "w1", Restarts: 0},
+			{StreamID: stream.ID, SourceType: "CAM", WorkerID: "w2", Restarts: 5},
+		}, nil)
+		streamsMock.EXPECT().AddHealth(gomock.Any()).Return(nil)
+		coursesMock := mock_dao.NewMockCoursesDao(ctrl)
+		coursesMock.EXPECT().GetCourseById(gomock.Any(), testutils.CourseFPV.ID).Return(testutils.CourseFPV, nil)
+
+		err := handleStreamHealth(dao.DaoWrapper{StreamsDao: streamsMock, CoursesDao: coursesMock}, stream,
+			model.StreamHealth{StreamID: stream.ID, SourceType: "COMB", WorkerID: "w1", Bitrate: 2500, Speed: 1, Restarts: 2})
+		if err != nil {
+			t.Errorf("handleStreamHealth(...) = %v, want nil", err)
+		}
+	})
+}
@@ -15,6 +15,7 @@ import (
 	"net/http"
 	"os"
 	"testing"
+	"time"
 )
 
 func StreamRouterWrapper(r *gin.Engine) {
@@ -270,6 +271,40 @@ func TestStream(t *testing.T) {
 			Url(url).
 			Run(t, testutils.Equal)
 	})
+	t.Run("GET/api/stream/:streamID/health", func(t *testing.T) {
+		url := fmt.Sprintf("/api/stream/%d/health", testutils.StreamFPVLive.ID)
+		reportedAt := time.Date(2022, 11, 8, 10, 0, 0, 0, time.UTC)
+		reports := []model.StreamHealth{
+			{Model: gorm.Model{CreatedAt: reportedAt}, StreamID: testutils.StreamFPVLive.ID, SourceType: "PRES", Bitrate: 2000, Restarts: 0},
+			{Model: gorm.Model{CreatedAt: reportedAt}, StreamID: testutils.StreamFPVLive.ID, SourceType: "CAM", Bitrate: 2400},
+			{Model: gorm.Model{CreatedAt: reportedAt.Add(time.Second * 30)}, StreamID: testutils.StreamFPVLive.ID, SourceType: "PRES", Bitrate: 1900, Restarts: 1},
+		}
+		healthRouter := func(reports []model.StreamHealth, err error) func(r *gin.Engine) {
+			return func(r *gin.Engine) {
+				streamsMock := testutils.GetStreamMock(t)
+				streamsMock.(*mock_dao.MockStreamsDao).
+					EXPECT().
+					GetHealth(testutils.StreamFPVLive.ID, gomock.Any()).
+					Return(reports, err).AnyTimes()
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock, CoursesDao: testutils.GetCoursesMock(t)})
+			}
+		}
+		gomino.TestCases{
+			"GetHealth returns error": {
+				Router:       healthRouter(nil, errors.New("")),
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router:           healthRouter(reports, nil),
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: []gin.H{reports[2].Json(), reports[1].Json()},
+			}}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
 }
 
 func TestStreamVideoSections(t *testing.T) {
@@ -395,7 +430,7 @@ func TestStreamVideoSections(t *testing.T) {
 					CoursesDao: testutils.GetCoursesMock(t),
 					WorkerDao: func() dao.WorkerDao {
 						workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
-						workerMock.EXPECT().GetAliveWorkers().Return([]model.Worker{testutils.Worker1})
+						workerMock.EXPECT().GetSchedulableWorkers().Return([]model.Worker{testutils.Worker1})
 						return workerMock
 					}(),
 					VideoSectionDao: func() dao.VideoSectionDao {
@@ -609,7 +644,7 @@ func TestStreamVideoSections(t *testing.T) {
 					CoursesDao: testutils.GetCoursesMock(t),
 					WorkerDao: func() dao.WorkerDao {
 						workerMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
-						workerMock.EXPECT().GetAliveWorkers().Return([]model.Worker{testutils.Worker1})
+						workerMock.EXPECT().GetSchedulableWorkers().Return([]model.Worker{testutils.Worker1})
 						return workerMock
 					}(),
 					VideoSectionDao: func() dao.VideoSectionDao {
@@ -1069,3 +1104,39 @@ func TestSubtitles(t *testing.T) {
 		Url(endpoint).
 		Run(t, testutils.Equal)
 }
+
+func TestSkipSilencesPlaylist(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	recording := testutils.StreamFPVNotLive
+	recording.Recording = true
+	recording.PlaylistUrlPIP = ""
+	recording.Silences = []model.Silence{{Start: 10, End: 100, StreamID: recording.ID}}
+
+	endpoint := fmt.Sprintf("/api/stream/%d/playlist/skip-silences.m3u8", recording.ID)
+	gomino.TestCases{
+		"no context": {
+			Router:       StreamRouterWrapper,
+			ExpectedCode: http.StatusInternalServerError,
+		},
+		"no silences": {
+			Router:       StreamDefaultRouter(t),
+			Url:          fmt.Sprintf("/api/stream/%d/playlist/skip-silences.m3u8", testutils.StreamFPVLive.ID),
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextEmpty)),
+			ExpectedCode: http.StatusNotFound,
+		},
+		"version not available": {
+			Router: func(r *gin.Engine) {
+				streamsMock := mock_dao.NewMockStreamsDao(gomock.NewController(t))
+				streamsMock.EXPECT().GetStreamByID(gomock.Any(), fmt.Sprintf("%d", recording.ID)).Return(recording, nil)
+				configGinStreamRestRouter(r, dao.DaoWrapper{StreamsDao: streamsMock, CoursesDao: testutils.GetCoursesMock(t)})
+			},
+			Url:          endpoint + "?version=PIP",
+			Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextEmpty)),
+			ExpectedCode: http.StatusNotFound,
+		},
+	}.
+		Method(http.MethodGet).
+		Url(endpoint).
+		Run(t, testutils.Equal)
+}
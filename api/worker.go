@@ -1,24 +1,32 @@
 package api
 
 import (
+	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
 	log "github.com/sirupsen/logrus"
 	"net/http"
+	"strconv"
+	"time"
 )
 
 func configWorkerRouter(r *gin.Engine, daoWrapper dao.DaoWrapper) {
 	g := r.Group("/api/workers")
 	g.Use(tools.Admin)
 
-	routes := workerRoutes{dao: daoWrapper.WorkerDao}
+	routes := workerRoutes{dao: daoWrapper.WorkerDao, auditDao: daoWrapper.AuditDao}
 
 	g.DELETE("/:id", routes.deleteWorker)
+	g.GET("/:id/heartbeats", routes.getHeartbeats)
+	g.POST("/:id/drain", routes.drainWorker)
+	g.POST("/:id/resume", routes.resumeWorker)
 }
 
 type workerRoutes struct {
-	dao dao.WorkerDao
+	dao      dao.WorkerDao
+	auditDao dao.AuditDao
 }
 
 func (r workerRoutes) deleteWorker(c *gin.Context) {
@@ -34,3 +42,95 @@ func (r workerRoutes) deleteWorker(c *gin.Context) {
 		return
 	}
 }
+
+// getHeartbeats returns the vm stats reported by a worker in the last hours (query parameter, default 24)
+func (r workerRoutes) getHeartbeats(c *gin.Context) {
+	hours := 24
+	if h := c.Query("hours"); h != "" {
+		parsed, err := strconv.Atoi(h)
+		if err != nil || parsed <= 0 {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "invalid hours",
+				Err:           err,
+			})
+			return
+		}
+		hours = parsed
+	}
+	heartbeats, err := r.dao.GetHeartbeats(c.Param("id"), time.Now().Add(-time.Duration(hours)*time.Hour))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not get heartbeats",
+			Err:           err,
+		})
+		return
+	}
+	c.JSON(http.StatusOK, heartbeats)
+}
+
+type drainWorkerRequest struct {
+	Reason string `json:"reason"`
+}
+
+// drainWorker puts a worker into maintenance mode: it finishes its current jobs but doesn't get new ones until resumed.
+func (r workerRoutes) drainWorker(c *gin.Context) {
+	var req drainWorkerRequest
+	if c.Request.ContentLength != 0 {
+		if err := c.ShouldBindJSON(&req); err != nil {
+			_ = c.Error(tools.RequestError{
+				Status:        http.StatusBadRequest,
+				CustomMessage: "can not bind body",
+				Err:           err,
+			})
+			return
+		}
+	}
+	if req.Reason == "" {
+		req.Reason = "maintenance"
+	}
+	r.setDraining(c, true, req.Reason)
+}
+
+// resumeWorker ends the maintenance mode of a worker so that it gets new jobs again.
+func (r workerRoutes) resumeWorker(c *gin.Context) {
+	r.setDraining(c, false, "")
+}
+
+func (r workerRoutes) setDraining(c *gin.Context, draining bool, reason string) {
+	worker, err := r.dao.GetWorkerByID(c, c.Param("id"))
+	if err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusNotFound,
+			CustomMessage: "can not find worker",
+			Err:           err,
+		})
+		return
+	}
+	worker.Draining = draining
+	worker.DrainReason = reason
+	// only the drain state is updated, saving the whole worker would overwrite a concurrent heartbeat
+	if err = r.dao.UpdateWorker(worker.WorkerID, map[string]interface{}{"Draining": draining, "DrainReason": reason}); err != nil {
+		_ = c.Error(tools.RequestError{
+			Status:        http.StatusInternalServerError,
+			CustomMessage: "can not update worker",
+			Err:           err,
+		})
+		return
+	}
+
+	message := fmt.Sprintf("resumed worker %s", worker.Host)
+	if draining {
+		message = fmt.Sprintf("draining worker %s: %s", worker.Host, reason)
+	}
+	tumLiveContext := c.MustGet("TUMLiveContext").(tools.TUMLiveContext)
+	if err = r.auditDao.Create(&model.Audit{
+		User:    tumLiveContext.User,
+		Message: message,
+		Type:    model.AuditInfo,
+	}); err != nil {
+		log.Error("Create Audit:", err)
+	}
+	c.JSON(http.StatusOK, worker)
+}
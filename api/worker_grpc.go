@@ -17,12 +17,12 @@ import (
 	"sync"
 	"time"
 
-	go_anel_pwrctrl "github.com/RBG-TUM/go-anel-pwrctrl"
 	"github.com/getsentry/sentry-go"
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
-	"github.com/joschahenningsen/TUM-Live/tools/camera"
+	"github.com/joschahenningsen/TUM-Live/tools/liveindicator"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
 	"github.com/joschahenningsen/TUM-Live/worker/pb"
 	uuid "github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
@@ -38,8 +38,6 @@ import (
 
 var mutex = sync.Mutex{}
 
-var lightIndices = []int{0, 1, 2} // turn on all 3 outlets. TODO: make configurable
-
 type server struct {
 	pb.UnimplementedFromWorkerServer
 	dao.DaoWrapper
@@ -48,7 +46,9 @@ type server struct {
 func dialIn(targetWorker model.Worker) (*grpc.ClientConn, error) {
 	credentials := insecure.NewCredentials()
 	log.Info("Connecting to:" + fmt.Sprintf("%s:50051", targetWorker.Host))
-	conn, err := grpc.Dial(fmt.Sprintf("%s:50051", targetWorker.Host), grpc.WithTransportCredentials(credentials))
+	conn, err := grpc.Dial(fmt.Sprintf("%s:50051", targetWorker.Host),
+		grpc.WithTransportCredentials(credentials),
+		grpc.WithUnaryInterceptor(metrics.UnaryClientInterceptor))
 	return conn, err
 }
 
@@ -155,15 +155,19 @@ func (s server) SendSelfStreamRequest(ctx context.Context, request *pb.SelfStrea
 	s.DaoWrapper.IngestServerDao.SaveSlot(slot)
 
 	return &pb.SelfStreamResponse{
-		StreamID:     uint32(stream.ID),
-		CourseSlug:   course.Slug,
-		CourseYear:   uint32(course.Year),
-		StreamStart:  timestamppb.New(stream.Start),
-		CourseTerm:   course.TeachingTerm,
-		UploadVoD:    course.VODEnabled,
-		IngestServer: ingestServer.Url,
-		StreamName:   slot.StreamName,
-		OutUrl:       ingestServer.OutUrl,
+		StreamID:           uint32(stream.ID),
+		CourseSlug:         course.Slug,
+		CourseYear:         uint32(course.Year),
+		StreamStart:        timestamppb.New(stream.Start),
+		CourseTerm:         course.TeachingTerm,
+		UploadVoD:          course.VODEnabled,
+		IngestServer:       ingestServer.Url,
+		StreamName:         slot.StreamName,
+		OutUrl:             ingestServer.OutUrl,
+		MinSilenceDuration: uint32(course.MinSilenceDuration),
+		NormalizeLoudness:  course.NormalizeLoudness,
+		Denoise:            course.Denoise,
+		EncodingProfile:    getEncodingProfile(course.EncodingProfile),
 	}, nil
 }
 
@@ -232,11 +236,12 @@ func (s server) NotifyStreamFinished(ctx context.Context, request *pb.StreamFini
 			log.WithError(err).Error("Can't set stream not live")
 		}
 		NotifyViewersLiveState(uint(request.StreamID), false)
+		clearSignalStates(uint(request.StreamID))
 	}
 	return &pb.Status{Ok: true}, nil
 }
 
-func handleCameraPositionSwitch(stream model.Stream, daoWrapper dao.DaoWrapper) error {
+func handleCameraPositionSwitch(stream model.Stream, daoWrapper dao.DaoWrapper, presets tools.PresetUtility) error {
 	if stream.LectureHallID == 0 {
 		return nil
 	}
@@ -248,6 +253,13 @@ func handleCameraPositionSwitch(stream model.Stream, daoWrapper dao.DaoWrapper)
 	if err != nil {
 		return err
 	}
+	if lectureHall.CameraIP == "" {
+		return nil // no camera to move
+	}
+	cam, err := presets.ProvideCamera(lectureHall.CameraType, lectureHall.CameraIP)
+	if err != nil {
+		return err
+	}
 	var preferences []model.CameraPresetPreference
 	// make sure there is an empty list if no preferences are found (null or empty string in db)
 	if course.CameraPresetPreferences == "" {
@@ -259,12 +271,7 @@ func handleCameraPositionSwitch(stream model.Stream, daoWrapper dao.DaoWrapper)
 	}
 	for _, preference := range preferences {
 		if preference.LectureHallID == stream.LectureHallID {
-			switch lectureHall.CameraType {
-			case model.Axis:
-				return camera.NewAxisCam(lectureHall.CameraIP, tools.Cfg.Auths.CamAuth).SetPreset(preference.PresetID)
-			case model.Panasonic:
-				return camera.NewPanasonicCam(lectureHall.CameraIP, nil).SetPreset(preference.PresetID)
-			}
+			return cam.SetPreset(preference.PresetID)
 		}
 	}
 	// no preset found for this lecture hall, use default
@@ -272,13 +279,7 @@ func handleCameraPositionSwitch(stream model.Stream, daoWrapper dao.DaoWrapper)
 	if err != nil {
 		return err
 	}
-	switch lectureHall.CameraType {
-	case model.Axis:
-		return camera.NewAxisCam(lectureHall.CameraIP, tools.Cfg.Auths.CamAuth).SetPreset(defaultPreset.PresetID)
-	case model.Panasonic:
-		return camera.NewPanasonicCam(lectureHall.CameraIP, nil).SetPreset(defaultPreset.PresetID)
-	}
-	return nil
+	return cam.SetPreset(defaultPreset.PresetID)
 }
 
 func handleLightOnSwitch(stream model.Stream, daoWrapper dao.DaoWrapper) error {
@@ -289,20 +290,17 @@ func handleLightOnSwitch(stream model.Stream, daoWrapper dao.DaoWrapper) error {
 	if err != nil {
 		return err
 	}
+	indicator, err := liveindicator.New(lectureHall, tools.Cfg.Auths.PwrCrtlAuth)
+	if err == liveindicator.ErrNoLiveIndicator {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
 	lightLock.Lock()
 	defer lightLock.Unlock()
-
-	var errs []error
-	client := go_anel_pwrctrl.New(lectureHall.PwrCtrlIp, tools.Cfg.Auths.PwrCrtlAuth)
-	for _, lightIndex := range lightIndices {
-		err := client.TurnOn(lightIndex)
-		if err != nil {
-			errs = append(errs, err)
-		}
-	}
-
-	if len(errs) > 0 {
-		return fmt.Errorf("can't turn on lights: %v", errs)
+	if err = indicator.TurnOn(); err != nil {
+		return fmt.Errorf("can't turn on light: %w", err)
 	}
 	return nil
 }
@@ -327,15 +325,14 @@ func handleLightOffSwitch(stream model.Stream, daoWrapper dao.DaoWrapper) error
 	if err != nil {
 		return err
 	}
-	client := go_anel_pwrctrl.New(lectureHall.PwrCtrlIp, tools.Cfg.Auths.PwrCrtlAuth)
-	for _, index := range lightIndices {
-		err := client.TurnOff(index)
-		if err != nil {
-			return err
-		}
+	indicator, err := liveindicator.New(lectureHall, tools.Cfg.Auths.PwrCrtlAuth)
+	if err == liveindicator.ErrNoLiveIndicator {
+		return nil
 	}
-
-	return nil
+	if err != nil {
+		return err
+	}
+	return indicator.TurnOff()
 }
 
 // SendHeartBeat receives heartbeat messages sent by workers
@@ -351,10 +348,34 @@ func (s server) SendHeartBeat(ctx context.Context, request *pb.HeartBeat) (*pb.S
 		worker.Disk = request.Disk
 		worker.Uptime = request.Uptime
 		worker.Version = request.Version
-		err := s.DaoWrapper.SaveWorker(worker)
+		if worker.Draining && worker.InFlight > 0 && len(request.InFlightJobs) == 0 {
+			log.WithField("worker", worker.Host).Info("worker finished all jobs and can be shut down")
+		}
+		worker.InFlight = uint(len(request.InFlightJobs))
+
+		heartbeat := model.NewWorkerHeartbeat(worker)
+		// only the columns of the heartbeat are written, admins may drain or resume the worker meanwhile
+		fields := map[string]interface{}{
+			"Workload": worker.Workload,
+			"LastSeen": worker.LastSeen,
+			"Status":   worker.Status,
+			"CPU":      worker.CPU,
+			"Memory":   worker.Memory,
+			"Disk":     worker.Disk,
+			"Uptime":   worker.Uptime,
+			"Version":  worker.Version,
+			"InFlight": worker.InFlight,
+		}
+		if checkWorkerHealth(s.DaoWrapper.WorkerDao, &worker, heartbeat) {
+			fields["Draining"], fields["DrainReason"] = worker.Draining, worker.DrainReason
+		}
+		err := s.DaoWrapper.UpdateWorker(worker.WorkerID, fields)
 		if err != nil {
 			return nil, err
 		}
+		if err = s.DaoWrapper.AddHeartbeat(&heartbeat); err != nil {
+			log.WithError(err).Warn("can't save worker heartbeat")
+		}
 		return &pb.Status{Ok: true}, nil
 	}
 }
@@ -374,6 +395,16 @@ func (s server) NotifyTranscodingFinished(ctx context.Context, request *pb.Trans
 		log.WithError(err).Error("error removing transcoding progress")
 	}
 
+	if enabled, _ := streamFailoverConfig(); enabled {
+		partial, err := keepPartialRecording(s.DaoWrapper, stream, request)
+		if err != nil {
+			return nil, err
+		}
+		if partial {
+			return &pb.Status{Ok: true}, nil
+		}
+	}
+
 	// look for file to prevent duplication
 	shouldAddFile := true
 	for _, file := range stream.Files {
@@ -389,6 +420,10 @@ func (s server) NotifyTranscodingFinished(ctx context.Context, request *pb.Trans
 	if request.Duration != 0 {
 		stream.Duration = request.Duration
 	}
+	if l := request.GetLoudness(); l != nil {
+		integrated, loudnessRange, truePeak := l.Integrated, l.Range, l.TruePeak
+		stream.Loudness, stream.LoudnessRange, stream.TruePeak = &integrated, &loudnessRange, &truePeak
+	}
 	err = s.DaoWrapper.StreamsDao.SaveStream(&stream)
 	if err != nil {
 		log.WithError(err).Error("Can't save stream")
@@ -416,22 +451,102 @@ func (s server) NotifyUploadFinished(ctx context.Context, req *pb.UploadFinished
 		log.WithField("req", req).Warn("VoD not saved, stream is live.")
 		return nil, nil
 	}
+	standby, tookOver := model.StreamStandby{}, false
+	if enabled, _ := streamFailoverConfig(); enabled {
+		standby, tookOver = takenOverStandby(s.DaoWrapper, stream.ID, req.SourceType)
+	}
+	if tookOver && standby.PrimaryWorkerID == req.WorkerID {
+		log.WithField("stream", stream.ID).Info("Not publishing partial recording of failed worker")
+		return &pb.Status{Ok: true}, nil
+	}
 	stream.Recording = true
 	stream.Private = course.VodPrivate
 	switch req.SourceType {
 	case "CAM":
 		stream.PlaylistUrlCAM = req.HLSUrl
+		stream.DashUrlCAM = req.DashUrl
 	case "PRES":
 		stream.PlaylistUrlPRES = req.HLSUrl
+		stream.DashUrlPRES = req.DashUrl
+	case "PIP":
+		stream.PlaylistUrlPIP = req.HLSUrl
+		stream.DashUrlPIP = req.DashUrl
 	default:
 		stream.PlaylistUrl = req.HLSUrl
+		stream.DashUrl = req.DashUrl
 	}
 	if err = s.StreamsDao.SaveStream(&stream); err != nil {
 		return nil, err
 	}
+	if tookOver && !standby.StandbyUploaded {
+		standby.StandbyUploaded = true
+		if err = s.DaoWrapper.StreamStandbyDao.SaveStandby(&standby); err != nil {
+			log.WithError(err).Error("Can't save standby")
+		}
+		stitchRecordings(s.DaoWrapper, stream, standby)
+	}
+	// the upload that completes camera and presentation requests the composite
+	if pres, cam, ok := pipSources(stream, course); ok && (req.SourceType == "CAM" || req.SourceType == "PRES") {
+		go requestPip(s.DaoWrapper, stream, course, pres, cam)
+	}
 	return &pb.Status{Ok: true}, nil
 }
 
+// pipSources returns the transcoded presentation and camera of the stream if the picture-in-picture composite of the
+// stream should be rendered: the course has composites enabled and the stream has both sources but no composite yet.
+func pipSources(stream model.Stream, course model.Course) (pres string, cam string, ok bool) {
+	if !course.GetPipLayout().Enabled || stream.PlaylistUrlPIP != "" || stream.PlaylistUrlCAM == "" || stream.PlaylistUrlPRES == "" {
+		return "", "", false
+	}
+	for _, file := range stream.Files {
+		if file.Type != model.FILETYPE_VOD {
+			continue
+		}
+		switch file.GetVodTypeByName() {
+		case "PRES":
+			pres = file.Path
+		case "CAM":
+			cam = file.Path
+		}
+	}
+	return pres, cam, pres != "" && cam != ""
+}
+
+// requestPip requests the picture-in-picture composite of the stream from the worker with the least workload
+func requestPip(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course, pres string, cam string) {
+	workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
+	if len(workers) == 0 {
+		log.WithField("stream", stream.ID).Warn("No workers available to render picture-in-picture")
+		return
+	}
+	w := workers[getWorkerWithLeastWorkload(workers)]
+	wConn, err := dialIn(w)
+	if err != nil {
+		log.WithError(err).Warn("error dialing in")
+		return
+	}
+	defer endConnection(wConn)
+	layout := course.GetPipLayout()
+	client := pb.NewToWorkerClient(wConn)
+	resp, err := client.RenderPip(context.Background(), &pb.RenderPipRequest{
+		WorkerID:         w.WorkerID,
+		StreamID:         uint32(stream.ID),
+		CourseSlug:       course.Slug,
+		CourseTerm:       course.TeachingTerm,
+		CourseYear:       uint32(course.Year),
+		Start:            timestamppb.New(stream.Start),
+		End:              timestamppb.New(stream.End),
+		PublishVoD:       true,
+		PresentationPath: pres,
+		CameraPath:       cam,
+		Position:         string(layout.Position),
+		Size:             uint32(layout.Size),
+	})
+	if err != nil || !resp.Ok {
+		log.WithError(err).WithField("stream", stream.ID).Warn("error requesting picture-in-picture")
+	}
+}
+
 // NotifyThumbnailsFinished receives and handles messages from workers about finished thumbnails.
 func (s server) NotifyThumbnailsFinished(ctx context.Context, req *pb.ThumbnailsFinished) (*pb.Status, error) {
 	mutex.Lock()
@@ -443,6 +558,9 @@ func (s server) NotifyThumbnailsFinished(ctx context.Context, req *pb.Thumbnails
 	if err != nil {
 		return nil, err
 	}
+	if enabled, _ := streamFailoverConfig(); enabled && isSupersededByStandby(s.DaoWrapper, stream.ID, req.SourceType, req.WorkerID) {
+		return &pb.Status{Ok: true}, nil // the thumbnails of the standby show the whole stream
+	}
 	var thumbType model.FileType
 	var thumbTypeLG model.FileType
 
@@ -474,6 +592,24 @@ func (s server) NotifyThumbnailsFinished(ctx context.Context, req *pb.Thumbnails
 	return &pb.Status{Ok: true}, nil
 }
 
+// NotifyAudioFinished stores the audio only version of a vod that is listed in the podcast feed of the course
+func (s server) NotifyAudioFinished(ctx context.Context, req *pb.AudioFinished) (*pb.Status, error) {
+	if _, err := s.WorkerDao.GetWorkerByID(ctx, req.WorkerID); err != nil {
+		return nil, err
+	}
+	stream, err := s.StreamsDao.GetStreamByID(ctx, fmt.Sprintf("%d", req.StreamID))
+	if err != nil {
+		return nil, err
+	}
+	if enabled, _ := streamFailoverConfig(); enabled && isSupersededByStandby(s.DaoWrapper, stream.ID, req.GetSourceType(), req.WorkerID) {
+		return &pb.Status{Ok: true}, nil // the audio of the standby contains the whole stream
+	}
+	if err := s.FileDao.SetFile(stream.ID, model.File{StreamID: stream.ID, Path: req.FilePath, Type: model.FILETYPE_AUDIO}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
 // generateCombinedThumb generates a combined thumbnail from the two source thumbnails CAM and PRES if both exist
 func generateCombinedThumb(streamID uint, dao dao.DaoWrapper) {
 	stream, err := dao.StreamsDao.GetStreamByID(context.Background(), fmt.Sprintf("%d", streamID))
@@ -493,7 +629,7 @@ func generateCombinedThumb(streamID uint, dao dao.DaoWrapper) {
 	if thumbCam == "" || thumbPres == "" {
 		return // nothing to do
 	}
-	workers := dao.GetAliveWorkers()
+	workers := dao.GetSchedulableWorkers()
 	if len(workers) == 0 {
 		return
 	}
@@ -538,12 +674,15 @@ func (s server) GetStreamInfoForUpload(ctx context.Context, request *pb.GetStrea
 		log.WithError(err).Error("Can't delete upload key")
 	}
 	return &pb.GetStreamInfoForUploadResponse{
-		CourseSlug:  course.Slug,
-		CourseTerm:  course.TeachingTerm,
-		CourseYear:  uint32(course.Year),
-		StreamStart: timestamppb.New(key.Stream.Start),
-		StreamEnd:   timestamppb.New(key.Stream.End),
-		StreamID:    uint32(key.StreamID),
+		CourseSlug:         course.Slug,
+		CourseTerm:         course.TeachingTerm,
+		CourseYear:         uint32(course.Year),
+		StreamStart:        timestamppb.New(key.Stream.Start),
+		StreamEnd:          timestamppb.New(key.Stream.End),
+		StreamID:           uint32(key.StreamID),
+		MinSilenceDuration: uint32(course.MinSilenceDuration),
+		NormalizeLoudness:  course.NormalizeLoudness,
+		Denoise:            course.Denoise,
 	}, nil
 }
 
@@ -570,7 +709,7 @@ func (s server) NotifyStreamStarted(ctx context.Context, request *pb.StreamStart
 		if err != nil {
 			log.WithError(err).Error("Can't handle light on switch")
 		}
-		err = handleCameraPositionSwitch(stream, s.DaoWrapper)
+		err = handleCameraPositionSwitch(stream, s.DaoWrapper, tools.NewPresetUtility(s.DaoWrapper.LectureHallsDao))
 		if err != nil {
 			log.WithError(err).Error("Can't handle camera position switch")
 		}
@@ -668,7 +807,7 @@ func isHlsUrlOk(url string) bool {
 	return true
 }
 
-func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course, workers []model.Worker, sourceType string, source string) {
+func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, course model.Course, lectureHall model.LectureHall, workers []model.Worker, sourceType string, source string) {
 	if source == "" {
 		return
 	}
@@ -690,19 +829,25 @@ func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, course
 	}
 	slot.StreamID = stream.ID
 	daoWrapper.IngestServerDao.SaveSlot(slot)
+	audio := course.GetAudioProcessing(lectureHall)
 	req := pb.StreamRequest{
-		SourceType:   sourceType,
-		SourceUrl:    source,
-		CourseSlug:   course.Slug,
-		Start:        timestamppb.New(stream.Start),
-		End:          timestamppb.New(stream.End),
-		PublishVoD:   course.VODEnabled,
-		StreamID:     uint32(stream.ID),
-		CourseTerm:   course.TeachingTerm,
-		CourseYear:   uint32(course.Year),
-		StreamName:   slot.StreamName,
-		IngestServer: server.Url,
-		OutUrl:       server.OutUrl,
+		SourceType:         sourceType,
+		SourceUrl:          source,
+		CourseSlug:         course.Slug,
+		Start:              timestamppb.New(stream.Start),
+		End:                timestamppb.New(stream.End),
+		PublishVoD:         course.VODEnabled,
+		StreamID:           uint32(stream.ID),
+		CourseTerm:         course.TeachingTerm,
+		CourseYear:         uint32(course.Year),
+		StreamName:         slot.StreamName,
+		IngestServer:       server.Url,
+		OutUrl:             server.OutUrl,
+		MinSilenceDuration: uint32(course.MinSilenceDuration),
+		NormalizeLoudness:  audio.NormalizeLoudness,
+		Denoise:            audio.Denoise,
+		EncodingProfile:    getEncodingProfile(course.GetEncodingProfile(lectureHall)),
+		ExtractAudio:       sourceType == publishedSource(course, stream.LectureHallID),
 	}
 	workerIndex := getWorkerWithLeastWorkload(workers)
 	workers[workerIndex].Workload += 3
@@ -725,6 +870,9 @@ func CreateStreamRequest(daoWrapper dao.DaoWrapper, stream model.Stream, course
 		workers[workerIndex].Workload -= 1 // decrease workers load only by one (backoff)
 	}
 	endConnection(conn)
+	if enabled, _ := streamFailoverConfig(); enabled && err == nil && resp.Ok {
+		requestStandby(daoWrapper, stream, workers, workerIndex, &req)
+	}
 }
 
 // NotifyWorkers collects all streams that are due to stream
@@ -734,7 +882,7 @@ func NotifyWorkers(daoWrapper dao.DaoWrapper) func() {
 	return func() {
 		notifyWorkersPremieres(daoWrapper)
 		streams := daoWrapper.StreamsDao.GetDueStreamsForWorkers()
-		workers := daoWrapper.WorkerDao.GetAliveWorkers()
+		workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
 		if len(workers) == 0 && len(streams) != 0 {
 			log.Error("not enough workers to handle streams")
 			return
@@ -762,17 +910,17 @@ func NotifyWorkers(daoWrapper dao.DaoWrapper) func() {
 			switch courseForStream.GetSourceModeForLectureHall(streams[i].LectureHallID) {
 			// SourceMode == 1 -> Presentation Only
 			case 1:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "PRES", lectureHallForStream.PresIP)
+				CreateStreamRequest(daoWrapper, streams[i], courseForStream, lectureHallForStream, workers, "PRES", lectureHallForStream.PresIP)
 				return
 			// SourceMode == 2 -> Camera Only
 			case 2:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "CAM", lectureHallForStream.CamIP)
+				CreateStreamRequest(daoWrapper, streams[i], courseForStream, lectureHallForStream, workers, "CAM", lectureHallForStream.CamIP)
 				return
 			// SourceMode != 1,2 -> Combination view
 			default:
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "PRES", lectureHallForStream.PresIP)
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "CAM", lectureHallForStream.CamIP)
-				CreateStreamRequest(daoWrapper, streams[i], courseForStream, workers, "COMB", lectureHallForStream.CombIP)
+				CreateStreamRequest(daoWrapper, streams[i], courseForStream, lectureHallForStream, workers, "PRES", lectureHallForStream.PresIP)
+				CreateStreamRequest(daoWrapper, streams[i], courseForStream, lectureHallForStream, workers, "CAM", lectureHallForStream.CamIP)
+				CreateStreamRequest(daoWrapper, streams[i], courseForStream, lectureHallForStream, workers, "COMB", lectureHallForStream.CombIP)
 			}
 		}
 	}
@@ -781,7 +929,7 @@ func NotifyWorkers(daoWrapper dao.DaoWrapper) func() {
 // notifyWorkersPremieres looks for premieres that should be streamed and assigns them to workers.
 func notifyWorkersPremieres(daoWrapper dao.DaoWrapper) {
 	streams := daoWrapper.StreamsDao.GetDuePremieresForWorkers()
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
+	workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
 
 	if len(workers) == 0 && len(streams) != 0 {
 		log.Error("Not enough alive workers for premiere")
@@ -832,7 +980,7 @@ func notifyWorkersPremieres(daoWrapper dao.DaoWrapper) {
 // FetchLivePreviews gets a live thumbnail from a worker.
 func FetchLivePreviews(daoWrapper dao.DaoWrapper) func() {
 	return func() {
-		workers := daoWrapper.WorkerDao.GetAliveWorkers()
+		workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
 		liveStreams, err := daoWrapper.StreamsDao.GetCurrentLive(context.Background())
 		if err != nil {
 			return
@@ -897,7 +1045,7 @@ func getLivePreviewFromWorker(s *model.Stream, workerID string, client pb.ToWork
 // RegenerateThumbs regenerates the thumbnails for the timeline. This is useful for video with faulty thumbnails
 // and for VoDs that were created before the thumbnail feature.
 func RegenerateThumbs(daoWrapper dao.DaoWrapper, file model.File, stream *model.Stream, course *model.Course) error {
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
+	workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
 	workerIndex := getWorkerWithLeastWorkload(workers)
 	if len(workers) == 0 {
 		return errors.New("no workers available")
@@ -936,7 +1084,7 @@ type generateVideoSectionImagesParameters struct {
 }
 
 func DeleteVideoSectionImage(workerDao dao.WorkerDao, path string) error {
-	workers := workerDao.GetAliveWorkers()
+	workers := workerDao.GetSchedulableWorkers()
 	if len(workers) == 0 {
 		return errors.New("no workers available")
 	}
@@ -957,7 +1105,7 @@ func DeleteVideoSectionImage(workerDao dao.WorkerDao, path string) error {
 }
 
 func GenerateVideoSectionImages(daoWrapper dao.DaoWrapper, parameters *generateVideoSectionImagesParameters) error {
-	workers := daoWrapper.WorkerDao.GetAliveWorkers()
+	workers := daoWrapper.WorkerDao.GetSchedulableWorkers()
 	if len(workers) == 0 {
 		return errors.New("no workers available")
 	}
@@ -1076,6 +1224,35 @@ func (s server) NotifyTranscodingFailure(ctx context.Context, request *pb.Notify
 	return &pb.NotifyTranscodingFailureResponse{}, err
 }
 
+// NotifyUploadFailure handles the notification of a worker that a vod couldn't be uploaded after all retries.
+// The failure is listed with the transcoding failures, so admins can retry it from the transcoded file.
+func (s server) NotifyUploadFailure(ctx context.Context, request *pb.NotifyUploadFailureRequest) (*pb.Status, error) {
+	worker, err := s.WorkerDao.GetWorkerByID(ctx, request.WorkerID)
+	if err != nil {
+		return nil, err
+	}
+	log.WithFields(log.Fields{"stream": request.StreamID, "worker": worker.Host, "attempts": request.Attempts}).
+		Error("Upload failed: ", request.Error)
+	failure := model.TranscodingFailure{
+		StreamID: uint(request.StreamID),
+		Logs:     fmt.Sprintf("upload failed after %d attempts: %s", request.Attempts, request.Error),
+		FilePath: request.FilePath,
+		Hostname: worker.Host,
+	}
+	switch request.Version {
+	case "CAM":
+		failure.Version = model.CAM
+	case "PRES":
+		failure.Version = model.PRES
+	default:
+		failure.Version = model.COMB
+	}
+	if err = s.DaoWrapper.TranscodingFailureDao.New(&failure); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
 // getWorkerWithLeastWorkload Gets the index of the worker from workers with the least workload.
 // workers must not be empty!
 func getWorkerWithLeastWorkload(workers []model.Worker) int {
@@ -1102,7 +1279,10 @@ func ServeWorkerGRPC() {
 		MaxConnectionAgeGrace: time.Second * 5,
 		Time:                  time.Minute * 10,
 		Timeout:               time.Second * 20,
-	}))
+	}),
+		grpc.UnaryInterceptor(metrics.UnaryServerInterceptor),
+		grpc.StreamInterceptor(metrics.StreamServerInterceptor),
+	)
 	pb.RegisterFromWorkerServer(grpcServer, &server{DaoWrapper: dao.NewDaoWrapper()})
 	reflection.Register(grpcServer)
 	go func() {
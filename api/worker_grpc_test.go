@@ -0,0 +1,126 @@
+package api
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/mock_tools"
+	"github.com/joschahenningsen/TUM-Live/mock_tools/mock_camera"
+	"github.com/joschahenningsen/TUM-Live/model"
+)
+
+func TestPipSources(t *testing.T) {
+	var course model.Course
+	course.SetPipLayout(model.PipLayout{Enabled: true, Position: model.PipBottomRight, Size: 25})
+	stream := model.Stream{
+		PlaylistUrlCAM:  "https://edge/vod/eidiCAM.mp4/playlist.m3u8",
+		PlaylistUrlPRES: "https://edge/vod/eidiPRES.mp4/playlist.m3u8",
+		Files: []model.File{
+			{Path: "/vods/eidi_CAM.mp4", Type: model.FILETYPE_VOD},
+			{Path: "/vods/eidi_PRES.mp4", Type: model.FILETYPE_VOD},
+			{Path: "/vods/eidi_PRES-thumb.jpg", Type: model.FILETYPE_THUMB_PRES},
+		},
+	}
+
+	pres, cam, ok := pipSources(stream, course)
+	if !ok || pres != "/vods/eidi_PRES.mp4" || cam != "/vods/eidi_CAM.mp4" {
+		t.Errorf("pipSources() = %s, %s, %v", pres, cam, ok)
+	}
+
+	if _, _, ok = pipSources(stream, model.Course{}); ok {
+		t.Error("courses without composites enabled shouldn't render composites")
+	}
+
+	rendered := stream
+	rendered.PlaylistUrlPIP = "https://edge/vod/eidiPIP.mp4/playlist.m3u8"
+	if _, _, ok = pipSources(rendered, course); ok {
+		t.Error("existing composites shouldn't be rendered again")
+	}
+
+	presOnly := stream
+	presOnly.PlaylistUrlCAM = ""
+	if _, _, ok = pipSources(presOnly, course); ok {
+		t.Error("composites require camera and presentation")
+	}
+}
+
+func TestHandleCameraPositionSwitch(t *testing.T) {
+	lectureHall := model.LectureHall{CameraIP: "10.0.0.4", CameraType: model.Visca}
+	lectureHall.ID = 1
+	stream := model.Stream{LectureHallID: lectureHall.ID, CourseID: 2}
+
+	tests := []struct {
+		name        string
+		preferences string
+		preset      int
+	}{
+		{name: "course preference", preferences: `[{"lecture_hall_id":1,"preset_id":3}]`, preset: 3},
+		{name: "default preset", preferences: `[{"lecture_hall_id":5,"preset_id":3}]`, preset: 7},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			courses := mock_dao.NewMockCoursesDao(ctrl)
+			courses.EXPECT().GetCourseById(gomock.Any(), stream.CourseID).Return(model.Course{CameraPresetPreferences: test.preferences}, nil)
+			lectureHalls := mock_dao.NewMockLectureHallsDao(ctrl)
+			lectureHalls.EXPECT().GetLectureHallByID(lectureHall.ID).Return(lectureHall, nil)
+			presets := mock_dao.NewMockCameraPresetDao(ctrl)
+			presets.EXPECT().GetDefaultCameraPreset(lectureHall.ID).Return(model.CameraPreset{PresetID: 7}, nil).AnyTimes()
+
+			cam := mock_camera.NewMockCam(ctrl)
+			cam.EXPECT().SetPreset(test.preset).Return(nil)
+			utility := mock_tools.NewMockPresetUtility(ctrl)
+			utility.EXPECT().ProvideCamera(model.Visca, lectureHall.CameraIP).Return(cam, nil)
+
+			wrapper := dao.DaoWrapper{CoursesDao: courses, LectureHallsDao: lectureHalls, CameraPresetDao: presets}
+			if err := handleCameraPositionSwitch(stream, wrapper, utility); err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+}
+
+func TestHandleLightSwitch(t *testing.T) {
+	var states []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		states = append(states, r.URL.Query().Get("turn"))
+	}))
+	defer srv.Close()
+	lectureHall := model.LectureHall{PwrCtrlIp: srv.URL + "/relay/{index}?turn={state}", LiveIndicator: model.HTTPIndicator}
+	lectureHall.ID = 1
+	stream := model.Stream{LectureHallID: lectureHall.ID}
+	stream.ID = 2
+	other := model.Stream{LectureHallID: lectureHall.ID}
+	other.ID = 3
+
+	ctrl := gomock.NewController(t)
+	lectureHallsMock := mock_dao.NewMockLectureHallsDao(ctrl)
+	lectureHallsMock.EXPECT().GetLectureHallByID(lectureHall.ID).Return(lectureHall, nil).AnyTimes()
+	streamsMock := mock_dao.NewMockStreamsDao(ctrl)
+	gomock.InOrder(
+		streamsMock.EXPECT().GetLiveStreamsInLectureHall(lectureHall.ID).Return([]model.Stream{stream, other}, nil),
+		streamsMock.EXPECT().GetLiveStreamsInLectureHall(lectureHall.ID).Return([]model.Stream{stream}, nil),
+	)
+	wrapper := dao.DaoWrapper{LectureHallsDao: lectureHallsMock, StreamsDao: streamsMock}
+
+	if err := handleLightOnSwitch(stream, wrapper); err != nil {
+		t.Fatal(err)
+	}
+	// another stream is still live in the lecture hall
+	if err := handleLightOffSwitch(stream, wrapper); err != nil {
+		t.Fatal(err)
+	}
+	if err := handleLightOffSwitch(stream, wrapper); err != nil {
+		t.Fatal(err)
+	}
+	if len(states) != 2 || states[0] != "on" || states[1] != "off" {
+		t.Errorf("expected on and off, got %v", states)
+	}
+	if err := handleLightOnSwitch(model.Stream{}, wrapper); err != nil {
+		t.Errorf("streams without lecture hall shouldn't switch lights: %v", err)
+	}
+}
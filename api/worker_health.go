@@ -0,0 +1,101 @@
+package api
+
+import (
+	"fmt"
+	"github.com/getsentry/sentry-go"
+	"github.com/joschahenningsen/TUM-Live/dao"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/bot"
+	log "github.com/sirupsen/logrus"
+	"time"
+)
+
+const (
+	defaultWorkerDiskThreshold     = 90
+	defaultWorkerLoadThreshold     = 95
+	defaultHeartbeatRetentionDays  = 14
+	workerLoadAlertHeartbeatWindow = 5 // number of consecutive heartbeats above the threshold before alerting
+)
+
+// workerHealthConfig returns the configured thresholds for worker alerts and the heartbeat retention or their defaults.
+func workerHealthConfig() (diskThreshold float64, loadThreshold float64, retentionDays int) {
+	diskThreshold, loadThreshold, retentionDays = defaultWorkerDiskThreshold, defaultWorkerLoadThreshold, defaultHeartbeatRetentionDays
+	if tools.Cfg.WorkerHealth == nil {
+		return
+	}
+	if tools.Cfg.WorkerHealth.DiskThreshold > 0 {
+		diskThreshold = tools.Cfg.WorkerHealth.DiskThreshold
+	}
+	if tools.Cfg.WorkerHealth.LoadThreshold > 0 {
+		loadThreshold = tools.Cfg.WorkerHealth.LoadThreshold
+	}
+	if tools.Cfg.WorkerHealth.RetentionDays > 0 {
+		retentionDays = tools.Cfg.WorkerHealth.RetentionDays
+	}
+	return
+}
+
+// checkWorkerHealth compares the latest heartbeat of a worker with its history and sends an alert when the disk usage or
+// the load crossed its threshold. Workers running out of disk space are marked as draining, drained is true then.
+func checkWorkerHealth(workerDao dao.WorkerDao, worker *model.Worker, heartbeat model.WorkerHeartbeat) (drained bool) {
+	diskThreshold, loadThreshold, _ := workerHealthConfig()
+
+	previous, err := workerDao.GetHeartbeats(worker.WorkerID, time.Now().Add(-time.Hour))
+	if err != nil {
+		log.WithError(err).Warn("can't get previous heartbeats of worker")
+		return false
+	}
+	var last *model.WorkerHeartbeat
+	if len(previous) > 0 {
+		last = &previous[len(previous)-1]
+	}
+
+	if heartbeat.Disk >= diskThreshold && (last == nil || last.Disk < diskThreshold) {
+		reason := fmt.Sprintf("disk usage %.f%% crossed threshold of %.f%%", heartbeat.Disk, diskThreshold)
+		if !worker.Draining {
+			worker.Draining = true
+			worker.DrainReason = reason
+			drained = true
+		}
+		go sendWorkerAlert(*worker, heartbeat, reason)
+	}
+
+	// alert if the load just crossed the threshold and stayed above it for a few heartbeats to ignore short spikes
+	if heartbeat.CPU >= loadThreshold && len(previous) >= workerLoadAlertHeartbeatWindow {
+		window := previous[len(previous)-workerLoadAlertHeartbeatWindow:]
+		alert := window[0].CPU < loadThreshold
+		for _, h := range window[1:] {
+			alert = alert && h.CPU >= loadThreshold
+		}
+		if alert {
+			reason := fmt.Sprintf("cpu usage above %.f%% for %d heartbeats", loadThreshold, workerLoadAlertHeartbeatWindow)
+			go sendWorkerAlert(*worker, heartbeat, reason)
+		}
+	}
+	return drained
+}
+
+func sendWorkerAlert(worker model.Worker, heartbeat model.WorkerHeartbeat, reason string) {
+	log.WithFields(log.Fields{"worker": worker.Host, "reason": reason}).Warn("worker unhealthy")
+	if tools.Cfg.Alerts == nil || tools.Cfg.Alerts.Matrix == nil {
+		return
+	}
+	var alertBot bot.Bot
+	alertBot.SetMessagingMethod(&bot.Matrix{})
+	err := alertBot.SendWorkerAlert(bot.WorkerAlertMessage{Worker: worker, Heartbeat: heartbeat, Reason: reason})
+	if err != nil {
+		sentry.CaptureException(err)
+		log.WithError(err).Error("can't send worker alert")
+	}
+}
+
+// CleanupWorkerHeartbeats deletes heartbeats older than the configured retention
+func CleanupWorkerHeartbeats(daoWrapper dao.DaoWrapper) func() {
+	return func() {
+		_, _, retentionDays := workerHealthConfig()
+		if err := daoWrapper.WorkerDao.DeleteHeartbeatsBefore(time.Now().AddDate(0, 0, -retentionDays)); err != nil {
+			log.WithError(err).Error("can't delete old worker heartbeats")
+		}
+	}
+}
@@ -1,14 +1,17 @@
 package api
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"github.com/gin-gonic/gin"
 	"github.com/golang/mock/gomock"
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/mock_dao"
+	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
 	"github.com/joschahenningsen/TUM-Live/tools/testutils"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
 	"github.com/matthiasreumann/gomino"
 	"net/http"
 	"testing"
@@ -60,4 +63,229 @@ func TestWorker(t *testing.T) {
 			Url(url).
 			Run(t, testutils.Equal)
 	})
+	t.Run("GET/api/workers/:workerID/heartbeats", func(t *testing.T) {
+		url := fmt.Sprintf("/api/workers/%s/heartbeats", testutils.Worker1.WorkerID)
+		heartbeats := []model.WorkerHeartbeat{{WorkerID: testutils.Worker1.WorkerID, CPU: 42, Disk: 31}}
+		gomino.TestCases{
+			"invalid hours": {
+				Router: func(r *gin.Engine) {
+					configWorkerRouter(r, dao.DaoWrapper{})
+				},
+				Url:          url + "?hours=abc",
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"can not get heartbeats": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao: func() dao.WorkerDao {
+							workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+							workerDaoMock.
+								EXPECT().
+								GetHeartbeats(testutils.Worker1.WorkerID, gomock.Any()).
+								Return(nil, errors.New("")).
+								AnyTimes()
+							return workerDaoMock
+						}(),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusInternalServerError,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao: func() dao.WorkerDao {
+							workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+							workerDaoMock.
+								EXPECT().
+								GetHeartbeats(testutils.Worker1.WorkerID, gomock.Any()).
+								Return(heartbeats, nil).
+								AnyTimes()
+							return workerDaoMock
+						}(),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: heartbeats,
+			}}.
+			Method(http.MethodGet).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+	t.Run("POST/api/workers/:workerID/drain", func(t *testing.T) {
+		url := fmt.Sprintf("/api/workers/%s/drain", testutils.Worker1.WorkerID)
+		drained := testutils.Worker1
+		drained.Draining = true
+		drained.DrainReason = "kernel update"
+		gomino.TestCases{
+			"invalid body": {
+				Router: func(r *gin.Engine) {
+					configWorkerRouter(r, dao.DaoWrapper{})
+				},
+				Body:         "not json",
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusBadRequest,
+			},
+			"worker not found": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao: func() dao.WorkerDao {
+							workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+							workerDaoMock.
+								EXPECT().
+								GetWorkerByID(gomock.Any(), testutils.Worker1.WorkerID).
+								Return(model.Worker{}, errors.New("")).
+								AnyTimes()
+							return workerDaoMock
+						}(),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Body:         drainWorkerRequest{Reason: "kernel update"},
+				Middlewares:  testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode: http.StatusNotFound,
+			},
+			"success": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao: func() dao.WorkerDao {
+							workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+							workerDaoMock.
+								EXPECT().
+								GetWorkerByID(gomock.Any(), testutils.Worker1.WorkerID).
+								Return(testutils.Worker1, nil).
+								AnyTimes()
+							workerDaoMock.
+								EXPECT().
+								UpdateWorker(testutils.Worker1.WorkerID, map[string]interface{}{"Draining": true, "DrainReason": "kernel update"}).
+								Return(nil).
+								AnyTimes()
+							return workerDaoMock
+						}(),
+						AuditDao: func() dao.AuditDao {
+							auditDaoMock := mock_dao.NewMockAuditDao(gomock.NewController(t))
+							auditDaoMock.EXPECT().Create(gomock.Any()).Return(nil).AnyTimes()
+							return auditDaoMock
+						}(),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Body:             drainWorkerRequest{Reason: "kernel update"},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: drained,
+			}}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+	t.Run("POST/api/workers/:workerID/resume", func(t *testing.T) {
+		url := fmt.Sprintf("/api/workers/%s/resume", testutils.Worker1.WorkerID)
+		draining := testutils.Worker1
+		draining.Draining = true
+		draining.DrainReason = "maintenance"
+		gomino.TestCases{
+			"success": {
+				Router: func(r *gin.Engine) {
+					wrapper := dao.DaoWrapper{
+						WorkerDao: func() dao.WorkerDao {
+							workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+							workerDaoMock.
+								EXPECT().
+								GetWorkerByID(gomock.Any(), testutils.Worker1.WorkerID).
+								Return(draining, nil).
+								AnyTimes()
+							workerDaoMock.
+								EXPECT().
+								UpdateWorker(testutils.Worker1.WorkerID, map[string]interface{}{"Draining": false, "DrainReason": ""}).
+								Return(nil).
+								AnyTimes()
+							return workerDaoMock
+						}(),
+						AuditDao: func() dao.AuditDao {
+							auditDaoMock := mock_dao.NewMockAuditDao(gomock.NewController(t))
+							auditDaoMock.EXPECT().Create(gomock.Any()).Return(nil).AnyTimes()
+							return auditDaoMock
+						}(),
+					}
+					configWorkerRouter(r, wrapper)
+				},
+				Middlewares:      testutils.GetMiddlewares(tools.ErrorHandler, testutils.TUMLiveContext(testutils.TUMLiveContextAdmin)),
+				ExpectedCode:     http.StatusOK,
+				ExpectedResponse: testutils.Worker1,
+			}}.
+			Method(http.MethodPost).
+			Url(url).
+			Run(t, testutils.Equal)
+	})
+}
+
+func TestCheckWorkerHealth(t *testing.T) {
+	t.Run("drain worker when disk usage crosses threshold", func(t *testing.T) {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.
+			EXPECT().
+			GetHeartbeats(testutils.Worker1.WorkerID, gomock.Any()).
+			Return([]model.WorkerHeartbeat{{Disk: 50}}, nil)
+
+		worker := testutils.Worker1
+		checkWorkerHealth(workerDaoMock, &worker, model.WorkerHeartbeat{WorkerID: worker.WorkerID, Disk: 95})
+		if !worker.Draining {
+			t.Errorf("worker.Draining = false, want true")
+		}
+	})
+
+	t.Run("don't drain healthy worker", func(t *testing.T) {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.
+			EXPECT().
+			GetHeartbeats(testutils.Worker1.WorkerID, gomock.Any()).
+			Return([]model.WorkerHeartbeat{{Disk: 50}}, nil)
+
+		worker := testutils.Worker1
+		checkWorkerHealth(workerDaoMock, &worker, model.WorkerHeartbeat{WorkerID: worker.WorkerID, Disk: 60})
+		if worker.Draining {
+			t.Errorf("worker.Draining = true, want false")
+		}
+	})
+}
+
+func TestSendHeartBeat(t *testing.T) {
+	heartbeat := func(t *testing.T, worker model.Worker, disk string) map[string]interface{} {
+		workerDaoMock := mock_dao.NewMockWorkerDao(gomock.NewController(t))
+		workerDaoMock.EXPECT().GetWorkerByID(gomock.Any(), worker.WorkerID).Return(worker, nil)
+		workerDaoMock.EXPECT().GetHeartbeats(worker.WorkerID, gomock.Any()).Return([]model.WorkerHeartbeat{{Disk: 50}}, nil)
+		workerDaoMock.EXPECT().AddHeartbeat(gomock.Any()).Return(nil)
+		var updated map[string]interface{}
+		workerDaoMock.EXPECT().UpdateWorker(worker.WorkerID, gomock.Any()).DoAndReturn(func(_ string, fields map[string]interface{}) error {
+			updated = fields
+			return nil
+		})
+		s := server{DaoWrapper: dao.DaoWrapper{WorkerDao: workerDaoMock}}
+		if _, err := s.SendHeartBeat(context.Background(), &pb.HeartBeat{WorkerID: worker.WorkerID, Workload: 2, Disk: disk}); err != nil {
+			t.Fatalf("SendHeartBeat() error = %v", err)
+		}
+		return updated
+	}
+
+	t.Run("keep draining state of admins", func(t *testing.T) {
+		fields := heartbeat(t, testutils.Worker1, "50%")
+		if _, ok := fields["Draining"]; ok {
+			t.Errorf("heartbeat must not overwrite the draining state, updated %v", fields)
+		}
+		if fields["Workload"] != uint(2) {
+			t.Errorf("Workload = %v, want 2", fields["Workload"])
+		}
+	})
+
+	t.Run("drain full worker", func(t *testing.T) {
+		fields := heartbeat(t, testutils.Worker1, "95%")
+		if fields["Draining"] != true || fields["DrainReason"] == "" {
+			t.Errorf("worker running out of disk space should be drained, updated %v", fields)
+		}
+	})
 }
@@ -28,8 +28,7 @@ const (
 )
 
 type sessionWrapper struct {
-	session         *realtime.Context
-	isAdminOfCourse bool
+	session *realtime.Context
 }
 
 var connHandler = func(context *realtime.Context) {
@@ -39,11 +38,7 @@ var connHandler = func(context *realtime.Context) {
 		return
 	}
 	tumLiveContext := foundContext.(tools.TUMLiveContext)
-	isAdmin := false
-	if tumLiveContext.User != nil {
-		isAdmin = tumLiveContext.User.IsAdminOfCourse(*tumLiveContext.Course)
-	}
-	sessionData := sessionWrapper{context, isAdmin}
+	sessionData := sessionWrapper{context}
 
 	wsMapLock.Lock()
 	sessionsMap[tumLiveContext.Stream.ID] = append(sessionsMap[tumLiveContext.Stream.ID], &sessionData)
@@ -118,43 +113,20 @@ func cleanupSessions() {
 	}
 }
 
+// broadcastStream sends msg to all subscribers of the chat of the stream. Clients that reconnect get it when resuming
+// their subscription.
 func broadcastStream(streamID uint, msg []byte) {
-	sessions, f := sessionsMap[streamID]
-	if !f {
-		return
-	}
-	wsMapLock.Lock()
-	sessions = removeClosed(sessions)
-	wsMapLock.Unlock()
-
-	for _, wrapper := range sessions {
-		_ = wrapper.session.Send(msg) // ignore "session closed" error, nothing we can do about it at this point
+	roomName := strings.Replace(ChatRoomName, ":streamID", strconv.Itoa(int(streamID)), -1)
+	if err := RealtimeInstance.Broadcast(roomName, msg); err != nil {
+		log.WithError(err).Warn("can't broadcast to stream")
 	}
 }
 
+// broadcastStreamToAdmins sends msg to the course admins in the chat of the stream. Admins that reconnect get it when
+// resuming their subscription.
 func broadcastStreamToAdmins(streamID uint, msg []byte) {
-	sessions, f := sessionsMap[streamID]
-	if !f {
-		return
-	}
-	wsMapLock.Lock()
-	sessions = removeClosed(sessions)
-	wsMapLock.Unlock()
-
-	for _, wrapper := range sessions {
-		if wrapper.isAdminOfCourse {
-			_ = wrapper.session.Send(msg)
-		}
-	}
-}
-
-// removeClosed removes session where IsClosed() is true.
-func removeClosed(sessions []*sessionWrapper) []*sessionWrapper {
-	var newSessions []*sessionWrapper
-	for _, wrapper := range sessions {
-		if RealtimeInstance.IsConnected(wrapper.session.Client.Id) {
-			newSessions = append(newSessions, wrapper)
-		}
+	roomName := strings.Replace(ChatAdminRoomName, ":streamID", strconv.Itoa(int(streamID)), -1)
+	if err := RealtimeInstance.Broadcast(roomName, msg); err != nil {
+		log.WithError(err).Warn("can't broadcast to admins of stream")
 	}
-	return newSessions
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/joschahenningsen/TUM-Live/dao"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/joschahenningsen/TUM-Live/tools"
+	"github.com/joschahenningsen/TUM-Live/tools/metrics"
 	"github.com/joschahenningsen/TUM-Live/tools/tum"
 	"github.com/joschahenningsen/TUM-Live/web"
 	"github.com/pkg/profile"
@@ -47,6 +48,7 @@ func GinServer() (err error) {
 	gin.SetMode(gin.ReleaseMode)
 	// capture performance with sentry
 	router.Use(sentrygin.New(sentrygin.Options{Repanic: true}))
+	router.Use(metrics.Middleware)
 	if VersionTag != "development" {
 		tools.CookieSecure = true
 	}
@@ -128,6 +130,10 @@ func main() {
 	}
 	dao.DB = db
 
+	if err = metrics.RegisterGormCallbacks(db); err != nil {
+		log.WithError(err).Error("can't register database metrics")
+	}
+
 	err = dao.Migrator.RunBefore(db)
 	if err != nil {
 		log.Error(err)
@@ -149,6 +155,7 @@ func main() {
 		&model.Stream{},
 		&model.Worker{},
 		&model.CameraPreset{},
+		&model.CameraPresetSwitch{},
 		&model.ServerNotification{},
 		&model.File{},
 		&model.StreamProgress{},
@@ -167,6 +174,12 @@ func main() {
 		&model.ChatReaction{},
 		&model.Subtitles{},
 		&model.TranscodingFailure{},
+		&model.StreamStandby{},
+		&model.WorkerHeartbeat{},
+		&model.LectureHallHealth{},
+		&model.StreamHealth{},
+		&model.VodArchive{},
+		&model.RetentionNotice{},
 	)
 	if err != nil {
 		sentry.CaptureException(err)
@@ -206,6 +219,12 @@ func main() {
 			log.WithError(err).Fatal("can't launch gin server")
 		}
 	}()
+	go func() {
+		if err := api.AdminServer(dao.NewDaoWrapper()); err != nil {
+			sentry.CaptureException(err)
+			log.WithError(err).Error("can't launch admin server")
+		}
+	}()
 	keepAlive()
 }
 
@@ -226,6 +245,20 @@ func initCron() {
 	_ = tools.Cron.AddFunc("exportToMeili", tools.NewMeiliExporter(daoWrapper).Export, "30 4 * * *")
 	// fetch live stream previews
 	_ = tools.Cron.AddFunc("fetchLivePreviews", api.FetchLivePreviews(daoWrapper), "*/1 * * * *")
+	// delete worker heartbeats older than the retention every night
+	_ = tools.Cron.AddFunc("cleanupWorkerHeartbeats", api.CleanupWorkerHeartbeats(daoWrapper), "0 2 * * *")
+	// delete health reports of live streams older than the retention every night
+	_ = tools.Cron.AddFunc("cleanupStreamHealth", api.CleanupStreamHealth(daoWrapper), "15 2 * * *")
+	// let standby workers take over streams of failed workers
+	_ = tools.Cron.AddFunc("checkStreamFailover", api.CheckStreamFailover(daoWrapper), "*/1 * * * *")
+	// move cameras of live lectures to their scheduled presets
+	_ = tools.Cron.AddFunc("switchScheduledCameraPresets", api.SwitchScheduledCameraPresets(daoWrapper, tools.NewPresetUtility(daoWrapper.LectureHallsDao)), "*/1 * * * *")
+	// check the sources of lecture halls with upcoming lectures
+	_ = tools.Cron.AddFunc("checkLectureHallHealth", api.CheckLectureHallHealth(daoWrapper), "*/5 * * * *")
+	// re-encode old VoDs for long term storage during off-peak hours
+	_ = tools.Cron.AddFunc("archiveVoDs", api.ArchiveVoDs(daoWrapper), "0 * * * *")
+	// archive and delete recordings according to the retention policies of their courses
+	_ = tools.Cron.AddFunc("enforceRetention", api.EnforceRetention(daoWrapper), "30 3 * * *")
 	tools.Cron.Run()
 }
 
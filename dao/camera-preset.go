@@ -9,6 +9,13 @@ import (
 
 type CameraPresetDao interface {
 	GetDefaultCameraPreset(lectureHallID uint) (res model.CameraPreset, err error)
+
+	// GetPresetSwitches returns the scheduled preset switches of a lecture series ordered by time
+	GetPresetSwitches(seriesIdentifier string) ([]model.CameraPresetSwitch, error)
+	// CreatePresetSwitch schedules a preset switch
+	CreatePresetSwitch(presetSwitch *model.CameraPresetSwitch) error
+	// DeletePresetSwitch deletes the preset switch with id from a lecture series
+	DeletePresetSwitch(id uint, seriesIdentifier string) error
 }
 
 type cameraPresetDao struct {
@@ -23,3 +30,21 @@ func (d cameraPresetDao) GetDefaultCameraPreset(lectureHallID uint) (res model.C
 	err = DB.Debug().First(&res, "lecture_hall_id = ? AND is_default", lectureHallID).Error
 	return
 }
+
+// GetPresetSwitches returns the scheduled preset switches of a lecture series ordered by time
+func (d cameraPresetDao) GetPresetSwitches(seriesIdentifier string) (switches []model.CameraPresetSwitch, err error) {
+	err = DB.Where("series_identifier = ?", seriesIdentifier).
+		Order("weekday IS NOT NULL, weekday, time_of_day, `offset`").
+		Find(&switches).Error
+	return switches, err
+}
+
+// CreatePresetSwitch schedules a preset switch
+func (d cameraPresetDao) CreatePresetSwitch(presetSwitch *model.CameraPresetSwitch) error {
+	return DB.Create(presetSwitch).Error
+}
+
+// DeletePresetSwitch deletes the preset switch with id from a lecture series
+func (d cameraPresetDao) DeletePresetSwitch(id uint, seriesIdentifier string) error {
+	return DB.Where("series_identifier = ?", seriesIdentifier).Delete(&model.CameraPresetSwitch{}, id).Error
+}
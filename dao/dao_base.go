@@ -35,6 +35,9 @@ type DaoWrapper struct {
 	BookmarkDao BookmarkDao
 	SubtitlesDao
 	TranscodingFailureDao
+	StreamStandbyDao
+	VodArchiveDao
+	RetentionDao
 }
 
 func NewDaoWrapper() DaoWrapper {
@@ -61,5 +64,8 @@ func NewDaoWrapper() DaoWrapper {
 		BookmarkDao:           NewBookmarkDao(),
 		SubtitlesDao:          NewSubtitlesDao(),
 		TranscodingFailureDao: NewTranscodingFailureDao(),
+		StreamStandbyDao:      NewStreamStandbyDao(),
+		VodArchiveDao:         NewVodArchiveDao(),
+		RetentionDao:          NewRetentionDao(),
 	}
 }
@@ -14,6 +14,7 @@ type FileDao interface {
 	DeleteFile(id uint) error
 	CountVoDFiles() (int64, error)
 	SetThumbnail(streamId uint, thumb model.File) error
+	SetFile(streamId uint, file model.File) error
 }
 
 type fileDao struct {
@@ -47,12 +48,17 @@ func (d fileDao) CountVoDFiles() (count int64, err error) {
 }
 
 func (d fileDao) SetThumbnail(streamId uint, thumb model.File) error {
+	return d.SetFile(streamId, thumb)
+}
+
+// SetFile replaces the file of the stream with the type of file, e.g. the audio only version of a vod
+func (d fileDao) SetFile(streamId uint, file model.File) error {
 	defer Cache.Clear()
 	return DB.Transaction(func(tx *gorm.DB) error {
-		err := DB.Where("stream_id = ? AND type = ?", streamId, thumb.Type).Delete(&model.File{}).Error
+		err := tx.Where("stream_id = ? AND type = ?", streamId, file.Type).Delete(&model.File{}).Error
 		if err != nil {
 			return err
 		}
-		return tx.Create(&thumb).Error
+		return tx.Create(&file).Error
 	})
 }
@@ -21,6 +21,10 @@ type LectureHallsDao interface {
 	GetLectureHallByID(id uint) (model.LectureHall, error)
 	GetStreamsForLectureHallIcal(userId uint, lectureHalls []uint, all bool) ([]CalendarResult, error)
 
+	SaveHealth(health *model.LectureHallHealth) error
+	GetHealth(lectureHallID uint) ([]model.LectureHallHealth, error)
+	GetAllHealth() ([]model.LectureHallHealth, error)
+
 	UnsetDefaults(lectureHallID string) error
 
 	DeleteLectureHall(id uint) error
@@ -95,6 +99,28 @@ func (d lectureHallsDao) GetStreamsForLectureHallIcal(userId uint, lectureHalls
 	return res, err
 }
 
+// SaveHealth stores the result of a source check, replacing the previous result for the same source of the lecture hall
+func (d lectureHallsDao) SaveHealth(health *model.LectureHallHealth) error {
+	return DB.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "lecture_hall_id"}, {Name: "source_type"}},
+		DoUpdates: clause.AssignmentColumns([]string{"updated_at", "source", "reachable", "error", "checked_at", "worker_id", "stream_id", "alerted_stream_id"}),
+	}).Create(health).Error
+}
+
+// GetHealth returns the latest check results of the sources of a lecture hall
+func (d lectureHallsDao) GetHealth(lectureHallID uint) ([]model.LectureHallHealth, error) {
+	var health []model.LectureHallHealth
+	err := DB.Where("lecture_hall_id = ?", lectureHallID).Order("source_type").Find(&health).Error
+	return health, err
+}
+
+// GetAllHealth returns the latest check results of all lecture halls
+func (d lectureHallsDao) GetAllHealth() ([]model.LectureHallHealth, error) {
+	var health []model.LectureHallHealth
+	err := DB.Order("lecture_hall_id, source_type").Find(&health).Error
+	return health, err
+}
+
 // UnsetDefaults makes all camera presets not default
 func (d lectureHallsDao) UnsetDefaults(lectureHallID string) error {
 	return DB.Model(&model.CameraPreset{}).Where("lecture_hall_id = ?", lectureHallID).Update("default", nil).Error
@@ -107,6 +133,7 @@ func (d lectureHallsDao) DeleteLectureHall(id uint) error {
 	}
 
 	DB.Delete(model.CameraPreset{}, "lecture_hall_id = ?", id)
+	DB.Unscoped().Delete(model.LectureHallHealth{}, "lecture_hall_id = ?", id)
 	DB.Exec("UPDATE streams SET lecture_hall_id = NULL WHERE lecture_hall_id = ?", id)
 	return nil
 }
@@ -0,0 +1,69 @@
+package dao
+
+import (
+	"github.com/joschahenningsen/TUM-Live/model"
+	"gorm.io/gorm"
+)
+
+//go:generate mockgen -source=retention.go -destination ../mock_dao/retention.go
+
+type RetentionDao interface {
+	// GetRetainedCourses returns the courses with a retention policy other than keeping their recordings forever
+	GetRetainedCourses() ([]model.Course, error)
+
+	// GetNotices returns the deletion notices sent for streams of a course
+	GetNotices(courseID uint) ([]model.RetentionNotice, error)
+
+	// CreateNotice stores that the course admins were notified about the deletion of a stream
+	CreateNotice(notice *model.RetentionNotice) error
+
+	// DeleteNotice deletes the deletion notice of a stream
+	DeleteNotice(id uint) error
+
+	// SetNoticeEnforced marks the deletion notice of a stream as carried out
+	SetNoticeEnforced(id uint) error
+
+	// DeleteNotices deletes all deletion notices of a course, e.g. because its retention policy changed
+	DeleteNotices(courseID uint) error
+}
+
+func NewRetentionDao() RetentionDao {
+	return &retentionDao{db: DB}
+}
+
+type retentionDao struct {
+	db *gorm.DB
+}
+
+// GetRetainedCourses returns the courses with a retention policy other than keeping their recordings forever with
+// their streams and files
+func (d retentionDao) GetRetainedCourses() (courses []model.Course, err error) {
+	err = DB.Preload("Streams.Files").Where("retention_policy != ?", model.RetentionKeep).Find(&courses).Error
+	return courses, err
+}
+
+// GetNotices returns the deletion notices sent for streams of a course
+func (d retentionDao) GetNotices(courseID uint) (notices []model.RetentionNotice, err error) {
+	err = DB.Where("course_id = ?", courseID).Find(&notices).Error
+	return notices, err
+}
+
+// CreateNotice stores that the course admins were notified about the deletion of a stream
+func (d retentionDao) CreateNotice(notice *model.RetentionNotice) error {
+	return DB.Create(notice).Error
+}
+
+// DeleteNotice deletes the deletion notice of a stream
+func (d retentionDao) DeleteNotice(id uint) error {
+	return DB.Unscoped().Delete(&model.RetentionNotice{}, id).Error
+}
+
+// SetNoticeEnforced marks the deletion notice of a stream as carried out
+func (d retentionDao) SetNoticeEnforced(id uint) error {
+	return DB.Model(&model.RetentionNotice{}).Where("id = ?", id).Update("enforced", true).Error
+}
+
+// DeleteNotices deletes all deletion notices of a course
+func (d retentionDao) DeleteNotices(courseID uint) error {
+	return DB.Unscoped().Where("course_id = ?", courseID).Delete(&model.RetentionNotice{}).Error
+}
@@ -0,0 +1,67 @@
+package dao
+
+import (
+	"database/sql"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+//go:generate mockgen -source=stream-standby.go -destination ../mock_dao/stream-standby.go
+
+type StreamStandbyDao interface {
+	// CreateStandby stores the standby worker of a stream source
+	CreateStandby(standby *model.StreamStandby) error
+
+	// GetActiveStandbys returns all standbys of streams that are currently running and didn't take over yet
+	GetActiveStandbys() ([]model.StreamStandby, error)
+
+	// GetStandby returns the standby of the source of a stream
+	GetStandby(streamID uint, sourceType string) (model.StreamStandby, error)
+
+	// SetTakenOver marks the standby as having taken over the ingest at time t
+	SetTakenOver(id uint, t time.Time) error
+
+	// SaveStandby updates the standby
+	SaveStandby(standby *model.StreamStandby) error
+}
+
+func NewStreamStandbyDao() StreamStandbyDao {
+	return &streamStandbyDao{db: DB}
+}
+
+type streamStandbyDao struct {
+	db *gorm.DB
+}
+
+// CreateStandby stores the standby worker of a stream source
+func (d streamStandbyDao) CreateStandby(standby *model.StreamStandby) error {
+	return DB.Create(standby).Error
+}
+
+// GetActiveStandbys returns all standbys of streams that are currently running (including 10 minutes padding at the end)
+// and didn't take over yet
+func (d streamStandbyDao) GetActiveStandbys() (standbys []model.StreamStandby, err error) {
+	err = DB.Model(&model.StreamStandby{}).
+		Joins("JOIN streams s ON s.id = stream_standbies.stream_id").
+		Where("stream_standbies.taken_over_at IS NULL AND (s.ended = false OR s.ended IS NULL) AND s.deleted_at IS NULL" +
+			" AND s.start <= NOW() AND DATE_ADD(s.end, INTERVAL 10 MINUTE) > NOW()").
+		Find(&standbys).Error
+	return standbys, err
+}
+
+// GetStandby returns the standby of the source of a stream
+func (d streamStandbyDao) GetStandby(streamID uint, sourceType string) (standby model.StreamStandby, err error) {
+	err = DB.Where("stream_id = ? AND source_type = ?", streamID, sourceType).First(&standby).Error
+	return standby, err
+}
+
+// SetTakenOver marks the standby as having taken over the ingest at time t
+func (d streamStandbyDao) SetTakenOver(id uint, t time.Time) error {
+	return DB.Model(&model.StreamStandby{}).Where("id = ?", id).Update("taken_over_at", sql.NullTime{Time: t, Valid: true}).Error
+}
+
+// SaveStandby updates the standby
+func (d streamStandbyDao) SaveStandby(standby *model.StreamStandby) error {
+	return DB.Save(standby).Error
+}
@@ -19,6 +19,7 @@ type StreamsDao interface {
 
 	GetDueStreamsForWorkers() []model.Stream
 	GetDuePremieresForWorkers() []model.Stream
+	GetUpcomingLectureHallStreams(until time.Time) ([]model.Stream, error)
 	GetStreamByKey(ctx context.Context, key string) (stream model.Stream, err error)
 	GetUnitByID(id string) (model.StreamUnit, error)
 	GetStreamByTumOnlineID(ctx context.Context, id uint) (stream model.Stream, err error)
@@ -31,6 +32,7 @@ type StreamsDao interface {
 	GetCurrentLiveNonHidden(ctx context.Context) (currentLive []model.Stream, err error)
 	GetLiveStreamsInLectureHall(lectureHallId uint) ([]model.Stream, error)
 	GetStreamsWithWatchState(courseID uint, userID uint) (streams []model.Stream, err error)
+	GetPodcastStreams(ctx context.Context, courseID uint) ([]model.Stream, error)
 
 	SetLectureHall(streamIDs []uint, lectureHallID uint) error
 	UnsetLectureHall(streamIDs []uint) error
@@ -42,6 +44,10 @@ type StreamsDao interface {
 	UpdateStreamFullAssoc(vod *model.Stream) error
 	SetStreamNotLiveById(streamID uint) error
 	SetStreamLiveNowTimestampById(streamID uint, liveNowTimestamp time.Time) error
+	SetLiveSource(streamID uint, source string) error
+	AddHealth(health *model.StreamHealth) error
+	GetHealth(streamID uint, since time.Time) ([]model.StreamHealth, error)
+	DeleteHealthBefore(t time.Time) error
 	SaveEndedState(streamID uint, hasEnded bool) error
 	SaveCOMBURL(stream *model.Stream, url string)
 	SaveCAMURL(stream *model.Stream, url string)
@@ -121,6 +127,18 @@ func (d streamsDao) GetDueStreamsForWorkers() []model.Stream {
 	return res
 }
 
+// GetUpcomingLectureHallStreams returns all streams in lecture halls that start between now and until, ordered by start.
+func (d streamsDao) GetUpcomingLectureHallStreams(until time.Time) ([]model.Stream, error) {
+	var res []model.Stream
+	err := DB.Model(&model.Stream{}).
+		Joins("JOIN courses c ON c.id = streams.course_id").
+		Where("lecture_hall_id IS NOT NULL AND start BETWEEN NOW() AND ? "+
+			"AND live_now = false AND recording = false AND (ended = false OR ended IS NULL) AND c.deleted_at IS NULL", until).
+		Order("start").
+		Find(&res).Error
+	return res, err
+}
+
 func (d streamsDao) GetDuePremieresForWorkers() []model.Stream {
 	var res []model.Stream
 	DB.Preload("Files").
@@ -304,6 +322,21 @@ func (d streamsDao) GetStreamsWithWatchState(courseID uint, userID uint) (stream
 	return
 }
 
+// GetPodcastStreams returns all public recordings of a course that have an audio file, the newest first.
+// Audio files and video sections are preloaded.
+func (d streamsDao) GetPodcastStreams(ctx context.Context, courseID uint) (streams []model.Stream, err error) {
+	err = DB.WithContext(ctx).
+		Preload("Files", "type = ?", model.FILETYPE_AUDIO).
+		Preload("VideoSections", func(db *gorm.DB) *gorm.DB {
+			return db.Order("start_hours, start_minutes, start_seconds asc")
+		}).
+		Where("course_id = ? AND recording AND private = false", courseID).
+		Where("EXISTS (SELECT 1 FROM files WHERE files.stream_id = streams.id AND files.type = ? AND files.deleted_at IS NULL)", model.FILETYPE_AUDIO).
+		Order("start desc").
+		Find(&streams).Error
+	return streams, err
+}
+
 // SetLectureHall set lecture-halls of streamIds to lectureHallID
 func (d streamsDao) SetLectureHall(streamIDs []uint, lectureHallID uint) error {
 	return DB.Model(&model.Stream{}).Where("id IN ?", streamIDs).Update("lecture_hall_id", lectureHallID).Error
@@ -360,6 +393,29 @@ func (d streamsDao) SetStreamNotLiveById(streamID uint) error {
 	return DB.Debug().Exec("UPDATE `streams` SET `live_now`='0' WHERE id = ?", streamID).Error
 }
 
+// SetLiveSource sets the source viewers of the default version get while the stream is live, "" for the default source.
+func (d streamsDao) SetLiveSource(streamID uint, source string) error {
+	defer Cache.Clear()
+	return DB.Model(&model.Stream{}).Where("id = ?", streamID).Update("live_source", source).Error
+}
+
+// AddHealth stores a health report of a source of a live stream
+func (d streamsDao) AddHealth(health *model.StreamHealth) error {
+	return DB.Create(health).Error
+}
+
+// GetHealth returns the health reports of a stream since a given time, oldest first
+func (d streamsDao) GetHealth(streamID uint, since time.Time) ([]model.StreamHealth, error) {
+	var health []model.StreamHealth
+	err := DB.Where("stream_id = ? AND created_at >= ?", streamID, since).Order("created_at").Find(&health).Error
+	return health, err
+}
+
+// DeleteHealthBefore deletes all health reports older than t
+func (d streamsDao) DeleteHealthBefore(t time.Time) error {
+	return DB.Where("created_at < ?", t).Delete(&model.StreamHealth{}).Error
+}
+
 // SetStreamLiveNowTimestampById stores timestamp when stream is going live.
 func (d streamsDao) SetStreamLiveNowTimestampById(streamID uint, liveNowTimestamp time.Time) error {
 	defer Cache.Clear()
@@ -12,6 +12,7 @@ type TokenDao interface {
 	AddToken(token model.Token) error
 
 	GetToken(token string) (model.Token, error)
+	GetUserToken(userID uint, scope string) (model.Token, error)
 	GetAllTokens() ([]AllTokensDto, error)
 
 	TokenUsed(token model.Token) error
@@ -39,6 +40,13 @@ func (d tokenDao) GetToken(token string) (model.Token, error) {
 	return t, err
 }
 
+// GetUserToken returns the first token of the user with the given scope that is not expired.
+func (d tokenDao) GetUserToken(userID uint, scope string) (model.Token, error) {
+	var t model.Token
+	err := DB.Model(&t).Where("user_id = ? AND scope = ? AND (expires IS null OR expires > NOW())", userID, scope).First(&t).Error
+	return t, err
+}
+
 // GetAllTokens returns all tokens and the corresponding users name, email and lrz id
 func (d tokenDao) GetAllTokens() ([]AllTokensDto, error) {
 	var tokens []AllTokensDto
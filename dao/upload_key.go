@@ -9,8 +9,13 @@ import (
 
 type UploadKeyDao interface {
 	GetUploadKey(key string) (model.UploadKey, error)
-	CreateUploadKey(key string, stream uint) error
+	CreateUploadKey(key *model.UploadKey) error
 	DeleteUploadKey(key model.UploadKey) error
+
+	// UpdateUploadOffset stores how many bytes of an upload have been received
+	UpdateUploadOffset(key string, offset int64) error
+	// GetUploadKeysForCourse returns the unfinished uploads of a course
+	GetUploadKeysForCourse(courseID uint) ([]model.UploadKey, error)
 }
 
 type uploadKeyDao struct {
@@ -21,14 +26,25 @@ func (u uploadKeyDao) GetUploadKey(key string) (k model.UploadKey, err error) {
 	return k, u.db.Preload("Stream").First(&k, "upload_key = ?", key).Error
 }
 
-func (u uploadKeyDao) CreateUploadKey(key string, stream uint) error {
-	return u.db.Create(&model.UploadKey{UploadKey: key, StreamID: stream}).Error
+func (u uploadKeyDao) CreateUploadKey(key *model.UploadKey) error {
+	return u.db.Create(key).Error
 }
 
 func (u uploadKeyDao) DeleteUploadKey(key model.UploadKey) error {
 	return u.db.Unscoped().Delete(&key).Error
 }
 
+func (u uploadKeyDao) UpdateUploadOffset(key string, offset int64) error {
+	return u.db.Model(&model.UploadKey{}).Where("upload_key = ?", key).Update("offset", offset).Error
+}
+
+func (u uploadKeyDao) GetUploadKeysForCourse(courseID uint) (keys []model.UploadKey, err error) {
+	return keys, u.db.Preload("Stream").
+		Joins("JOIN streams s ON s.id = upload_keys.stream_id").
+		Where("s.course_id = ? AND s.deleted_at IS NULL", courseID).
+		Find(&keys).Error
+}
+
 func NewUploadKeyDao() UploadKeyDao {
 	return &uploadKeyDao{db: DB}
 }
@@ -0,0 +1,100 @@
+package dao
+
+import (
+	"github.com/joschahenningsen/TUM-Live/model"
+	"gorm.io/gorm"
+	"time"
+)
+
+//go:generate mockgen -source=vod-archive.go -destination ../mock_dao/vod-archive.go
+
+type VodArchiveDao interface {
+	// GetArchivableFiles returns up to limit VoD files of recordings that ended before t and weren't archived yet
+	GetArchivableFiles(t time.Time, limit int) ([]model.File, error)
+
+	// CreateArchive stores the archival of a VoD file
+	CreateArchive(archive *model.VodArchive) error
+
+	// GetArchiveByFileID returns the archival of a VoD file
+	GetArchiveByFileID(fileID uint) (model.VodArchive, error)
+
+	// SaveArchive updates the archival of a VoD file
+	SaveArchive(archive *model.VodArchive) error
+
+	// DeleteArchive deletes the archival of a VoD file, so it's archived again
+	DeleteArchive(id uint) error
+
+	// DeletePendingBefore deletes archivals that are pending since before t, their workers were probably restarted
+	DeletePendingBefore(t time.Time) error
+
+	// GetArchiveStats returns the number of archivals and their sizes by codec and state
+	GetArchiveStats() ([]VodArchiveStats, error)
+}
+
+// VodArchiveStats are the archivals of a codec in a state
+type VodArchiveStats struct {
+	Codec        string                `json:"codec"`
+	State        model.VodArchiveState `json:"state"`
+	Count        int64                 `json:"count"`
+	OriginalSize int64                 `json:"originalSize"`
+	ArchiveSize  int64                 `json:"archiveSize"`
+	FallbackSize int64                 `json:"fallbackSize"`
+}
+
+func NewVodArchiveDao() VodArchiveDao {
+	return &vodArchiveDao{db: DB}
+}
+
+type vodArchiveDao struct {
+	db *gorm.DB
+}
+
+// GetArchivableFiles returns up to limit VoD files of recordings that ended before t and weren't archived yet,
+// oldest first
+func (d vodArchiveDao) GetArchivableFiles(t time.Time, limit int) (files []model.File, err error) {
+	err = DB.Model(&model.File{}).
+		Joins("JOIN streams s ON s.id = files.stream_id").
+		Joins("LEFT JOIN vod_archives va ON va.file_id = files.id AND va.deleted_at IS NULL").
+		Where("files.type = ? AND va.id IS NULL AND s.recording = true AND s.live_now = false AND s.end < ? AND s.deleted_at IS NULL",
+			model.FILETYPE_VOD, t).
+		Order("s.end").
+		Limit(limit).
+		Find(&files).Error
+	return files, err
+}
+
+// CreateArchive stores the archival of a VoD file
+func (d vodArchiveDao) CreateArchive(archive *model.VodArchive) error {
+	return DB.Create(archive).Error
+}
+
+// GetArchiveByFileID returns the archival of a VoD file
+func (d vodArchiveDao) GetArchiveByFileID(fileID uint) (archive model.VodArchive, err error) {
+	err = DB.Where("file_id = ?", fileID).First(&archive).Error
+	return archive, err
+}
+
+// SaveArchive updates the archival of a VoD file
+func (d vodArchiveDao) SaveArchive(archive *model.VodArchive) error {
+	return DB.Save(archive).Error
+}
+
+// DeleteArchive deletes the archival of a VoD file, so it's archived again
+func (d vodArchiveDao) DeleteArchive(id uint) error {
+	return DB.Unscoped().Delete(&model.VodArchive{}, id).Error
+}
+
+// DeletePendingBefore deletes archivals that are pending since before t
+func (d vodArchiveDao) DeletePendingBefore(t time.Time) error {
+	return DB.Unscoped().Where("state = ? AND updated_at < ?", model.VodArchivePending, t).Delete(&model.VodArchive{}).Error
+}
+
+// GetArchiveStats returns the number of archivals and their sizes by codec and state
+func (d vodArchiveDao) GetArchiveStats() (stats []VodArchiveStats, err error) {
+	err = DB.Model(&model.VodArchive{}).
+		Select("codec, state, COUNT(*) AS count, SUM(original_size) AS original_size, SUM(archive_size) AS archive_size, SUM(fallback_size) AS fallback_size").
+		Group("codec, state").
+		Order("codec, state").
+		Scan(&stats).Error
+	return stats, err
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/joschahenningsen/TUM-Live/model"
 	"gorm.io/gorm"
+	"time"
 )
 
 //go:generate mockgen -source=worker.go -destination ../mock_dao/worker.go
@@ -11,13 +12,24 @@ import (
 type WorkerDao interface {
 	CreateWorker(worker *model.Worker) error
 	SaveWorker(worker model.Worker) error
+	// UpdateWorker updates only the given fields of a worker
+	UpdateWorker(workerID string, fields map[string]interface{}) error
 
 	GetAllWorkers() ([]model.Worker, error)
 	GetAliveWorkers() []model.Worker
+	// GetSchedulableWorkers returns the alive workers that accept new jobs
+	GetSchedulableWorkers() []model.Worker
 	GetWorkerByHostname(ctx context.Context, hostname string) (model.Worker, error)
 	GetWorkerByID(ctx context.Context, workerID string) (model.Worker, error)
 
 	DeleteWorker(workerID string) error
+
+	// AddHeartbeat stores the vm stats of a heartbeat
+	AddHeartbeat(heartbeat *model.WorkerHeartbeat) error
+	// GetHeartbeats returns the heartbeats of a worker since a given time, oldest first
+	GetHeartbeats(workerID string, since time.Time) ([]model.WorkerHeartbeat, error)
+	// DeleteHeartbeatsBefore deletes all heartbeats older than t
+	DeleteHeartbeatsBefore(t time.Time) error
 }
 
 type workerDao struct {
@@ -36,6 +48,11 @@ func (d workerDao) SaveWorker(worker model.Worker) error {
 	return DB.Save(&worker).Error
 }
 
+// UpdateWorker updates only the given fields of a worker, e.g. to not overwrite changes by admins made concurrently
+func (d workerDao) UpdateWorker(workerID string, fields map[string]interface{}) error {
+	return DB.Model(&model.Worker{}).Where("worker_id = ?", workerID).Updates(fields).Error
+}
+
 func (d workerDao) GetAllWorkers() ([]model.Worker, error) {
 	var workers []model.Worker
 	err := DB.Find(&workers).Error
@@ -49,6 +66,14 @@ func (d workerDao) GetAliveWorkers() []model.Worker {
 	return workers
 }
 
+// GetSchedulableWorkers returns all workers that were active within the last 5 minutes and accept new jobs
+// (aren't draining)
+func (d workerDao) GetSchedulableWorkers() []model.Worker {
+	var workers []model.Worker
+	DB.Model(&model.Worker{}).Where("last_seen > DATE_SUB(NOW(), INTERVAL 5 MINUTE) AND draining = false").Scan(&workers)
+	return workers
+}
+
 func (d workerDao) GetWorkerByHostname(ctx context.Context, hostname string) (model.Worker, error) {
 	var worker model.Worker
 	err := DB.Where("host = ?", hostname).First(&worker).Error
@@ -64,3 +89,17 @@ func (d workerDao) GetWorkerByID(ctx context.Context, workerID string) (model.Wo
 func (d workerDao) DeleteWorker(workerID string) error {
 	return DB.Where("worker_id = ?", workerID).Delete(&model.Worker{}).Error
 }
+
+func (d workerDao) AddHeartbeat(heartbeat *model.WorkerHeartbeat) error {
+	return DB.Create(heartbeat).Error
+}
+
+func (d workerDao) GetHeartbeats(workerID string, since time.Time) ([]model.WorkerHeartbeat, error) {
+	var heartbeats []model.WorkerHeartbeat
+	err := DB.Where("worker_id = ? AND created_at > ?", workerID, since).Order("created_at").Find(&heartbeats).Error
+	return heartbeats, err
+}
+
+func (d workerDao) DeleteHeartbeatsBefore(t time.Time) error {
+	return DB.Where("created_at < ?", t).Delete(&model.WorkerHeartbeat{}).Error
+}
@@ -34,6 +34,34 @@ func (m *MockCameraPresetDao) EXPECT() *MockCameraPresetDaoMockRecorder {
 	return m.recorder
 }
 
+// CreatePresetSwitch mocks base method.
+func (m *MockCameraPresetDao) CreatePresetSwitch(presetSwitch *model.CameraPresetSwitch) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePresetSwitch", presetSwitch)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreatePresetSwitch indicates an expected call of CreatePresetSwitch.
+func (mr *MockCameraPresetDaoMockRecorder) CreatePresetSwitch(presetSwitch interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePresetSwitch", reflect.TypeOf((*MockCameraPresetDao)(nil).CreatePresetSwitch), presetSwitch)
+}
+
+// DeletePresetSwitch mocks base method.
+func (m *MockCameraPresetDao) DeletePresetSwitch(id uint, seriesIdentifier string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePresetSwitch", id, seriesIdentifier)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePresetSwitch indicates an expected call of DeletePresetSwitch.
+func (mr *MockCameraPresetDaoMockRecorder) DeletePresetSwitch(id, seriesIdentifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePresetSwitch", reflect.TypeOf((*MockCameraPresetDao)(nil).DeletePresetSwitch), id, seriesIdentifier)
+}
+
 // GetDefaultCameraPreset mocks base method.
 func (m *MockCameraPresetDao) GetDefaultCameraPreset(lectureHallID uint) (model.CameraPreset, error) {
 	m.ctrl.T.Helper()
@@ -48,3 +76,18 @@ func (mr *MockCameraPresetDaoMockRecorder) GetDefaultCameraPreset(lectureHallID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDefaultCameraPreset", reflect.TypeOf((*MockCameraPresetDao)(nil).GetDefaultCameraPreset), lectureHallID)
 }
+
+// GetPresetSwitches mocks base method.
+func (m *MockCameraPresetDao) GetPresetSwitches(seriesIdentifier string) ([]model.CameraPresetSwitch, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPresetSwitches", seriesIdentifier)
+	ret0, _ := ret[0].([]model.CameraPresetSwitch)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPresetSwitches indicates an expected call of GetPresetSwitches.
+func (mr *MockCameraPresetDaoMockRecorder) GetPresetSwitches(seriesIdentifier interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresetSwitches", reflect.TypeOf((*MockCameraPresetDao)(nil).GetPresetSwitches), seriesIdentifier)
+}
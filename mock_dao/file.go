@@ -92,6 +92,20 @@ func (mr *MockFileDaoMockRecorder) NewFile(f interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewFile", reflect.TypeOf((*MockFileDao)(nil).NewFile), f)
 }
 
+// SetFile mocks base method.
+func (m *MockFileDao) SetFile(streamId uint, file model.File) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetFile", streamId, file)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetFile indicates an expected call of SetFile.
+func (mr *MockFileDaoMockRecorder) SetFile(streamId, file interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetFile", reflect.TypeOf((*MockFileDao)(nil).SetFile), streamId, file)
+}
+
 // SetThumbnail mocks base method.
 func (m *MockFileDao) SetThumbnail(streamId uint, thumb model.File) error {
 	m.ctrl.T.Helper()
@@ -76,6 +76,21 @@ func (mr *MockLectureHallsDaoMockRecorder) FindPreset(lectureHallID, presetID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindPreset", reflect.TypeOf((*MockLectureHallsDao)(nil).FindPreset), lectureHallID, presetID)
 }
 
+// GetAllHealth mocks base method.
+func (m *MockLectureHallsDao) GetAllHealth() ([]model.LectureHallHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAllHealth")
+	ret0, _ := ret[0].([]model.LectureHallHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAllHealth indicates an expected call of GetAllHealth.
+func (mr *MockLectureHallsDaoMockRecorder) GetAllHealth() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllHealth", reflect.TypeOf((*MockLectureHallsDao)(nil).GetAllHealth))
+}
+
 // GetAllLectureHalls mocks base method.
 func (m *MockLectureHallsDao) GetAllLectureHalls() []model.LectureHall {
 	m.ctrl.T.Helper()
@@ -90,6 +105,21 @@ func (mr *MockLectureHallsDaoMockRecorder) GetAllLectureHalls() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllLectureHalls", reflect.TypeOf((*MockLectureHallsDao)(nil).GetAllLectureHalls))
 }
 
+// GetHealth mocks base method.
+func (m *MockLectureHallsDao) GetHealth(lectureHallID uint) ([]model.LectureHallHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth", lectureHallID)
+	ret0, _ := ret[0].([]model.LectureHallHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockLectureHallsDaoMockRecorder) GetHealth(lectureHallID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockLectureHallsDao)(nil).GetHealth), lectureHallID)
+}
+
 // GetLectureHallByID mocks base method.
 func (m *MockLectureHallsDao) GetLectureHallByID(id uint) (model.LectureHall, error) {
 	m.ctrl.T.Helper()
@@ -135,6 +165,20 @@ func (mr *MockLectureHallsDaoMockRecorder) GetStreamsForLectureHallIcal(userId,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStreamsForLectureHallIcal", reflect.TypeOf((*MockLectureHallsDao)(nil).GetStreamsForLectureHallIcal), userId, lectureHalls, all)
 }
 
+// SaveHealth mocks base method.
+func (m *MockLectureHallsDao) SaveHealth(health *model.LectureHallHealth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveHealth", health)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveHealth indicates an expected call of SaveHealth.
+func (mr *MockLectureHallsDaoMockRecorder) SaveHealth(health interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveHealth", reflect.TypeOf((*MockLectureHallsDao)(nil).SaveHealth), health)
+}
+
 // SaveLectureHall mocks base method.
 func (m *MockLectureHallsDao) SaveLectureHall(lectureHall model.LectureHall) error {
 	m.ctrl.T.Helper()
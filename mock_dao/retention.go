@@ -0,0 +1,121 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: retention.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/joschahenningsen/TUM-Live/model"
+)
+
+// MockRetentionDao is a mock of RetentionDao interface.
+type MockRetentionDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockRetentionDaoMockRecorder
+}
+
+// MockRetentionDaoMockRecorder is the mock recorder for MockRetentionDao.
+type MockRetentionDaoMockRecorder struct {
+	mock *MockRetentionDao
+}
+
+// NewMockRetentionDao creates a new mock instance.
+func NewMockRetentionDao(ctrl *gomock.Controller) *MockRetentionDao {
+	mock := &MockRetentionDao{ctrl: ctrl}
+	mock.recorder = &MockRetentionDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockRetentionDao) EXPECT() *MockRetentionDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateNotice mocks base method.
+func (m *MockRetentionDao) CreateNotice(notice *model.RetentionNotice) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNotice", notice)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateNotice indicates an expected call of CreateNotice.
+func (mr *MockRetentionDaoMockRecorder) CreateNotice(notice interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNotice", reflect.TypeOf((*MockRetentionDao)(nil).CreateNotice), notice)
+}
+
+// DeleteNotice mocks base method.
+func (m *MockRetentionDao) DeleteNotice(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotice", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotice indicates an expected call of DeleteNotice.
+func (mr *MockRetentionDaoMockRecorder) DeleteNotice(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotice", reflect.TypeOf((*MockRetentionDao)(nil).DeleteNotice), id)
+}
+
+// DeleteNotices mocks base method.
+func (m *MockRetentionDao) DeleteNotices(courseID uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNotices", courseID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNotices indicates an expected call of DeleteNotices.
+func (mr *MockRetentionDaoMockRecorder) DeleteNotices(courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNotices", reflect.TypeOf((*MockRetentionDao)(nil).DeleteNotices), courseID)
+}
+
+// GetNotices mocks base method.
+func (m *MockRetentionDao) GetNotices(courseID uint) ([]model.RetentionNotice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNotices", courseID)
+	ret0, _ := ret[0].([]model.RetentionNotice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNotices indicates an expected call of GetNotices.
+func (mr *MockRetentionDaoMockRecorder) GetNotices(courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNotices", reflect.TypeOf((*MockRetentionDao)(nil).GetNotices), courseID)
+}
+
+// GetRetainedCourses mocks base method.
+func (m *MockRetentionDao) GetRetainedCourses() ([]model.Course, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRetainedCourses")
+	ret0, _ := ret[0].([]model.Course)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRetainedCourses indicates an expected call of GetRetainedCourses.
+func (mr *MockRetentionDaoMockRecorder) GetRetainedCourses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRetainedCourses", reflect.TypeOf((*MockRetentionDao)(nil).GetRetainedCourses))
+}
+
+// SetNoticeEnforced mocks base method.
+func (m *MockRetentionDao) SetNoticeEnforced(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNoticeEnforced", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNoticeEnforced indicates an expected call of SetNoticeEnforced.
+func (mr *MockRetentionDaoMockRecorder) SetNoticeEnforced(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNoticeEnforced", reflect.TypeOf((*MockRetentionDao)(nil).SetNoticeEnforced), id)
+}
@@ -0,0 +1,108 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: stream-standby.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	model "github.com/joschahenningsen/TUM-Live/model"
+)
+
+// MockStreamStandbyDao is a mock of StreamStandbyDao interface.
+type MockStreamStandbyDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockStreamStandbyDaoMockRecorder
+}
+
+// MockStreamStandbyDaoMockRecorder is the mock recorder for MockStreamStandbyDao.
+type MockStreamStandbyDaoMockRecorder struct {
+	mock *MockStreamStandbyDao
+}
+
+// NewMockStreamStandbyDao creates a new mock instance.
+func NewMockStreamStandbyDao(ctrl *gomock.Controller) *MockStreamStandbyDao {
+	mock := &MockStreamStandbyDao{ctrl: ctrl}
+	mock.recorder = &MockStreamStandbyDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockStreamStandbyDao) EXPECT() *MockStreamStandbyDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateStandby mocks base method.
+func (m *MockStreamStandbyDao) CreateStandby(standby *model.StreamStandby) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStandby", standby)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateStandby indicates an expected call of CreateStandby.
+func (mr *MockStreamStandbyDaoMockRecorder) CreateStandby(standby interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStandby", reflect.TypeOf((*MockStreamStandbyDao)(nil).CreateStandby), standby)
+}
+
+// GetActiveStandbys mocks base method.
+func (m *MockStreamStandbyDao) GetActiveStandbys() ([]model.StreamStandby, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetActiveStandbys")
+	ret0, _ := ret[0].([]model.StreamStandby)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetActiveStandbys indicates an expected call of GetActiveStandbys.
+func (mr *MockStreamStandbyDaoMockRecorder) GetActiveStandbys() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetActiveStandbys", reflect.TypeOf((*MockStreamStandbyDao)(nil).GetActiveStandbys))
+}
+
+// GetStandby mocks base method.
+func (m *MockStreamStandbyDao) GetStandby(streamID uint, sourceType string) (model.StreamStandby, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetStandby", streamID, sourceType)
+	ret0, _ := ret[0].(model.StreamStandby)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetStandby indicates an expected call of GetStandby.
+func (mr *MockStreamStandbyDaoMockRecorder) GetStandby(streamID, sourceType interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStandby", reflect.TypeOf((*MockStreamStandbyDao)(nil).GetStandby), streamID, sourceType)
+}
+
+// SaveStandby mocks base method.
+func (m *MockStreamStandbyDao) SaveStandby(standby *model.StreamStandby) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveStandby", standby)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveStandby indicates an expected call of SaveStandby.
+func (mr *MockStreamStandbyDaoMockRecorder) SaveStandby(standby interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveStandby", reflect.TypeOf((*MockStreamStandbyDao)(nil).SaveStandby), standby)
+}
+
+// SetTakenOver mocks base method.
+func (m *MockStreamStandbyDao) SetTakenOver(id uint, t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetTakenOver", id, t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetTakenOver indicates an expected call of SetTakenOver.
+func (mr *MockStreamStandbyDaoMockRecorder) SetTakenOver(id, t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTakenOver", reflect.TypeOf((*MockStreamStandbyDao)(nil).SetTakenOver), id, t)
+}
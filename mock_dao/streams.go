@@ -37,6 +37,20 @@ func (m *MockStreamsDao) EXPECT() *MockStreamsDaoMockRecorder {
 	return m.recorder
 }
 
+// AddHealth mocks base method.
+func (m *MockStreamsDao) AddHealth(health *model.StreamHealth) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHealth", health)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHealth indicates an expected call of AddHealth.
+func (mr *MockStreamsDaoMockRecorder) AddHealth(health interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHealth", reflect.TypeOf((*MockStreamsDao)(nil).AddHealth), health)
+}
+
 // AddVodView mocks base method.
 func (m *MockStreamsDao) AddVodView(id string) error {
 	m.ctrl.T.Helper()
@@ -79,6 +93,20 @@ func (mr *MockStreamsDaoMockRecorder) CreateStream(stream interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStream", reflect.TypeOf((*MockStreamsDao)(nil).CreateStream), stream)
 }
 
+// DeleteHealthBefore mocks base method.
+func (m *MockStreamsDao) DeleteHealthBefore(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHealthBefore", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHealthBefore indicates an expected call of DeleteHealthBefore.
+func (mr *MockStreamsDaoMockRecorder) DeleteHealthBefore(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHealthBefore", reflect.TypeOf((*MockStreamsDao)(nil).DeleteHealthBefore), t)
+}
+
 // DeleteLectureSeries mocks base method.
 func (m *MockStreamsDao) DeleteLectureSeries(arg0 string) error {
 	m.ctrl.T.Helper()
@@ -228,6 +256,21 @@ func (mr *MockStreamsDaoMockRecorder) GetDueStreamsForWorkers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDueStreamsForWorkers", reflect.TypeOf((*MockStreamsDao)(nil).GetDueStreamsForWorkers))
 }
 
+// GetHealth mocks base method.
+func (m *MockStreamsDao) GetHealth(streamID uint, since time.Time) ([]model.StreamHealth, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHealth", streamID, since)
+	ret0, _ := ret[0].([]model.StreamHealth)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHealth indicates an expected call of GetHealth.
+func (mr *MockStreamsDaoMockRecorder) GetHealth(streamID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHealth", reflect.TypeOf((*MockStreamsDao)(nil).GetHealth), streamID, since)
+}
+
 // GetLiveStreamsInLectureHall mocks base method.
 func (m *MockStreamsDao) GetLiveStreamsInLectureHall(lectureHallId uint) ([]model.Stream, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +286,21 @@ func (mr *MockStreamsDaoMockRecorder) GetLiveStreamsInLectureHall(lectureHallId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLiveStreamsInLectureHall", reflect.TypeOf((*MockStreamsDao)(nil).GetLiveStreamsInLectureHall), lectureHallId)
 }
 
+// GetPodcastStreams mocks base method.
+func (m *MockStreamsDao) GetPodcastStreams(ctx context.Context, courseID uint) ([]model.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPodcastStreams", ctx, courseID)
+	ret0, _ := ret[0].([]model.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPodcastStreams indicates an expected call of GetPodcastStreams.
+func (mr *MockStreamsDaoMockRecorder) GetPodcastStreams(ctx, courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodcastStreams", reflect.TypeOf((*MockStreamsDao)(nil).GetPodcastStreams), ctx, courseID)
+}
+
 // GetStreamByID mocks base method.
 func (m *MockStreamsDao) GetStreamByID(ctx context.Context, id string) (model.Stream, error) {
 	m.ctrl.T.Helper()
@@ -348,6 +406,21 @@ func (mr *MockStreamsDaoMockRecorder) GetUnitByID(id interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUnitByID", reflect.TypeOf((*MockStreamsDao)(nil).GetUnitByID), id)
 }
 
+// GetUpcomingLectureHallStreams mocks base method.
+func (m *MockStreamsDao) GetUpcomingLectureHallStreams(until time.Time) ([]model.Stream, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUpcomingLectureHallStreams", until)
+	ret0, _ := ret[0].([]model.Stream)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUpcomingLectureHallStreams indicates an expected call of GetUpcomingLectureHallStreams.
+func (mr *MockStreamsDaoMockRecorder) GetUpcomingLectureHallStreams(until interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpcomingLectureHallStreams", reflect.TypeOf((*MockStreamsDao)(nil).GetUpcomingLectureHallStreams), until)
+}
+
 // GetWorkersForStream mocks base method.
 func (m *MockStreamsDao) GetWorkersForStream(stream model.Stream) ([]model.Worker, error) {
 	m.ctrl.T.Helper()
@@ -483,6 +556,20 @@ func (mr *MockStreamsDaoMockRecorder) SetLectureHall(streamIDs, lectureHallID in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLectureHall", reflect.TypeOf((*MockStreamsDao)(nil).SetLectureHall), streamIDs, lectureHallID)
 }
 
+// SetLiveSource mocks base method.
+func (m *MockStreamsDao) SetLiveSource(streamID uint, source string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetLiveSource", streamID, source)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetLiveSource indicates an expected call of SetLiveSource.
+func (mr *MockStreamsDaoMockRecorder) SetLiveSource(streamID, source interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetLiveSource", reflect.TypeOf((*MockStreamsDao)(nil).SetLiveSource), streamID, source)
+}
+
 // SetStreamLiveNowTimestampById mocks base method.
 func (m *MockStreamsDao) SetStreamLiveNowTimestampById(streamID uint, liveNowTimestamp time.Time) error {
 	m.ctrl.T.Helper()
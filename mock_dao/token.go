@@ -93,6 +93,21 @@ func (mr *MockTokenDaoMockRecorder) GetToken(token interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetToken", reflect.TypeOf((*MockTokenDao)(nil).GetToken), token)
 }
 
+// GetUserToken mocks base method.
+func (m *MockTokenDao) GetUserToken(userID uint, scope string) (model.Token, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserToken", userID, scope)
+	ret0, _ := ret[0].(model.Token)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserToken indicates an expected call of GetUserToken.
+func (mr *MockTokenDaoMockRecorder) GetUserToken(userID, scope interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserToken", reflect.TypeOf((*MockTokenDao)(nil).GetUserToken), userID, scope)
+}
+
 // TokenUsed mocks base method.
 func (m *MockTokenDao) TokenUsed(token model.Token) error {
 	m.ctrl.T.Helper()
@@ -35,17 +35,17 @@ func (m *MockUploadKeyDao) EXPECT() *MockUploadKeyDaoMockRecorder {
 }
 
 // CreateUploadKey mocks base method.
-func (m *MockUploadKeyDao) CreateUploadKey(key string, stream uint) error {
+func (m *MockUploadKeyDao) CreateUploadKey(key *model.UploadKey) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "CreateUploadKey", key, stream)
+	ret := m.ctrl.Call(m, "CreateUploadKey", key)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // CreateUploadKey indicates an expected call of CreateUploadKey.
-func (mr *MockUploadKeyDaoMockRecorder) CreateUploadKey(key, stream interface{}) *gomock.Call {
+func (mr *MockUploadKeyDaoMockRecorder) CreateUploadKey(key interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadKey", reflect.TypeOf((*MockUploadKeyDao)(nil).CreateUploadKey), key, stream)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUploadKey", reflect.TypeOf((*MockUploadKeyDao)(nil).CreateUploadKey), key)
 }
 
 // DeleteUploadKey mocks base method.
@@ -76,3 +76,32 @@ func (mr *MockUploadKeyDaoMockRecorder) GetUploadKey(key interface{}) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadKey", reflect.TypeOf((*MockUploadKeyDao)(nil).GetUploadKey), key)
 }
+
+// GetUploadKeysForCourse mocks base method.
+func (m *MockUploadKeyDao) GetUploadKeysForCourse(courseID uint) ([]model.UploadKey, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadKeysForCourse", courseID)
+	ret0, _ := ret[0].([]model.UploadKey)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadKeysForCourse indicates an expected call of GetUploadKeysForCourse.
+func (mr *MockUploadKeyDaoMockRecorder) GetUploadKeysForCourse(courseID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadKeysForCourse", reflect.TypeOf((*MockUploadKeyDao)(nil).GetUploadKeysForCourse), courseID)
+}
+
+// UpdateUploadOffset mocks base method.
+func (m *MockUploadKeyDao) UpdateUploadOffset(key string, offset int64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUploadOffset", key, offset)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateUploadOffset indicates an expected call of UpdateUploadOffset.
+func (mr *MockUploadKeyDaoMockRecorder) UpdateUploadOffset(key, offset interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUploadOffset", reflect.TypeOf((*MockUploadKeyDao)(nil).UpdateUploadOffset), key, offset)
+}
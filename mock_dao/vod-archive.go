@@ -0,0 +1,138 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: vod-archive.go
+
+// Package mock_dao is a generated GoMock package.
+package mock_dao
+
+import (
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	dao "github.com/joschahenningsen/TUM-Live/dao"
+	model "github.com/joschahenningsen/TUM-Live/model"
+)
+
+// MockVodArchiveDao is a mock of VodArchiveDao interface.
+type MockVodArchiveDao struct {
+	ctrl     *gomock.Controller
+	recorder *MockVodArchiveDaoMockRecorder
+}
+
+// MockVodArchiveDaoMockRecorder is the mock recorder for MockVodArchiveDao.
+type MockVodArchiveDaoMockRecorder struct {
+	mock *MockVodArchiveDao
+}
+
+// NewMockVodArchiveDao creates a new mock instance.
+func NewMockVodArchiveDao(ctrl *gomock.Controller) *MockVodArchiveDao {
+	mock := &MockVodArchiveDao{ctrl: ctrl}
+	mock.recorder = &MockVodArchiveDaoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockVodArchiveDao) EXPECT() *MockVodArchiveDaoMockRecorder {
+	return m.recorder
+}
+
+// CreateArchive mocks base method.
+func (m *MockVodArchiveDao) CreateArchive(archive *model.VodArchive) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateArchive", archive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CreateArchive indicates an expected call of CreateArchive.
+func (mr *MockVodArchiveDaoMockRecorder) CreateArchive(archive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateArchive", reflect.TypeOf((*MockVodArchiveDao)(nil).CreateArchive), archive)
+}
+
+// DeleteArchive mocks base method.
+func (m *MockVodArchiveDao) DeleteArchive(id uint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteArchive", id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteArchive indicates an expected call of DeleteArchive.
+func (mr *MockVodArchiveDaoMockRecorder) DeleteArchive(id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteArchive", reflect.TypeOf((*MockVodArchiveDao)(nil).DeleteArchive), id)
+}
+
+// DeletePendingBefore mocks base method.
+func (m *MockVodArchiveDao) DeletePendingBefore(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePendingBefore", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeletePendingBefore indicates an expected call of DeletePendingBefore.
+func (mr *MockVodArchiveDaoMockRecorder) DeletePendingBefore(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePendingBefore", reflect.TypeOf((*MockVodArchiveDao)(nil).DeletePendingBefore), t)
+}
+
+// GetArchivableFiles mocks base method.
+func (m *MockVodArchiveDao) GetArchivableFiles(t time.Time, limit int) ([]model.File, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchivableFiles", t, limit)
+	ret0, _ := ret[0].([]model.File)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchivableFiles indicates an expected call of GetArchivableFiles.
+func (mr *MockVodArchiveDaoMockRecorder) GetArchivableFiles(t, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchivableFiles", reflect.TypeOf((*MockVodArchiveDao)(nil).GetArchivableFiles), t, limit)
+}
+
+// GetArchiveByFileID mocks base method.
+func (m *MockVodArchiveDao) GetArchiveByFileID(fileID uint) (model.VodArchive, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchiveByFileID", fileID)
+	ret0, _ := ret[0].(model.VodArchive)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchiveByFileID indicates an expected call of GetArchiveByFileID.
+func (mr *MockVodArchiveDaoMockRecorder) GetArchiveByFileID(fileID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchiveByFileID", reflect.TypeOf((*MockVodArchiveDao)(nil).GetArchiveByFileID), fileID)
+}
+
+// GetArchiveStats mocks base method.
+func (m *MockVodArchiveDao) GetArchiveStats() ([]dao.VodArchiveStats, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetArchiveStats")
+	ret0, _ := ret[0].([]dao.VodArchiveStats)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetArchiveStats indicates an expected call of GetArchiveStats.
+func (mr *MockVodArchiveDaoMockRecorder) GetArchiveStats() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetArchiveStats", reflect.TypeOf((*MockVodArchiveDao)(nil).GetArchiveStats))
+}
+
+// SaveArchive mocks base method.
+func (m *MockVodArchiveDao) SaveArchive(archive *model.VodArchive) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SaveArchive", archive)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SaveArchive indicates an expected call of SaveArchive.
+func (mr *MockVodArchiveDaoMockRecorder) SaveArchive(archive interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveArchive", reflect.TypeOf((*MockVodArchiveDao)(nil).SaveArchive), archive)
+}
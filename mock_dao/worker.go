@@ -7,6 +7,7 @@ package mock_dao
 import (
 	context "context"
 	reflect "reflect"
+	time "time"
 
 	gomock "github.com/golang/mock/gomock"
 	model "github.com/joschahenningsen/TUM-Live/model"
@@ -35,6 +36,20 @@ func (m *MockWorkerDao) EXPECT() *MockWorkerDaoMockRecorder {
 	return m.recorder
 }
 
+// AddHeartbeat mocks base method.
+func (m *MockWorkerDao) AddHeartbeat(heartbeat *model.WorkerHeartbeat) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHeartbeat", heartbeat)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddHeartbeat indicates an expected call of AddHeartbeat.
+func (mr *MockWorkerDaoMockRecorder) AddHeartbeat(heartbeat interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHeartbeat", reflect.TypeOf((*MockWorkerDao)(nil).AddHeartbeat), heartbeat)
+}
+
 // CreateWorker mocks base method.
 func (m *MockWorkerDao) CreateWorker(worker *model.Worker) error {
 	m.ctrl.T.Helper()
@@ -49,6 +64,20 @@ func (mr *MockWorkerDaoMockRecorder) CreateWorker(worker interface{}) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWorker", reflect.TypeOf((*MockWorkerDao)(nil).CreateWorker), worker)
 }
 
+// DeleteHeartbeatsBefore mocks base method.
+func (m *MockWorkerDao) DeleteHeartbeatsBefore(t time.Time) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHeartbeatsBefore", t)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteHeartbeatsBefore indicates an expected call of DeleteHeartbeatsBefore.
+func (mr *MockWorkerDaoMockRecorder) DeleteHeartbeatsBefore(t interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHeartbeatsBefore", reflect.TypeOf((*MockWorkerDao)(nil).DeleteHeartbeatsBefore), t)
+}
+
 // DeleteWorker mocks base method.
 func (m *MockWorkerDao) DeleteWorker(workerID string) error {
 	m.ctrl.T.Helper()
@@ -92,6 +121,35 @@ func (mr *MockWorkerDaoMockRecorder) GetAllWorkers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAllWorkers", reflect.TypeOf((*MockWorkerDao)(nil).GetAllWorkers))
 }
 
+// GetHeartbeats mocks base method.
+func (m *MockWorkerDao) GetHeartbeats(workerID string, since time.Time) ([]model.WorkerHeartbeat, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeartbeats", workerID, since)
+	ret0, _ := ret[0].([]model.WorkerHeartbeat)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeartbeats indicates an expected call of GetHeartbeats.
+func (mr *MockWorkerDaoMockRecorder) GetHeartbeats(workerID, since interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeartbeats", reflect.TypeOf((*MockWorkerDao)(nil).GetHeartbeats), workerID, since)
+}
+
+// GetSchedulableWorkers mocks base method.
+func (m *MockWorkerDao) GetSchedulableWorkers() []model.Worker {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSchedulableWorkers")
+	ret0, _ := ret[0].([]model.Worker)
+	return ret0
+}
+
+// GetSchedulableWorkers indicates an expected call of GetSchedulableWorkers.
+func (mr *MockWorkerDaoMockRecorder) GetSchedulableWorkers() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSchedulableWorkers", reflect.TypeOf((*MockWorkerDao)(nil).GetSchedulableWorkers))
+}
+
 // GetWorkerByHostname mocks base method.
 func (m *MockWorkerDao) GetWorkerByHostname(ctx context.Context, hostname string) (model.Worker, error) {
 	m.ctrl.T.Helper()
@@ -135,3 +193,17 @@ func (mr *MockWorkerDaoMockRecorder) SaveWorker(worker interface{}) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SaveWorker", reflect.TypeOf((*MockWorkerDao)(nil).SaveWorker), worker)
 }
+
+// UpdateWorker mocks base method.
+func (m *MockWorkerDao) UpdateWorker(workerID string, fields map[string]interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWorker", workerID, fields)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UpdateWorker indicates an expected call of UpdateWorker.
+func (mr *MockWorkerDaoMockRecorder) UpdateWorker(workerID, fields interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWorker", reflect.TypeOf((*MockWorkerDao)(nil).UpdateWorker), workerID, fields)
+}
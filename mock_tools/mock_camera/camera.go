@@ -49,6 +49,34 @@ func (mr *MockCamMockRecorder) GetPresets() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPresets", reflect.TypeOf((*MockCam)(nil).GetPresets))
 }
 
+// Move mocks base method.
+func (m *MockCam) Move(pan, tilt, zoom float64) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Move", pan, tilt, zoom)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Move indicates an expected call of Move.
+func (mr *MockCamMockRecorder) Move(pan, tilt, zoom interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Move", reflect.TypeOf((*MockCam)(nil).Move), pan, tilt, zoom)
+}
+
+// SavePreset mocks base method.
+func (m *MockCam) SavePreset(presetId int, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SavePreset", presetId, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SavePreset indicates an expected call of SavePreset.
+func (mr *MockCamMockRecorder) SavePreset(presetId, name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SavePreset", reflect.TypeOf((*MockCam)(nil).SavePreset), presetId, name)
+}
+
 // SetPreset mocks base method.
 func (m *MockCam) SetPreset(presetId int) error {
 	m.ctrl.T.Helper()
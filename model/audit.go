@@ -18,6 +18,7 @@ const (
 	AuditStreamEdit
 	AuditStreamDelete
 	AuditCameraMoved
+	AuditRetention
 )
 
 // String returns a string representation of the AuditType
@@ -33,6 +34,7 @@ func (t AuditType) String() string {
 		"Stream Edited",
 		"Stream Deleted",
 		"Camera Moved",
+		"Retention",
 	}[t-1]
 }
 
@@ -47,6 +49,7 @@ func GetAllAuditTypes() []AuditType {
 		AuditStreamEdit,
 		AuditStreamDelete,
 		AuditCameraMoved,
+		AuditRetention,
 	}
 }
 
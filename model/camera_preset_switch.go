@@ -0,0 +1,52 @@
+package model
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"time"
+)
+
+// CameraPresetSwitch is a scheduled switch to a camera preset during the lectures of a lecture series.
+// It is due Offset minutes after the start of every lecture or, if Weekday is set, weekly at TimeOfDay.
+type CameraPresetSwitch struct {
+	gorm.Model
+
+	SeriesIdentifier string `gorm:"not null;index"`
+	LectureHallID    uint   `gorm:"not null"`
+	PresetID         int    `gorm:"not null"`
+
+	Offset    uint          // minutes after the start of the lecture, only used if Weekday is nil
+	Weekday   *time.Weekday // weekday of weekly switches
+	TimeOfDay uint          // minutes after midnight of weekly switches
+}
+
+// DueAt returns the time at which the switch is due during stream on the day of now.
+// ok is false if the switch isn't due during the stream on that day.
+func (s CameraPresetSwitch) DueAt(stream Stream, now time.Time) (due time.Time, ok bool) {
+	if s.Weekday == nil {
+		due = stream.Start.Add(time.Duration(s.Offset) * time.Minute)
+	} else {
+		if now.Weekday() != *s.Weekday {
+			return due, false
+		}
+		y, m, d := now.Date()
+		due = time.Date(y, m, d, 0, 0, 0, 0, now.Location()).Add(time.Duration(s.TimeOfDay) * time.Minute)
+	}
+	return due, !due.Before(stream.Start) && due.Before(stream.End)
+}
+
+// Json converts the switch into a json object consumed by apis
+func (s CameraPresetSwitch) Json() gin.H {
+	res := gin.H{
+		"id":            s.ID,
+		"lectureHallID": s.LectureHallID,
+		"presetID":      s.PresetID,
+		"offset":        s.Offset,
+		"weekday":       nil,
+		"timeOfDay":     s.TimeOfDay,
+	}
+	if s.Weekday != nil {
+		res["weekday"] = int(*s.Weekday)
+	}
+	return res
+}
@@ -0,0 +1,34 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCameraPresetSwitchDueAt(t *testing.T) {
+	start := time.Date(2022, time.November, 8, 10, 15, 0, 0, time.UTC) // tuesday
+	stream := Stream{Start: start, End: start.Add(90 * time.Minute)}
+	tuesday, wednesday := time.Tuesday, time.Wednesday
+
+	tests := []struct {
+		name   string
+		s      CameraPresetSwitch
+		now    time.Time
+		wantOk bool
+		want   time.Time
+	}{
+		{"offset", CameraPresetSwitch{Offset: 30}, start, true, start.Add(30 * time.Minute)},
+		{"offset after the lecture", CameraPresetSwitch{Offset: 120}, start, false, time.Time{}},
+		{"weekly", CameraPresetSwitch{Weekday: &tuesday, TimeOfDay: 11*60 + 30}, start.Add(time.Hour), true, start.Add(75 * time.Minute)},
+		{"weekly on other day", CameraPresetSwitch{Weekday: &wednesday, TimeOfDay: 11*60 + 30}, start, false, time.Time{}},
+		{"weekly before the lecture", CameraPresetSwitch{Weekday: &tuesday, TimeOfDay: 9 * 60}, start, false, time.Time{}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			due, ok := test.s.DueAt(stream, test.now)
+			if ok != test.wantOk || (ok && !due.Equal(test.want)) {
+				t.Errorf("DueAt() = %v, %v, want %v, %v", due, ok, test.want, test.wantOk)
+			}
+		})
+	}
+}
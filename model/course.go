@@ -36,10 +36,18 @@ type Course struct {
 	UserCreatedByToken      bool   `gorm:"default:false"`
 	CameraPresetPreferences string // json encoded. e.g. [{lectureHallID:1, presetID:4}, ...]
 	SourcePreferences       string // json encoded. e.g. [{lectureHallID:1, sourceMode:0}, ...]
-	Pinned                  bool   `gorm:"-"` // Used to determine if the course is pinned when loaded for a specific user.
+	PipLayout               string // json encoded. e.g. {"enabled":true,"position":"bottom-right","size":25}
+	MinSilenceDuration      uint   `gorm:"not null;default:30"`    // minimum duration of detected silences in seconds
+	NormalizeLoudness       bool   `gorm:"not null;default:false"` // EBU R128 loudness normalization of recordings
+	Denoise                 bool   `gorm:"not null;default:false"` // noise reduction of recordings
+	EncodingProfile         string `gorm:"not null;default:''"`    // name of the encoding profile, overrides the lecture hall's if set
+	Pinned                  bool   `gorm:"-"`                      // Used to determine if the course is pinned when loaded for a specific user.
 
 	LivePrivate bool `gorm:"not null; default:false"` // whether Livestreams are private
 	VodPrivate  bool `gorm:"not null; default:false"` // Whether VODs are made private after livestreams
+
+	RetentionPolicy RetentionPolicy `gorm:"not null;default:1"` // what happens to recordings after the RetentionPeriod
+	RetentionPeriod uint            `gorm:"not null;default:0"` // days for RetentionDeleteRaw, semesters after the course's otherwise
 }
 
 type CourseDTO struct {
@@ -149,6 +157,88 @@ func (c *Course) SetSourcePreference(pref []SourcePreference) {
 	c.SourcePreferences = string(pBytes)
 }
 
+const (
+	MinSilenceDurationMin = 5   // shortest pause that can be configured as silence in seconds
+	MinSilenceDurationMax = 600 // longest pause that can be configured as silence in seconds
+)
+
+// AudioProcessing is the audio enhancement applied when transcoding recordings
+type AudioProcessing struct {
+	NormalizeLoudness bool // EBU R128 loudness normalization
+	Denoise           bool // noise reduction
+}
+
+// GetAudioProcessing returns the audio processing for recordings of the course in the lecture hall.
+// Filters are applied if they are enabled for either the course or the lecture hall.
+func (c Course) GetAudioProcessing(lectureHall LectureHall) AudioProcessing {
+	return AudioProcessing{
+		NormalizeLoudness: c.NormalizeLoudness || lectureHall.NormalizeLoudness,
+		Denoise:           c.Denoise || lectureHall.Denoise,
+	}
+}
+
+// GetEncodingProfile returns the name of the encoding profile for streams of the course in the lecture hall.
+// The course's profile takes precedence, an empty name means neither has one.
+func (c Course) GetEncodingProfile(lectureHall LectureHall) string {
+	if c.EncodingProfile != "" {
+		return c.EncodingProfile
+	}
+	return lectureHall.EncodingProfile
+}
+
+// PipPosition is the corner of the presentation the camera is shown in
+type PipPosition string
+
+const (
+	PipTopLeft     PipPosition = "top-left"
+	PipTopRight    PipPosition = "top-right"
+	PipBottomLeft  PipPosition = "bottom-left"
+	PipBottomRight PipPosition = "bottom-right"
+)
+
+const (
+	PipMinSize     = 10
+	PipMaxSize     = 50
+	pipDefaultSize = 25
+)
+
+// PipLayout is the layout of the picture-in-picture composite of a courses' recordings:
+// the presentation with the camera as inset
+type PipLayout struct {
+	Enabled  bool        `json:"enabled"`  // whether the composite is rendered for recordings with camera and presentation
+	Position PipPosition `json:"position"` // corner of the camera inset
+	Size     uint        `json:"size"`     // width of the camera inset in percent of the presentation's width
+}
+
+// IsValid returns whether the position and size of the layout are valid
+func (l PipLayout) IsValid() bool {
+	switch l.Position {
+	case PipTopLeft, PipTopRight, PipBottomLeft, PipBottomRight:
+	default:
+		return false
+	}
+	return l.Size >= PipMinSize && l.Size <= PipMaxSize
+}
+
+// GetPipLayout retrieves the picture-in-picture layout, returns the disabled default layout if none or an invalid one is set
+func (c Course) GetPipLayout() PipLayout {
+	var res PipLayout
+	if err := json.Unmarshal([]byte(c.PipLayout), &res); err != nil || !res.IsValid() {
+		return PipLayout{Position: PipBottomRight, Size: pipDefaultSize}
+	}
+	return res
+}
+
+// SetPipLayout updates the picture-in-picture layout
+func (c *Course) SetPipLayout(layout PipLayout) {
+	pBytes, err := json.Marshal(layout)
+	if err != nil {
+		logrus.WithError(err).Error("Could not marshal pip layout")
+		return
+	}
+	c.PipLayout = string(pBytes)
+}
+
 // CompareTo used for sorting. Falling back to old java habits...
 func (c Course) CompareTo(other Course) bool {
 	if !other.HasNextLecture() {
@@ -0,0 +1,99 @@
+package model
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPipLayout(t *testing.T) {
+	defaultLayout := PipLayout{Position: PipBottomRight, Size: pipDefaultSize}
+	if l := (Course{}).GetPipLayout(); l != defaultLayout {
+		t.Errorf("courses without layout should use the disabled default layout, got %+v", l)
+	}
+	if l := (Course{PipLayout: `{"enabled":true,"position":"center","size":25}`}).GetPipLayout(); l != defaultLayout {
+		t.Errorf("invalid layouts should be replaced by the default layout, got %+v", l)
+	}
+
+	layout := PipLayout{Enabled: true, Position: PipTopLeft, Size: 30}
+	var c Course
+	c.SetPipLayout(layout)
+	if l := c.GetPipLayout(); l != layout {
+		t.Errorf("GetPipLayout() = %+v, want %+v", l, layout)
+	}
+}
+
+func TestPipLayoutIsValid(t *testing.T) {
+	tests := []struct {
+		layout PipLayout
+		valid  bool
+	}{
+		{PipLayout{Position: PipTopRight, Size: PipMinSize}, true},
+		{PipLayout{Position: PipBottomLeft, Size: PipMaxSize}, true},
+		{PipLayout{Position: PipTopRight, Size: PipMinSize - 1}, false},
+		{PipLayout{Position: PipTopRight, Size: PipMaxSize + 1}, false},
+		{PipLayout{Position: "", Size: 25}, false},
+	}
+	for _, test := range tests {
+		if valid := test.layout.IsValid(); valid != test.valid {
+			t.Errorf("%+v.IsValid() = %v, want %v", test.layout, valid, test.valid)
+		}
+	}
+}
+
+func TestGetAudioProcessing(t *testing.T) {
+	tests := []struct {
+		course      Course
+		lectureHall LectureHall
+		want        AudioProcessing
+	}{
+		{Course{}, LectureHall{}, AudioProcessing{}},
+		{Course{NormalizeLoudness: true}, LectureHall{Denoise: true}, AudioProcessing{NormalizeLoudness: true, Denoise: true}},
+		{Course{}, LectureHall{NormalizeLoudness: true}, AudioProcessing{NormalizeLoudness: true}},
+		{Course{Denoise: true}, LectureHall{}, AudioProcessing{Denoise: true}},
+	}
+	for _, test := range tests {
+		if got := test.course.GetAudioProcessing(test.lectureHall); got != test.want {
+			t.Errorf("GetAudioProcessing() = %+v, want %+v", got, test.want)
+		}
+	}
+}
+
+func TestGetEncodingProfile(t *testing.T) {
+	tests := []struct {
+		course      Course
+		lectureHall LectureHall
+		want        string
+	}{
+		{Course{}, LectureHall{}, ""},
+		{Course{}, LectureHall{EncodingProfile: "nvenc"}, "nvenc"},
+		{Course{EncodingProfile: "low"}, LectureHall{EncodingProfile: "nvenc"}, "low"},
+	}
+	for _, test := range tests {
+		if got := test.course.GetEncodingProfile(test.lectureHall); got != test.want {
+			t.Errorf("GetEncodingProfile() = %s, want %s", got, test.want)
+		}
+	}
+}
+
+func TestRetentionDueAt(t *testing.T) {
+	end := time.Date(2022, time.May, 10, 12, 0, 0, 0, time.Local)
+	tests := []struct {
+		course Course
+		due    time.Time
+		ok     bool
+	}{
+		{Course{RetentionPolicy: RetentionKeep, RetentionPeriod: 2}, time.Time{}, false},
+		{Course{RetentionPolicy: RetentionDeleteRaw, RetentionPeriod: 30}, end.AddDate(0, 0, 30), true},
+		{Course{RetentionPolicy: RetentionArchive, Year: 2022, TeachingTerm: "S", RetentionPeriod: 0},
+			time.Date(2022, time.October, 1, 0, 0, 0, 0, time.Local), true},
+		{Course{RetentionPolicy: RetentionDelete, Year: 2021, TeachingTerm: "W", RetentionPeriod: 3},
+			time.Date(2023, time.October, 1, 0, 0, 0, 0, time.Local), true},
+	}
+	for _, test := range tests {
+		due, ok := test.course.RetentionDueAt(Stream{End: end})
+		if ok != test.ok || !due.Equal(test.due) {
+			t.Errorf("RetentionDueAt() of %v after %d = %v, %v, want %v, %v",
+				test.course.RetentionPolicy, test.course.RetentionPeriod, due, ok, test.due, test.ok)
+		}
+	}
+}
@@ -21,6 +21,7 @@ const (
 	FILETYPE_THUMB_LG_CAM
 	FILETYPE_THUMB_LG_PRES
 	FILETYPE_THUMB_LG_CAM_PRES // generated from CAM and PRES, preferred over the others
+	FILETYPE_AUDIO             // audio only version of the vod, used for podcasts
 )
 
 type File struct {
@@ -63,6 +64,9 @@ func (f File) GetVodTypeByName() string {
 	if strings.HasSuffix(f.Path, "PRES.mp4") {
 		return "PRES"
 	}
+	if strings.HasSuffix(f.Path, "PIP.mp4") {
+		return "PIP"
+	}
 	return "COMB"
 }
 
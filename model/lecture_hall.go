@@ -17,7 +17,15 @@ type LectureHall struct {
 	RoomID         int    // used by TUMOnline
 	PwrCtrlIp      string // power control api for red live light
 	LiveLightIndex int    // id of power outlet for live light
-	ExternalURL    string
+	// LiveIndicator is the driver used to switch the live light at PwrCtrlIp
+	LiveIndicator      LiveIndicatorType `gorm:"not null;default:1"`
+	LiveIndicatorTopic string            // mqtt topic of the live light
+	ExternalURL        string
+
+	NormalizeLoudness bool `gorm:"not null;default:false"` // EBU R128 loudness normalization of recordings
+	Denoise           bool `gorm:"not null;default:false"` // noise reduction of recordings
+
+	EncodingProfile string // name of the encoding profile of streams in the lecture hall
 }
 
 type CameraType uint
@@ -25,8 +33,72 @@ type CameraType uint
 const (
 	Axis CameraType = iota + 1
 	Panasonic
+	Visca // VISCA over IP
+	Onvif // ONVIF PTZ
+)
+
+// CameraTypes are all supported camera types
+var CameraTypes = []CameraType{Axis, Panasonic, Visca, Onvif}
+
+// IsValid returns whether t is a supported camera type
+func (t CameraType) IsValid() bool {
+	for _, cameraType := range CameraTypes {
+		if t == cameraType {
+			return true
+		}
+	}
+	return false
+}
+
+func (t CameraType) String() string {
+	switch t {
+	case Axis:
+		return "Axis"
+	case Panasonic:
+		return "Panasonic"
+	case Visca:
+		return "VISCA over IP"
+	case Onvif:
+		return "ONVIF"
+	default:
+		return "Unknown"
+	}
+}
+
+type LiveIndicatorType uint
+
+const (
+	AnelIndicator LiveIndicatorType = iota + 1 // Anel PwrCtrl power outlets
+	HTTPIndicator                              // generic http request, e.g. to a smart plug
+	MQTTIndicator                              // message published to an mqtt broker
 )
 
+// LiveIndicatorTypes are all supported live indicator drivers
+var LiveIndicatorTypes = []LiveIndicatorType{AnelIndicator, HTTPIndicator, MQTTIndicator}
+
+// IsValid returns whether t is a supported live indicator driver
+func (t LiveIndicatorType) IsValid() bool {
+	for _, indicatorType := range LiveIndicatorTypes {
+		if t == indicatorType {
+			return true
+		}
+	}
+	return false
+}
+
+func (t LiveIndicatorType) String() string {
+	switch t {
+	case AnelIndicator:
+		return "Anel PwrCtrl"
+	case HTTPIndicator:
+		return "HTTP"
+	case MQTTIndicator:
+		return "MQTT"
+	default:
+		return "Unknown"
+	}
+}
+
 func (l LectureHall) NumSources() int {
 	num := 0
 	if l.CombIP != "" {
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+	"time"
+)
+
+// LectureHallHealth is the result of the latest reachability check of a source of a lecture hall.
+// There is at most one entry per lecture hall and source type.
+type LectureHallHealth struct {
+	gorm.Model
+
+	LectureHallID   uint      `gorm:"not null;uniqueIndex:idx_lecture_hall_source"`
+	SourceType      string    `gorm:"type:varchar(4);not null;uniqueIndex:idx_lecture_hall_source"` // COMB, PRES or CAM
+	Source          string    `gorm:"not null"`                                                     // e.g. 10.0.0.1/extron3
+	Reachable       bool      `gorm:"not null;default:false"`
+	Error           string    // why the source could not be reached
+	CheckedAt       time.Time `gorm:"not null"`
+	WorkerID        string    // worker that probed the source
+	StreamID        uint      // next stream in the lecture hall at the time of the check
+	AlertedStreamID uint      // last stream an alert about this source was sent for
+}
+
+// Json returns the check result as it is used in the admin api
+func (h LectureHallHealth) Json() gin.H {
+	return gin.H{
+		"lectureHallID": h.LectureHallID,
+		"sourceType":    h.SourceType,
+		"source":        h.Source,
+		"reachable":     h.Reachable,
+		"error":         h.Error,
+		"checkedAt":     h.CheckedAt,
+		"workerID":      h.WorkerID,
+		"streamID":      h.StreamID,
+	}
+}
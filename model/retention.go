@@ -0,0 +1,70 @@
+package model
+
+import (
+	"gorm.io/gorm"
+	"time"
+)
+
+// RetentionPolicy decides what happens to the recordings of a course after its retention period
+type RetentionPolicy uint
+
+const (
+	RetentionKeep      RetentionPolicy = iota + 1 // recordings are kept forever
+	RetentionDeleteRaw                            // raw recordings on the workers are deleted N days after the stream, VoDs stay
+	RetentionArchive                              // VoDs are archived N semesters after the course's semester
+	RetentionDelete                               // streams are deleted entirely N semesters after the course's semester
+)
+
+// RetentionPeriodMax is the longest retention period that can be set, in days or semesters
+const RetentionPeriodMax = 3650
+
+// IsValid returns whether p is one of the known retention policies
+func (p RetentionPolicy) IsValid() bool {
+	return p >= RetentionKeep && p <= RetentionDelete
+}
+
+// String returns a string representation of the RetentionPolicy
+func (p RetentionPolicy) String() string {
+	if !p.IsValid() {
+		return "Unknown"
+	}
+	return []string{
+		"Keep forever",
+		"Delete raw recordings",
+		"Archive VoDs",
+		"Delete entirely",
+	}[p-1]
+}
+
+// RetentionNotice is the notification of the course admins that a stream is going to be deleted by a retention policy
+type RetentionNotice struct {
+	gorm.Model
+
+	StreamID uint            `gorm:"not null;uniqueIndex"`
+	CourseID uint            `gorm:"not null;index"`
+	Policy   RetentionPolicy `gorm:"not null"`
+	DueAt    time.Time       `gorm:"not null"`               // the stream isn't deleted before, even if the policy changes to a shorter period
+	Enforced bool            `gorm:"not null;default:false"` // whether the raw recordings were deleted, streams keep their notice afterwards
+}
+
+// semesterEnd returns the end of the teaching term of the course. Winter terms end in March of the following year,
+// summer terms in September.
+func (c Course) semesterEnd() time.Time {
+	if c.TeachingTerm == "W" {
+		return time.Date(c.Year+1, time.April, 1, 0, 0, 0, 0, time.Local)
+	}
+	return time.Date(c.Year, time.October, 1, 0, 0, 0, 0, time.Local)
+}
+
+// RetentionDueAt returns when the retention policy of the course applies to the stream. ok is false if the policy
+// keeps the stream forever.
+func (c Course) RetentionDueAt(stream Stream) (due time.Time, ok bool) {
+	switch c.RetentionPolicy {
+	case RetentionDeleteRaw:
+		return stream.End.AddDate(0, 0, int(c.RetentionPeriod)), true
+	case RetentionArchive, RetentionDelete:
+		return c.semesterEnd().AddDate(0, 6*int(c.RetentionPeriod), 0), true
+	default:
+		return time.Time{}, false
+	}
+}
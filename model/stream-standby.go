@@ -0,0 +1,30 @@
+package model
+
+import (
+	"database/sql"
+	"gorm.io/gorm"
+)
+
+// StreamStandby is a worker that records a source of a stream redundantly to its primary worker.
+// It takes over the ingest if the primary worker stops sending heartbeats.
+type StreamStandby struct {
+	gorm.Model
+
+	StreamID        uint         `gorm:"not null"`
+	SourceType      string       `gorm:"not null"` // CAM, PRES or COMB
+	PrimaryWorkerID string       `gorm:"not null"`
+	StandbyWorkerID string       `gorm:"not null"`
+	TakenOverAt     sql.NullTime // time the standby took over (null if the primary worker didn't fail)
+
+	// the partial recording of the failed primary worker is stitched with the recording of the standby once the
+	// standby published its vod
+	PrimaryRecording string // transcoded recording of the failed primary worker, empty if it didn't report one
+	PrimaryDuration  uint32 // duration of PrimaryRecording in seconds
+	StandbyUploaded  bool   `gorm:"not null;default:false"` // whether the standby published its vod
+	Stitched         bool   `gorm:"not null;default:false"` // whether the recordings were requested to be stitched
+}
+
+// TookOver returns true if the standby took over the ingest from the primary worker.
+func (s StreamStandby) TookOver() bool {
+	return s.TakenOverAt.Valid
+}
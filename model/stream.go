@@ -30,8 +30,14 @@ type Stream struct {
 	PlaylistUrl           string
 	PlaylistUrlPRES       string
 	PlaylistUrlCAM        string
+	PlaylistUrlPIP        string // PlaylistUrlPIP is the composite of the presentation with the camera as inset
+	DashUrl               string // DashUrl is the MPEG-DASH manifest of the vod if one was packaged, same for PRES and CAM
+	DashUrlPRES           string
+	DashUrlCAM            string
+	DashUrlPIP            string
 	LiveNow               bool      `gorm:"not null"`
 	LiveNowTimestamp      time.Time `gorm:"default:null;column:live_now_timestamp"`
+	LiveSource            string    // source shown to viewers of the default version while live, e.g. CAM while the presentation is black
 	Recording             bool
 	Premiere              bool `gorm:"default:null"`
 	Ended                 bool `gorm:"default:null"`
@@ -47,6 +53,9 @@ type Stream struct {
 	ThumbInterval         uint32 `gorm:"default:null"`
 	StreamName            string
 	Duration              uint32           `gorm:"default:null"`
+	Loudness              *float64         `gorm:"default:null"` // integrated loudness of the recording before normalization in LUFS, measured after noise reduction if enabled
+	LoudnessRange         *float64         `gorm:"default:null"` // loudness range of the recording in LU
+	TruePeak              *float64         `gorm:"default:null"` // maximum true peak of the recording in dBTP
 	StreamWorkers         []Worker         `gorm:"many2many:stream_workers;"`
 	StreamProgresses      []StreamProgress `gorm:"foreignKey:StreamID"`
 	VideoSections         []VideoSection
@@ -82,6 +91,12 @@ func (s Stream) GetVodFiles() []DownloadableVod {
 			DownloadURL:  s.PlaylistUrlPRES + "&download=1",
 		})
 	}
+	if s.PlaylistUrlPIP != "" {
+		dFiles = append(dFiles, DownloadableVod{
+			FriendlyName: "Presentation with camera",
+			DownloadURL:  s.PlaylistUrlPIP + "&download=1",
+		})
+	}
 	return dFiles
 }
 
@@ -151,6 +166,34 @@ func (s Stream) GetName() string {
 	return fmt.Sprintf("Lecture: %s", s.Start.Format("Jan 2, 2006"))
 }
 
+// GetPlaylistUrl returns the HLS playlist of the given version (PRES, CAM, PIP or COMB) or "" if there is none.
+func (s Stream) GetPlaylistUrl(version string) string {
+	switch version {
+	case "PIP":
+		return s.PlaylistUrlPIP
+	case "PRES":
+		return s.PlaylistUrlPRES
+	case "CAM":
+		return s.PlaylistUrlCAM
+	default:
+		return s.PlaylistUrl
+	}
+}
+
+// GetDashUrl returns the DASH manifest of the given version (PRES, CAM, PIP or COMB) or "" if there is none.
+func (s Stream) GetDashUrl(version string) string {
+	switch version {
+	case "PIP":
+		return s.DashUrlPIP
+	case "PRES":
+		return s.DashUrlPRES
+	case "CAM":
+		return s.DashUrlCAM
+	default:
+		return s.DashUrl
+	}
+}
+
 func (s Stream) IsConverting() bool {
 	return len(s.TranscodingProgresses) > 0
 }
@@ -317,6 +360,8 @@ func (s Stream) getJson(lhs []LectureHall, course Course) gin.H {
 		"courseSlug":            course.Slug,
 		"private":               s.Private,
 		"downloadableVods":      s.GetVodFiles(),
+		"loudness":              s.Loudness,
+		"truePeak":              s.TruePeak,
 	}
 }
 
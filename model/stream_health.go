@@ -0,0 +1,37 @@
+package model
+
+import (
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// StreamHealth is a report of the metrics of a source of a live stream sent by the worker streaming it.
+type StreamHealth struct {
+	gorm.Model
+
+	StreamID         uint    `gorm:"not null;index"`
+	SourceType       string  `gorm:"not null"` // COMB, PRES or CAM
+	WorkerID         string  `gorm:"not null"`
+	Bitrate          float64 // output bitrate in kbit/s
+	FPS              float64
+	Speed            float64 // processing speed relative to realtime
+	Frames           uint64  // frames processed since the last restart of ffmpeg
+	DroppedFrames    uint64  // since the last restart of ffmpeg
+	DuplicatedFrames uint64  // since the last restart of ffmpeg
+	Restarts         uint32  // times ffmpeg was restarted after an error
+}
+
+// Json returns the report as it is shown in the health panel of the watch page
+func (h StreamHealth) Json() gin.H {
+	return gin.H{
+		"sourceType":       h.SourceType,
+		"bitrate":          h.Bitrate,
+		"fps":              h.FPS,
+		"speed":            h.Speed,
+		"frames":           h.Frames,
+		"droppedFrames":    h.DroppedFrames,
+		"duplicatedFrames": h.DuplicatedFrames,
+		"restarts":         h.Restarts,
+		"reportedAt":       h.CreatedAt,
+	}
+}
@@ -5,7 +5,10 @@ import (
 	"gorm.io/gorm"
 )
 
-const TokenScopeAdmin = "admin"
+const (
+	TokenScopeAdmin   = "admin"
+	TokenScopePodcast = "podcast" // private podcast feeds of the user
+)
 
 // Token can be used to authenticate instead of a user account
 type Token struct {
@@ -14,6 +17,6 @@ type Token struct {
 	User    User         `gorm:"foreignKey:user_id;not null;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"` // creator of the token
 	Token   string       `json:"token" gorm:"not null"`                                                     // secret token
 	Expires sql.NullTime `json:"expires"`                                                                   // expiration date (null if none)
-	Scope   string       `json:"scope" gorm:"not null"`                                                     // scope of the token, currently only admin
+	Scope   string       `json:"scope" gorm:"not null"`                                                     // scope of the token, admin or podcast
 	LastUse sql.NullTime `json:"last_use"`                                                                  // last time the token was used
 }
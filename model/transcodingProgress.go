@@ -6,6 +6,7 @@ const (
 	COMB StreamVersion = "COMB"
 	CAM  StreamVersion = "CAM"
 	PRES StreamVersion = "PRES"
+	PIP  StreamVersion = "PIP" // composite of PRES with CAM as inset
 )
 
 // TranscodingProgress is the progress as a percentage of the conversion of a single stream view (e.g. stream 123, COMB view)
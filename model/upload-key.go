@@ -4,9 +4,23 @@ import "gorm.io/gorm"
 
 // UploadKey represents a key that is created when a user uploads a file,
 // sent to the worker with the upload request and back to TUM-Live to authenticate the request.
+// The key identifies a resumable upload session on the worker until the upload is complete.
 type UploadKey struct {
 	gorm.Model
 	UploadKey string `gorm:"not null"`
 	Stream    Stream
 	StreamID  uint
+
+	WorkerID string // worker that receives the upload
+	Filename string
+	Size     int64 // size of the uploaded file in bytes
+	Offset   int64 // bytes received by the worker so far
+}
+
+// Progress returns the percentage of the file that has been uploaded.
+func (k UploadKey) Progress() int {
+	if k.Size == 0 {
+		return 0
+	}
+	return int(k.Offset * 100 / k.Size)
 }
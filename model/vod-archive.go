@@ -0,0 +1,36 @@
+package model
+
+import "gorm.io/gorm"
+
+// VodArchiveState is the progress of the archival of a VoD
+type VodArchiveState uint
+
+const (
+	VodArchivePending VodArchiveState = iota + 1 // a worker is re-encoding the VoD
+	VodArchiveDone                               // the VoD was archived
+	VodArchiveFailed                             // the VoD couldn't be archived or the archive didn't save space
+)
+
+// VodArchive is the re-encoding of a VoD file to an efficient codec for long term storage
+type VodArchive struct {
+	gorm.Model
+
+	FileID       uint            `gorm:"not null;uniqueIndex"`
+	StreamID     uint            `gorm:"not null"`
+	Codec        string          `gorm:"not null"` // av1 or hevc
+	WorkerID     string          `gorm:"not null"`
+	State        VodArchiveState `gorm:"not null;default:1"`
+	ArchivePath  string          // re-encoded file
+	OriginalSize int64           // bytes of the H.264 original
+	ArchiveSize  int64           // bytes of the re-encoded file
+	FallbackSize int64           // bytes of the H.264 fallback, 0 if the original was removed
+	Error        string
+}
+
+// Savings returns the bytes saved by the archival
+func (a VodArchive) Savings() int64 {
+	if a.State != VodArchiveDone {
+		return 0
+	}
+	return a.OriginalSize - a.ArchiveSize - a.FallbackSize
+}
@@ -0,0 +1,64 @@
+package model
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// WorkerHeartbeat is a snapshot of a workers vm stats, stored with every heartbeat to keep track of trends.
+type WorkerHeartbeat struct {
+	ID        uint      `gorm:"primaryKey" json:"-"`
+	WorkerID  string    `gorm:"not null;index:idx_worker_heartbeat,priority:1" json:"-"`
+	CreatedAt time.Time `gorm:"index:idx_worker_heartbeat,priority:2" json:"time"`
+
+	Workload  uint    `json:"workload"`
+	CPU       float64 `json:"cpu"`       // cpu usage in percent
+	Memory    float64 `json:"memory"`    // used memory in percent
+	Disk      float64 `json:"disk"`      // used disk space in percent
+	DiskUsed  uint64  `json:"diskUsed"`  // used disk space in GB
+	DiskTotal uint64  `json:"diskTotal"` // total disk space in GB
+	Uptime    int64   `json:"uptime"`    // uptime in seconds
+}
+
+var (
+	heartbeatPercentRe = regexp.MustCompile(`(\d+(?:\.\d+)?)%`)
+	heartbeatDiskRe    = regexp.MustCompile(`^(\d+)G/(\d+)G`)
+)
+
+// NewWorkerHeartbeat parses the human-readable vm stats a worker reported, e.g. "42%" for the cpu,
+// "28.690M/33.638M (15%)" for the memory and "287G/974G (31%)" for the disk.
+// Values that can't be parsed are left at zero.
+func NewWorkerHeartbeat(w Worker) WorkerHeartbeat {
+	h := WorkerHeartbeat{
+		WorkerID: w.WorkerID,
+		Workload: w.Workload,
+		CPU:      parseHeartbeatPercent(w.CPU),
+		Memory:   parseHeartbeatPercent(w.Memory),
+		Disk:     parseHeartbeatPercent(w.Disk),
+	}
+	if m := heartbeatDiskRe.FindStringSubmatch(w.Disk); m != nil {
+		h.DiskUsed, _ = strconv.ParseUint(m[1], 10, 64)
+		h.DiskTotal, _ = strconv.ParseUint(m[2], 10, 64)
+	}
+	// uptime is a truncated time.Duration string, e.g. "1h5m" (trailing "0s" removed) or "" for less than a minute
+	if uptime := strings.TrimSpace(w.Uptime); uptime != "" {
+		if !strings.HasSuffix(uptime, "s") {
+			uptime += "0s"
+		}
+		if d, err := time.ParseDuration(uptime); err == nil {
+			h.Uptime = int64(d.Seconds())
+		}
+	}
+	return h
+}
+
+func parseHeartbeatPercent(s string) float64 {
+	m := heartbeatPercentRe.FindStringSubmatch(s)
+	if m == nil {
+		return 0
+	}
+	p, _ := strconv.ParseFloat(m[1], 64)
+	return p
+}
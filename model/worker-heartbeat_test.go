@@ -0,0 +1,28 @@
+package model
+
+import "testing"
+
+func TestNewWorkerHeartbeat(t *testing.T) {
+	testCases := []struct {
+		worker   Worker
+		expected WorkerHeartbeat
+	}{
+		{
+			Worker{WorkerID: "w1", Workload: 3, CPU: "42%", Memory: "28.690M/33.638M (15%)", Disk: "287G/974G (31%)", Uptime: "1h5m"},
+			WorkerHeartbeat{WorkerID: "w1", Workload: 3, CPU: 42, Memory: 15, Disk: 31, DiskUsed: 287, DiskTotal: 974, Uptime: 3900},
+		},
+		{
+			Worker{WorkerID: "w2", CPU: "7%", Memory: "unknown", Disk: "0G/0G (NaN%)", Uptime: "2m"},
+			WorkerHeartbeat{WorkerID: "w2", CPU: 7, Uptime: 120},
+		},
+		{
+			Worker{WorkerID: "w3"},
+			WorkerHeartbeat{WorkerID: "w3"},
+		},
+	}
+	for _, testCase := range testCases {
+		if got := NewWorkerHeartbeat(testCase.worker); got != testCase.expected {
+			t.Errorf("NewWorkerHeartbeat(%+v) = %+v, want %+v", testCase.worker, got, testCase.expected)
+		}
+	}
+}
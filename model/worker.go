@@ -16,8 +16,18 @@ type Worker struct {
 	Uptime string
 
 	Version string
+
+	// Draining workers finish their current jobs but don't get new ones.
+	Draining    bool
+	DrainReason string
+	InFlight    uint // Number of jobs the worker reported as running in its last heartbeat
 }
 
 func (w *Worker) IsAlive() bool {
 	return w.LastSeen.After(time.Now().Add(time.Minute * -6))
 }
+
+// IsDrained returns true if the worker is draining and has finished all of its jobs, so it can be shut down safely.
+func (w *Worker) IsDrained() bool {
+	return w.Draining && w.InFlight == 0
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/joschahenningsen/TUM-Live/model"
 	"github.com/microcosm-cc/bluemonday"
 	log "github.com/sirupsen/logrus"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -168,3 +169,100 @@ func hasPrio(streamID uint, statsDao dao.StatisticsDao) bool {
 	// Returns whether at least one percent of the viewers have reported an issue within the last 10 minutes
 	return percentOfViewersWithIssue >= 1
 }
+
+// WorkerAlertMessage contains all information about a worker that crossed a health threshold.
+type WorkerAlertMessage struct {
+	Worker    model.Worker
+	Heartbeat model.WorkerHeartbeat
+	Reason    string
+}
+
+// SendWorkerAlert sends an alert about an unhealthy worker to the alert room.
+func (b *Bot) SendWorkerAlert(alert WorkerAlertMessage) error {
+	return b.SendMessage(Message{
+		Text: getFormattedMessageText(GenerateWorkerAlertText(alert)),
+		Prio: true,
+	})
+}
+
+// GenerateWorkerAlertText generates a formatted text for a worker alert.
+func GenerateWorkerAlertText(alert WorkerAlertMessage) string {
+	infoText := "🖥️ <b>Worker unhealthy</b>\n\n" +
+		"<table><tr><th>Worker</th><td>" + alert.Worker.Host + " (" + alert.Worker.WorkerID + ")</td></tr>" +
+		"<tr><th>Reason</th><td>" + alert.Reason + "</td></tr>" +
+		"<tr><th>CPU</th><td>" + alert.Worker.CPU + "</td></tr>" +
+		"<tr><th>Memory</th><td>" + alert.Worker.Memory + "</td></tr>" +
+		"<tr><th>Disk</th><td>" + alert.Worker.Disk + "</td></tr>" +
+		"<tr><th>Workload</th><td>" + strconv.Itoa(int(alert.Worker.Workload)) + "</td></tr>"
+	if alert.Worker.Draining {
+		infoText += "<tr><th>Draining</th><td>" + alert.Worker.DrainReason + "</td></tr>"
+	}
+	infoText += "</table>"
+	return infoText
+}
+
+// LectureHallAlertMessage contains the sources of a lecture hall that were not reachable before a stream.
+type LectureHallAlertMessage struct {
+	LectureHall model.LectureHall
+	Stream      model.Stream
+	CourseName  string
+	Unreachable []model.LectureHallHealth
+}
+
+// SendLectureHallAlert sends an alert about unreachable lecture hall sources to the alert room.
+func (b *Bot) SendLectureHallAlert(alert LectureHallAlertMessage) error {
+	return b.SendMessage(Message{
+		Text: getFormattedMessageText(GenerateLectureHallAlertText(alert)),
+		Prio: true,
+	})
+}
+
+// GenerateLectureHallAlertText generates a formatted text for a lecture hall alert.
+func GenerateLectureHallAlertText(alert LectureHallAlertMessage) string {
+	infoText := "🏫 <b>Lecture hall source unreachable</b>\n\n" +
+		"<table><tr><th>Lecture hall</th><td>" + alert.LectureHall.Name + "</td></tr>" +
+		"<tr><th>Course name</th><td>" + alert.CourseName + "</td></tr>" +
+		"<tr><th>Stream</th><td>" + alert.Stream.Name + " (" + strconv.Itoa(int(alert.Stream.ID)) + ")</td></tr>" +
+		"<tr><th>Start</th><td>" + alert.Stream.Start.Format("02.01.2006 15:04") + "</td></tr>"
+	for _, h := range alert.Unreachable {
+		infoText += "<tr><th>" + h.SourceType + "</th><td>" + h.Source + ": " + h.Error + "</td></tr>"
+	}
+	infoText += "</table>"
+	return infoText
+}
+
+// StreamHealthAlertMessage contains the problems a worker's metrics of a live stream indicate.
+type StreamHealthAlertMessage struct {
+	Stream      model.Stream
+	CourseName  string
+	LectureHall string
+	StreamUrl   string
+	Health      model.StreamHealth
+	Problems    []string
+}
+
+// SendStreamHealthAlert sends an automatically opened issue about a live stream to the alert room.
+func (b *Bot) SendStreamHealthAlert(alert StreamHealthAlertMessage) error {
+	return b.SendMessage(Message{
+		Text: getFormattedMessageText(GenerateStreamHealthAlertText(alert)),
+		Prio: true,
+	})
+}
+
+// GenerateStreamHealthAlertText generates a formatted text for an automatically opened issue.
+func GenerateStreamHealthAlertText(alert StreamHealthAlertMessage) string {
+	infoText := "📉 <b>Stream unhealthy</b>\n\n" +
+		"<table><tr><th>Problems</th><td>" + strings.Join(alert.Problems, " · ") + "</td></tr>" +
+		"<tr><th>Course name</th><td>" + alert.CourseName + "</td></tr>" +
+		"<tr><th>Stream URL</th><td>" + alert.StreamUrl + "</td></tr>" +
+		"<tr><th>Source</th><td>" + alert.Health.SourceType + "</td></tr>"
+	if alert.LectureHall != "" {
+		infoText += "<tr><th>Lecture hall</th><td>" + alert.LectureHall + "</td></tr>"
+	}
+	infoText += "<tr><th>Worker</th><td>" + alert.Health.WorkerID + "</td></tr>" +
+		"<tr><th>Bitrate</th><td>" + strconv.FormatFloat(alert.Health.Bitrate, 'f', 0, 64) + " kbit/s</td></tr>" +
+		"<tr><th>Speed</th><td>" + strconv.FormatFloat(alert.Health.Speed, 'f', 2, 64) + "x</td></tr>" +
+		"<tr><th>Restarts</th><td>" + strconv.Itoa(int(alert.Health.Restarts)) + "</td></tr>" +
+		"</table>"
+	return infoText
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/joschahenningsen/TUM-Live/model"
 	uuid "github.com/satori/go.uuid"
+	"net/url"
 	"strconv"
 	"strings"
 )
@@ -17,6 +18,12 @@ type AxisCam struct {
 
 const axisBaseURL = "http://%s"
 
+const (
+	axisPanRange  = 360.0  // degrees
+	axisTiltRange = 180.0  // degrees
+	axisZoomRange = 9999.0 // zoom steps
+)
+
 //NewAxisCam Acts as a constructor for cameras.
 //ip: the ip address of the camera
 //auth: username and password of the camera (e.g. "user:password")
@@ -44,6 +51,29 @@ func (c AxisCam) SetPreset(presetId int) error {
 	return nil
 }
 
+//Move moves the camera relative to its current position
+func (c AxisCam) Move(pan, tilt, zoom float64) error {
+	params := url.Values{"camera": {"1"}}
+	if pan != 0 {
+		params.Set("rpan", strconv.FormatFloat(pan*axisPanRange, 'f', 2, 64))
+	}
+	if tilt != 0 {
+		params.Set("rtilt", strconv.FormatFloat(tilt*axisTiltRange, 'f', 2, 64))
+	}
+	if zoom != 0 {
+		params.Set("rzoom", strconv.Itoa(int(zoom*axisZoomRange)))
+	}
+	_, err := makeAuthenticatedRequest(&c.Auth, "GET", "", fmt.Sprintf("%s/axis-cgi/com/ptz.cgi?%s", fmt.Sprintf(axisBaseURL, c.Ip), params.Encode()))
+	return err
+}
+
+//SavePreset stores the current position as the server preset presetId.
+//The camera names the preset itself, name is only kept by TUM-Live.
+func (c AxisCam) SavePreset(presetId int, _ string) error {
+	_, err := makeAuthenticatedRequest(&c.Auth, "GET", "", fmt.Sprintf("%s/axis-cgi/com/ptz.cgi?setserverpresetno=%d&camera=1", fmt.Sprintf(axisBaseURL, c.Ip), presetId))
+	return err
+}
+
 //GetPresets fetches all presets stored on the camera
 func (c AxisCam) GetPresets() ([]model.CameraPreset, error) {
 	var presetsForLectureHall []model.CameraPreset
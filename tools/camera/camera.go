@@ -20,6 +20,11 @@ type Cam interface {
 	TakeSnapshot(outDir string) (filename string, err error)
 	// GetPresets fetches all available presets
 	GetPresets() ([]model.CameraPreset, error)
+	// Move moves the camera relative to its current position. pan, tilt and zoom are fractions of the camera's range
+	// between -1 and 1, positive values move right, up and zoom in.
+	Move(pan, tilt, zoom float64) error
+	// SavePreset stores the current position of the camera as the preset identified by presetId.
+	SavePreset(presetId int, name string) error
 }
 
 //makeAuthenticatedRequest Sends a request to the camera.
@@ -80,3 +85,14 @@ func saveResponseBuffer(outDir string, filename string, resp *bytes.Buffer) erro
 	}
 	return nil
 }
+
+// clamp limits v to [min, max]
+func clamp(v, min, max int) int {
+	if v < min {
+		return min
+	}
+	if v > max {
+		return max
+	}
+	return v
+}
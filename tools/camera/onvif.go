@@ -0,0 +1,236 @@
+package camera
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/model"
+	uuid "github.com/satori/go.uuid"
+	log "github.com/sirupsen/logrus"
+)
+
+/**
+*
+* Compatible cameras:
+* - cameras implementing the ONVIF Profile S PTZ and media services
+*
+**/
+
+const (
+	onvifDeviceService = "http://%s/onvif/device_service"
+	onvifTimeout       = time.Second * 10
+)
+
+var errNoOnvifProfile = errors.New("camera has no media profile")
+
+// OnvifCam represents cameras controlled with ONVIF PTZ
+type OnvifCam struct {
+	Ip   string
+	Auth *string // username and password of the camera (e.g. "user:password"), nil if the camera has no auth
+
+	client *http.Client
+
+	mutex        sync.Mutex
+	ptzXAddr     string // url of the ptz service, discovered on first use
+	mediaXAddr   string // url of the media service, discovered on first use
+	profileToken string // token of the media profile used for ptz
+}
+
+// NewOnvifCam Acts as a constructor for cameras.
+// ip: the ip address of the camera
+// auth: username and password of the camera (e.g. "user:password")
+func NewOnvifCam(ip string, auth *string) Cam {
+	return &OnvifCam{Ip: ip, Auth: auth, client: &http.Client{Timeout: onvifTimeout}}
+}
+
+// SetPreset moves the camera to the preset with the token presetId
+func (c *OnvifCam) SetPreset(presetId int) error {
+	if err := c.discover(); err != nil {
+		return err
+	}
+	body := fmt.Sprintf(`<tptz:GotoPreset><tptz:ProfileToken>%s</tptz:ProfileToken><tptz:PresetToken>%d</tptz:PresetToken></tptz:GotoPreset>`,
+		xmlEscape(c.profileToken), presetId)
+	return c.call(c.ptzXAddr, body, nil)
+}
+
+// Move sends a RelativeMove in ONVIF's generic translation space which ranges from -1 to 1 like pan, tilt and zoom
+func (c *OnvifCam) Move(pan, tilt, zoom float64) error {
+	if err := c.discover(); err != nil {
+		return err
+	}
+	body := fmt.Sprintf(`<tptz:RelativeMove><tptz:ProfileToken>%s</tptz:ProfileToken><tptz:Translation>`+
+		`<tt:PanTilt x="%s" y="%s"/><tt:Zoom x="%s"/></tptz:Translation></tptz:RelativeMove>`,
+		xmlEscape(c.profileToken), onvifFloat(pan), onvifFloat(tilt), onvifFloat(zoom))
+	return c.call(c.ptzXAddr, body, nil)
+}
+
+// SavePreset stores the current position as the preset with the token presetId
+func (c *OnvifCam) SavePreset(presetId int, name string) error {
+	if err := c.discover(); err != nil {
+		return err
+	}
+	body := fmt.Sprintf(`<tptz:SetPreset><tptz:ProfileToken>%s</tptz:ProfileToken><tptz:PresetName>%s</tptz:PresetName>`+
+		`<tptz:PresetToken>%d</tptz:PresetToken></tptz:SetPreset>`, xmlEscape(c.profileToken), xmlEscape(name), presetId)
+	return c.call(c.ptzXAddr, body, nil)
+}
+
+// GetPresets fetches all presets stored on the camera. Presets with non-numeric tokens are skipped as they can't be
+// referenced by model.CameraPreset.
+func (c *OnvifCam) GetPresets() ([]model.CameraPreset, error) {
+	if err := c.discover(); err != nil {
+		return nil, err
+	}
+	var resp struct {
+		Presets []struct {
+			Token string `xml:"token,attr"`
+			Name  string `xml:"Name"`
+		} `xml:"Body>GetPresetsResponse>Preset"`
+	}
+	body := fmt.Sprintf(`<tptz:GetPresets><tptz:ProfileToken>%s</tptz:ProfileToken></tptz:GetPresets>`, xmlEscape(c.profileToken))
+	if err := c.call(c.ptzXAddr, body, &resp); err != nil {
+		return nil, err
+	}
+	var presets []model.CameraPreset
+	for _, p := range resp.Presets {
+		id, err := strconv.Atoi(p.Token)
+		if err != nil {
+			log.WithField("token", p.Token).Warn("Skipping onvif preset with non-numeric token")
+			continue
+		}
+		presets = append(presets, model.CameraPreset{Name: p.Name, PresetID: id})
+	}
+	return presets, nil
+}
+
+// TakeSnapshot fetches the snapshot of the camera's media profile
+func (c *OnvifCam) TakeSnapshot(outDir string) (filename string, err error) {
+	if err = c.discover(); err != nil {
+		return "", err
+	}
+	var resp struct {
+		Uri string `xml:"Body>GetSnapshotUriResponse>MediaUri>Uri"`
+	}
+	body := fmt.Sprintf(`<trt:GetSnapshotUri><trt:ProfileToken>%s</trt:ProfileToken></trt:GetSnapshotUri>`, xmlEscape(c.profileToken))
+	if err = c.call(c.mediaXAddr, body, &resp); err != nil {
+		return "", err
+	}
+	if resp.Uri == "" {
+		return "", errors.New("camera returned no snapshot uri")
+	}
+	image, err := makeAuthenticatedRequest(c.Auth, "GET", "", resp.Uri)
+	if err != nil {
+		return "", err
+	}
+	filename = uuid.NewV4().String() + ".jpg"
+	return filename, saveResponseBuffer(outDir, filename, image)
+}
+
+// discover looks up the ptz and media services and the media profile of the camera
+func (c *OnvifCam) discover() error {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if c.profileToken != "" {
+		return nil
+	}
+	var capabilities struct {
+		Media string `xml:"Body>GetCapabilitiesResponse>Capabilities>Media>XAddr"`
+		PTZ   string `xml:"Body>GetCapabilitiesResponse>Capabilities>PTZ>XAddr"`
+	}
+	err := c.call(fmt.Sprintf(onvifDeviceService, c.Ip), `<tds:GetCapabilities><tds:Category>All</tds:Category></tds:GetCapabilities>`, &capabilities)
+	if err != nil {
+		return fmt.Errorf("get onvif capabilities: %w", err)
+	}
+	if capabilities.PTZ == "" || capabilities.Media == "" {
+		return errors.New("camera doesn't support onvif ptz")
+	}
+	var profiles struct {
+		Profiles []struct {
+			Token string `xml:"token,attr"`
+		} `xml:"Body>GetProfilesResponse>Profiles"`
+	}
+	if err = c.call(capabilities.Media, `<trt:GetProfiles/>`, &profiles); err != nil {
+		return fmt.Errorf("get onvif profiles: %w", err)
+	}
+	if len(profiles.Profiles) == 0 {
+		return errNoOnvifProfile
+	}
+	c.ptzXAddr, c.mediaXAddr, c.profileToken = capabilities.PTZ, capabilities.Media, profiles.Profiles[0].Token
+	return nil
+}
+
+// call sends a SOAP request with body to the service at url and decodes the response into resp if it isn't nil
+func (c *OnvifCam) call(url string, body string, resp interface{}) error {
+	envelope := `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"` +
+		` xmlns:trt="http://www.onvif.org/ver10/media/wsdl" xmlns:tptz="http://www.onvif.org/ver20/ptz/wsdl"` +
+		` xmlns:tt="http://www.onvif.org/ver10/schema">` +
+		c.securityHeader() + `<s:Body>` + body + `</s:Body></s:Envelope>`
+	res, err := c.client.Post(url, `application/soap+xml; charset=utf-8`, strings.NewReader(envelope))
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	content, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+	if res.StatusCode != http.StatusOK {
+		var fault struct {
+			Reason string `xml:"Body>Fault>Reason>Text"`
+		}
+		_ = xml.Unmarshal(content, &fault)
+		return fmt.Errorf("onvif request failed with status %d: %s", res.StatusCode, fault.Reason)
+	}
+	if resp == nil {
+		return nil
+	}
+	return xml.Unmarshal(content, resp)
+}
+
+// securityHeader returns the WS-Security UsernameToken header of the camera's credentials
+func (c *OnvifCam) securityHeader() string {
+	if c.Auth == nil {
+		return ""
+	}
+	user, password, _ := strings.Cut(*c.Auth, ":")
+	nonce := make([]byte, 16)
+	_, _ = rand.Read(nonce)
+	created := time.Now().UTC().Format(time.RFC3339)
+	return fmt.Sprintf(`<s:Header><Security s:mustUnderstand="1" xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-secext-1.0.xsd">`+
+		`<UsernameToken><Username>%s</Username>`+
+		`<Password Type="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-username-token-profile-1.0#PasswordDigest">%s</Password>`+
+		`<Nonce EncodingType="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-soap-message-security-1.0#Base64Binary">%s</Nonce>`+
+		`<Created xmlns="http://docs.oasis-open.org/wss/2004/01/oasis-200401-wss-wssecurity-utility-1.0.xsd">%s</Created>`+
+		`</UsernameToken></Security></s:Header>`,
+		xmlEscape(user), onvifPasswordDigest(nonce, created, password), base64.StdEncoding.EncodeToString(nonce), created)
+}
+
+// onvifPasswordDigest returns Base64(SHA1(nonce + created + password)) as defined by the WS-Security username token profile
+func onvifPasswordDigest(nonce []byte, created string, password string) string {
+	h := sha1.New()
+	h.Write(nonce)
+	h.Write([]byte(created))
+	h.Write([]byte(password))
+	return base64.StdEncoding.EncodeToString(h.Sum(nil))
+}
+
+func onvifFloat(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func xmlEscape(s string) string {
+	var b bytes.Buffer
+	_ = xml.EscapeText(&b, []byte(s))
+	return b.String()
+}
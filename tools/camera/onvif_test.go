@@ -0,0 +1,162 @@
+package camera
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+// fakeOnvifCam is an ONVIF camera with the profile "profile_1" and the presets 1 ("Desk") and 2 ("Board")
+type fakeOnvifCam struct {
+	*httptest.Server
+	gotoPresets []string // preset tokens of the received GotoPreset requests
+	moves       []string // translations of the received RelativeMove requests as "pan tilt zoom"
+	setPresets  []string // token and name of the received SetPreset requests as "token name"
+}
+
+func newFakeOnvifCam(t *testing.T, user, password string) *fakeOnvifCam {
+	cam := &fakeOnvifCam{}
+	cam.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/snapshot.jpg" {
+			_, _ = w.Write([]byte("jpeg"))
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		if !validOnvifAuth(string(body), user, password) {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = fmt.Fprint(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope"><s:Body><s:Fault><s:Reason><s:Text>not authorized</s:Text></s:Reason></s:Fault></s:Body></s:Envelope>`)
+			return
+		}
+		var resp string
+		switch {
+		case r.URL.Path == "/onvif/device_service" && strings.Contains(string(body), "GetCapabilities"):
+			resp = fmt.Sprintf(`<tds:GetCapabilitiesResponse><tds:Capabilities><tt:Media><tt:XAddr>%[1]s/onvif/media</tt:XAddr></tt:Media>`+
+				`<tt:PTZ><tt:XAddr>%[1]s/onvif/ptz</tt:XAddr></tt:PTZ></tds:Capabilities></tds:GetCapabilitiesResponse>`, cam.URL)
+		case r.URL.Path == "/onvif/media" && strings.Contains(string(body), "GetProfiles"):
+			resp = `<trt:GetProfilesResponse><trt:Profiles token="profile_1"><tt:Name>main</tt:Name></trt:Profiles></trt:GetProfilesResponse>`
+		case r.URL.Path == "/onvif/media" && strings.Contains(string(body), "GetSnapshotUri"):
+			resp = fmt.Sprintf(`<trt:GetSnapshotUriResponse><trt:MediaUri><tt:Uri>%s/snapshot.jpg</tt:Uri></trt:MediaUri></trt:GetSnapshotUriResponse>`, cam.URL)
+		case r.URL.Path == "/onvif/ptz" && strings.Contains(string(body), "GetPresets"):
+			resp = `<tptz:GetPresetsResponse><tptz:Preset token="1"><tt:Name>Desk</tt:Name></tptz:Preset>` +
+				`<tptz:Preset token="2"><tt:Name>Board</tt:Name></tptz:Preset><tptz:Preset token="home"><tt:Name>Home</tt:Name></tptz:Preset></tptz:GetPresetsResponse>`
+		case r.URL.Path == "/onvif/ptz" && strings.Contains(string(body), "GotoPreset"):
+			var req struct {
+				Profile string `xml:"Body>GotoPreset>ProfileToken"`
+				Preset  string `xml:"Body>GotoPreset>PresetToken"`
+			}
+			if err := xml.Unmarshal(body, &req); err != nil || req.Profile != "profile_1" {
+				t.Errorf("invalid GotoPreset request: %s", body)
+			}
+			cam.gotoPresets = append(cam.gotoPresets, req.Preset)
+			resp = `<tptz:GotoPresetResponse/>`
+		case r.URL.Path == "/onvif/ptz" && strings.Contains(string(body), "RelativeMove"):
+			var req struct {
+				PanTilt struct {
+					X string `xml:"x,attr"`
+					Y string `xml:"y,attr"`
+				} `xml:"Body>RelativeMove>Translation>PanTilt"`
+				Zoom struct {
+					X string `xml:"x,attr"`
+				} `xml:"Body>RelativeMove>Translation>Zoom"`
+			}
+			if err := xml.Unmarshal(body, &req); err != nil {
+				t.Errorf("invalid RelativeMove request: %s", body)
+			}
+			cam.moves = append(cam.moves, req.PanTilt.X+" "+req.PanTilt.Y+" "+req.Zoom.X)
+			resp = `<tptz:RelativeMoveResponse/>`
+		case r.URL.Path == "/onvif/ptz" && strings.Contains(string(body), "SetPreset"):
+			var req struct {
+				Name  string `xml:"Body>SetPreset>PresetName"`
+				Token string `xml:"Body>SetPreset>PresetToken"`
+			}
+			if err := xml.Unmarshal(body, &req); err != nil {
+				t.Errorf("invalid SetPreset request: %s", body)
+			}
+			cam.setPresets = append(cam.setPresets, req.Token+" "+req.Name)
+			resp = `<tptz:SetPresetResponse><tptz:PresetToken>` + req.Token + `</tptz:PresetToken></tptz:SetPresetResponse>`
+		default:
+			t.Errorf("unexpected onvif request to %s: %s", r.URL.Path, body)
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		_, _ = fmt.Fprintf(w, `<s:Envelope xmlns:s="http://www.w3.org/2003/05/soap-envelope" xmlns:tds="http://www.onvif.org/ver10/device/wsdl"`+
+			` xmlns:trt="http://www.onvif.org/ver10/media/wsdl" xmlns:tptz="http://www.onvif.org/ver20/ptz/wsdl" xmlns:tt="http://www.onvif.org/ver10/schema">`+
+			`<s:Body>%s</s:Body></s:Envelope>`, resp)
+	}))
+	t.Cleanup(cam.Close)
+	return cam
+}
+
+// validOnvifAuth checks the WS-Security UsernameToken of a request
+func validOnvifAuth(body, user, password string) bool {
+	var req struct {
+		Username string `xml:"Header>Security>UsernameToken>Username"`
+		Password string `xml:"Header>Security>UsernameToken>Password"`
+		Nonce    string `xml:"Header>Security>UsernameToken>Nonce"`
+		Created  string `xml:"Header>Security>UsernameToken>Created"`
+	}
+	if err := xml.Unmarshal([]byte(body), &req); err != nil {
+		return false
+	}
+	nonce, err := base64.StdEncoding.DecodeString(req.Nonce)
+	return err == nil && req.Username == user && req.Password == onvifPasswordDigest(nonce, req.Created, password)
+}
+
+func TestOnvif(t *testing.T) {
+	fake := newFakeOnvifCam(t, "admin", "secret")
+	auth := "admin:secret"
+	cam := NewOnvifCam(strings.TrimPrefix(fake.URL, "http://"), &auth)
+
+	presets, err := cam.GetPresets()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(presets) != 2 || presets[0].PresetID != 1 || presets[0].Name != "Desk" || presets[1].PresetID != 2 {
+		t.Errorf("unexpected presets: %+v", presets)
+	}
+
+	if err = cam.SetPreset(2); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.gotoPresets) != 1 || fake.gotoPresets[0] != "2" {
+		t.Errorf("expected GotoPreset 2, got %v", fake.gotoPresets)
+	}
+
+	if err = cam.Move(0.05, -0.1, 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.moves) != 1 || fake.moves[0] != "0.05 -0.1 0" {
+		t.Errorf("expected RelativeMove 0.05 -0.1 0, got %v", fake.moves)
+	}
+
+	if err = cam.SavePreset(3, "Board & Desk"); err != nil {
+		t.Fatal(err)
+	}
+	if len(fake.setPresets) != 1 || fake.setPresets[0] != "3 Board & Desk" {
+		t.Errorf("expected SetPreset 3, got %v", fake.setPresets)
+	}
+
+	dir := t.TempDir()
+	filename, err := cam.TakeSnapshot(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if content, err := os.ReadFile(dir + "/" + filename); err != nil || string(content) != "jpeg" {
+		t.Errorf("snapshot wasn't saved: %v", err)
+	}
+}
+
+func TestOnvifWrongPassword(t *testing.T) {
+	fake := newFakeOnvifCam(t, "admin", "secret")
+	auth := "admin:wrong"
+	err := NewOnvifCam(strings.TrimPrefix(fake.URL, "http://"), &auth).SetPreset(1)
+	if err == nil || !strings.Contains(err.Error(), "not authorized") {
+		t.Errorf("expected authorization error, got %v", err)
+	}
+}
@@ -5,6 +5,9 @@ import (
 	"github.com/joschahenningsen/TUM-Live/model"
 	uuid "github.com/satori/go.uuid"
 	log "github.com/sirupsen/logrus"
+	"net/url"
+	"strconv"
+	"strings"
 )
 
 /**
@@ -18,6 +21,12 @@ import (
 
 const panasonicBaseUrl = "http://%s/cgi-bin"
 
+const (
+	panasonicPanTiltMax = 0xFFFF // pan and tilt positions range from 0x0000 to 0xFFFF
+	panasonicZoomMin    = 0x555  // wide end
+	panasonicZoomMax    = 0xFFF  // tele end
+)
+
 //PanasonicCam represents Panasonic IP cameras the TUM uses
 type PanasonicCam struct {
 	Ip   string
@@ -65,3 +74,66 @@ func (c PanasonicCam) GetPresets() ([]model.CameraPreset, error) {
 	}
 	return presets, nil
 }
+
+//Move moves the camera relative to its current position by reading the absolute position and setting the new one
+func (c PanasonicCam) Move(pan, tilt, zoom float64) error {
+	if pan != 0 || tilt != 0 {
+		resp, err := c.command("#APC", "aPC")
+		if err != nil {
+			return err
+		}
+		if len(resp) != 8 {
+			return fmt.Errorf("unexpected pan/tilt position: %s", resp)
+		}
+		curPan, err := strconv.ParseInt(resp[:4], 16, 0)
+		if err != nil {
+			return err
+		}
+		curTilt, err := strconv.ParseInt(resp[4:], 16, 0)
+		if err != nil {
+			return err
+		}
+		newPan := clamp(int(curPan)+int(pan*panasonicPanTiltMax), 0, panasonicPanTiltMax)
+		newTilt := clamp(int(curTilt)+int(tilt*panasonicPanTiltMax), 0, panasonicPanTiltMax)
+		if _, err = c.command(fmt.Sprintf("#APC%04X%04X", newPan, newTilt), "aPC"); err != nil {
+			return err
+		}
+	}
+	if zoom != 0 {
+		resp, err := c.command("#GZ", "gz")
+		if err != nil {
+			return err
+		}
+		curZoom, err := strconv.ParseInt(resp, 16, 0)
+		if err != nil {
+			return fmt.Errorf("unexpected zoom position: %s", resp)
+		}
+		newZoom := clamp(int(curZoom)+int(zoom*(panasonicZoomMax-panasonicZoomMin)), panasonicZoomMin, panasonicZoomMax)
+		if _, err = c.command(fmt.Sprintf("#AXZ%03X", newZoom), "axz"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//SavePreset stores the current position in the preset memory presetId. The camera doesn't store names.
+func (c PanasonicCam) SavePreset(presetId int, _ string) error {
+	if presetId < 0 || presetId > 99 {
+		return fmt.Errorf("invalid panasonic preset: %d", presetId)
+	}
+	_, err := c.command(fmt.Sprintf("#M%02d", presetId), "s")
+	return err
+}
+
+//command sends a ptz command to the camera and returns the response without the expected prefix
+func (c PanasonicCam) command(cmd string, prefix string) (string, error) {
+	resp, err := makeAuthenticatedRequest(c.Auth, "GET", "", fmt.Sprintf("%s/aw_ptz?cmd=%s&res=1", fmt.Sprintf(panasonicBaseUrl, c.Ip), url.QueryEscape(cmd)))
+	if err != nil {
+		return "", err
+	}
+	body := strings.TrimSpace(resp.String())
+	if !strings.HasPrefix(body, prefix) {
+		return "", fmt.Errorf("unexpected response to %s: %s", cmd, body)
+	}
+	return strings.TrimPrefix(body, prefix), nil
+}
@@ -0,0 +1,189 @@
+package camera
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/model"
+)
+
+/**
+*
+* Compatible cameras:
+* - cameras supporting Sony's VISCA over IP, e.g. Sony SRG series, BRC series and PTZOptics
+*
+**/
+
+const (
+	viscaDefaultPort = "52381"
+	viscaTimeout     = time.Second * 5
+
+	viscaPayloadCommand = 0x0100 // payload type of VISCA commands
+	viscaPayloadControl = 0x0200 // payload type of control commands such as resetting the sequence number
+	viscaPayloadReply   = 0x0111 // payload type of VISCA replies
+	viscaControlReply   = 0x0201 // payload type of control replies
+
+	viscaPanRange  = 0x1320 // pan positions range from about -0x990 to 0x990
+	viscaTiltRange = 0x0600 // tilt positions range from about -0x1B0 to 0x450
+	viscaZoomMax   = 0x4000 // zoom positions range from 0 (wide) to 0x4000 (tele)
+	viscaSpeed     = 0x0C   // pan and tilt speed of relative moves
+)
+
+// ErrUnsupported is returned by cameras that don't support an operation
+var ErrUnsupported = errors.New("operation not supported by camera")
+
+// ViscaCam represents cameras controlled with VISCA over IP
+type ViscaCam struct {
+	Addr string // host:port of the camera, the default VISCA over IP port is used if no port is given
+}
+
+// NewViscaCam Acts as a constructor for cameras.
+// ip: the ip address of the camera, optionally with port (e.g. "10.0.0.4:52381")
+func NewViscaCam(ip string) Cam {
+	addr := ip
+	if _, _, err := net.SplitHostPort(ip); err != nil {
+		addr = net.JoinHostPort(ip, viscaDefaultPort)
+	}
+	return &ViscaCam{Addr: addr}
+}
+
+// SetPreset recalls the memory preset presetId of the camera
+func (c *ViscaCam) SetPreset(presetId int) error {
+	if presetId < 0 || presetId > 0xFF {
+		return fmt.Errorf("invalid visca preset: %d", presetId)
+	}
+	_, err := c.command([]byte{0x81, 0x01, 0x04, 0x3F, 0x02, byte(presetId), 0xFF})
+	return err
+}
+
+// SavePreset stores the current position in the memory preset presetId. VISCA doesn't store names.
+func (c *ViscaCam) SavePreset(presetId int, _ string) error {
+	if presetId < 0 || presetId > 0xFF {
+		return fmt.Errorf("invalid visca preset: %d", presetId)
+	}
+	_, err := c.command([]byte{0x81, 0x01, 0x04, 0x3F, 0x01, byte(presetId), 0xFF})
+	return err
+}
+
+// Move moves the camera with a relative pan/tilt command and sets the zoom position relative to the current one
+func (c *ViscaCam) Move(pan, tilt, zoom float64) error {
+	if pan != 0 || tilt != 0 {
+		cmd := []byte{0x81, 0x01, 0x06, 0x03, viscaSpeed, viscaSpeed}
+		cmd = append(cmd, viscaNibbles(uint16(int16(pan*viscaPanRange)))...)
+		cmd = append(cmd, viscaNibbles(uint16(int16(tilt*viscaTiltRange)))...)
+		if _, err := c.command(append(cmd, 0xFF)); err != nil {
+			return err
+		}
+	}
+	if zoom != 0 {
+		reply, err := c.command([]byte{0x81, 0x09, 0x04, 0x47, 0xFF})
+		if err != nil {
+			return err
+		}
+		if len(reply) != 7 {
+			return fmt.Errorf("unexpected visca zoom position: % X", reply)
+		}
+		current := int(reply[2]&0x0F)<<12 | int(reply[3]&0x0F)<<8 | int(reply[4]&0x0F)<<4 | int(reply[5]&0x0F)
+		newZoom := clamp(current+int(zoom*viscaZoomMax), 0, viscaZoomMax)
+		cmd := append([]byte{0x81, 0x01, 0x04, 0x47}, viscaNibbles(uint16(newZoom))...)
+		if _, err = c.command(append(cmd, 0xFF)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TakeSnapshot is not supported by VISCA
+func (c *ViscaCam) TakeSnapshot(string) (string, error) {
+	return "", ErrUnsupported
+}
+
+// GetPresets is not supported by VISCA, the camera can't list its presets. Presets are known from SavePreset only.
+func (c *ViscaCam) GetPresets() ([]model.CameraPreset, error) {
+	return nil, ErrUnsupported
+}
+
+// command sends a VISCA command or inquiry to the camera, waits until the camera completed it and returns the
+// completion message
+func (c *ViscaCam) command(payload []byte) ([]byte, error) {
+	conn, err := net.Dial("udp", c.Addr)
+	if err != nil {
+		return nil, fmt.Errorf("dial visca camera: %w", err)
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(viscaTimeout)); err != nil {
+		return nil, err
+	}
+
+	// the camera only accepts messages with a sequence number higher than the last one, reset it first
+	var sequence uint32
+	if _, err = conn.Write(viscaMessage(viscaPayloadControl, sequence, []byte{0x01})); err != nil {
+		return nil, fmt.Errorf("reset visca sequence number: %w", err)
+	}
+	if _, _, err = readViscaMessage(conn, viscaControlReply); err != nil {
+		return nil, fmt.Errorf("reset visca sequence number: %w", err)
+	}
+
+	sequence++
+	if _, err = conn.Write(viscaMessage(viscaPayloadCommand, sequence, payload)); err != nil {
+		return nil, fmt.Errorf("send visca command: %w", err)
+	}
+	for {
+		_, reply, err := readViscaMessage(conn, viscaPayloadReply)
+		if err != nil {
+			return nil, fmt.Errorf("read visca reply: %w", err)
+		}
+		if len(reply) < 3 {
+			return nil, fmt.Errorf("invalid visca reply: % X", reply)
+		}
+		switch reply[1] & 0xF0 {
+		case 0x40: // ACK, the camera started executing the command
+			continue
+		case 0x50: // completion
+			return reply, nil
+		case 0x60:
+			return nil, fmt.Errorf("visca error: % X", reply)
+		default:
+			return nil, fmt.Errorf("unexpected visca reply: % X", reply)
+		}
+	}
+}
+
+// viscaMessage wraps a payload in the VISCA over IP header
+func viscaMessage(payloadType uint16, sequence uint32, payload []byte) []byte {
+	msg := make([]byte, 8, 8+len(payload))
+	binary.BigEndian.PutUint16(msg[0:2], payloadType)
+	binary.BigEndian.PutUint16(msg[2:4], uint16(len(payload)))
+	binary.BigEndian.PutUint32(msg[4:8], sequence)
+	return append(msg, payload...)
+}
+
+// viscaNibbles splits v into the four nibbles VISCA uses to encode positions
+func viscaNibbles(v uint16) []byte {
+	return []byte{byte(v >> 12 & 0x0F), byte(v >> 8 & 0x0F), byte(v >> 4 & 0x0F), byte(v & 0x0F)}
+}
+
+// readViscaMessage reads the next message of payloadType and returns its sequence number and payload
+func readViscaMessage(conn net.Conn, payloadType uint16) (uint32, []byte, error) {
+	buf := make([]byte, 1024)
+	for {
+		n, err := conn.Read(buf)
+		if err != nil {
+			return 0, nil, err
+		}
+		if n < 8 {
+			return 0, nil, fmt.Errorf("short visca message: % X", buf[:n])
+		}
+		length := int(binary.BigEndian.Uint16(buf[2:4]))
+		if n < 8+length {
+			return 0, nil, fmt.Errorf("truncated visca message: % X", buf[:n])
+		}
+		if binary.BigEndian.Uint16(buf[0:2]) != payloadType {
+			continue
+		}
+		return binary.BigEndian.Uint32(buf[4:8]), buf[8 : 8+length], nil
+	}
+}
@@ -0,0 +1,138 @@
+package camera
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"net"
+	"testing"
+)
+
+// fakeViscaCam is a VISCA over IP camera on localhost that answers every command with reply
+type fakeViscaCam struct {
+	conn     net.PacketConn
+	commands chan []byte // payloads of the received commands
+	reply    [][]byte    // replies sent for each command
+}
+
+func newFakeViscaCam(t *testing.T, reply ...[]byte) *fakeViscaCam {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cam := &fakeViscaCam{conn: conn, commands: make(chan []byte, 10), reply: reply}
+	t.Cleanup(func() { _ = conn.Close() })
+	go cam.serve(t)
+	return cam
+}
+
+func (f *fakeViscaCam) serve(t *testing.T) {
+	buf := make([]byte, 1024)
+	var lastSequence uint32
+	for {
+		n, addr, err := f.conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		if n < 8 || int(binary.BigEndian.Uint16(buf[2:4])) != n-8 {
+			t.Errorf("invalid visca message: % X", buf[:n])
+			return
+		}
+		payloadType, sequence := binary.BigEndian.Uint16(buf[0:2]), binary.BigEndian.Uint32(buf[4:8])
+		payload := append([]byte{}, buf[8:n]...)
+		switch payloadType {
+		case viscaPayloadControl:
+			lastSequence = 0
+			_, _ = f.conn.WriteTo(viscaMessage(viscaControlReply, sequence, []byte{0x01}), addr)
+		case viscaPayloadCommand:
+			if sequence <= lastSequence {
+				t.Errorf("sequence number %d not increased", sequence)
+			}
+			lastSequence = sequence
+			f.commands <- payload
+			for _, r := range f.reply {
+				_, _ = f.conn.WriteTo(viscaMessage(viscaPayloadReply, sequence, r), addr)
+			}
+		default:
+			t.Errorf("unexpected payload type %04X", payloadType)
+		}
+	}
+}
+
+func TestViscaSetPreset(t *testing.T) {
+	fake := newFakeViscaCam(t, []byte{0x90, 0x41, 0xFF}, []byte{0x90, 0x51, 0xFF})
+	cam := NewViscaCam(fake.conn.LocalAddr().String())
+	if err := cam.SetPreset(5); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x81, 0x01, 0x04, 0x3F, 0x02, 0x05, 0xFF}
+	if got := <-fake.commands; !bytes.Equal(got, want) {
+		t.Errorf("SetPreset sent % X, want % X", got, want)
+	}
+	// the sequence number is reset before every command
+	if err := cam.SetPreset(6); err != nil {
+		t.Fatal(err)
+	}
+	<-fake.commands
+}
+
+func TestViscaError(t *testing.T) {
+	fake := newFakeViscaCam(t, []byte{0x90, 0x61, 0x41, 0xFF}) // command not executable
+	if err := NewViscaCam(fake.conn.LocalAddr().String()).SetPreset(1); err == nil {
+		t.Error("expected error for visca error reply")
+	}
+	if err := NewViscaCam(fake.conn.LocalAddr().String()).SetPreset(300); err == nil {
+		t.Error("expected error for invalid preset")
+	}
+}
+
+func TestNewViscaCamDefaultPort(t *testing.T) {
+	if addr := NewViscaCam("10.0.0.4").(*ViscaCam).Addr; addr != "10.0.0.4:52381" {
+		t.Errorf("expected default port, got %s", addr)
+	}
+	if addr := NewViscaCam("10.0.0.4:1259").(*ViscaCam).Addr; addr != "10.0.0.4:1259" {
+		t.Errorf("expected configured port, got %s", addr)
+	}
+}
+
+func TestViscaGetPresets(t *testing.T) {
+	// presets stored in TUM-Live must not be replaced with made up ones
+	if presets, err := NewViscaCam("10.0.0.4").GetPresets(); !errors.Is(err, ErrUnsupported) || presets != nil {
+		t.Errorf("GetPresets() = %v, %v, want ErrUnsupported", presets, err)
+	}
+}
+
+func TestViscaMove(t *testing.T) {
+	fake := newFakeViscaCam(t, []byte{0x90, 0x41, 0xFF}, []byte{0x90, 0x51, 0xFF})
+	if err := NewViscaCam(fake.conn.LocalAddr().String()).Move(0.1, -0.1, 0); err != nil {
+		t.Fatal(err)
+	}
+	want := []byte{0x81, 0x01, 0x06, 0x03, 0x0C, 0x0C, 0x00, 0x01, 0x0E, 0x09, 0x0F, 0x0F, 0x06, 0x07, 0xFF}
+	if got := <-fake.commands; !bytes.Equal(got, want) {
+		t.Errorf("Move sent % X, want % X", got, want)
+	}
+}
+
+func TestViscaZoom(t *testing.T) {
+	// every command completes with the zoom position 0x1000
+	fake := newFakeViscaCam(t, []byte{0x90, 0x50, 0x01, 0x00, 0x00, 0x00, 0xFF})
+	if err := NewViscaCam(fake.conn.LocalAddr().String()).Move(0, 0, 0.25); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := <-fake.commands, []byte{0x81, 0x09, 0x04, 0x47, 0xFF}; !bytes.Equal(got, want) {
+		t.Errorf("expected zoom inquiry % X, got % X", want, got)
+	}
+	if got, want := <-fake.commands, []byte{0x81, 0x01, 0x04, 0x47, 0x02, 0x00, 0x00, 0x00, 0xFF}; !bytes.Equal(got, want) {
+		t.Errorf("expected zoom direct % X, got % X", want, got)
+	}
+}
+
+func TestViscaSavePreset(t *testing.T) {
+	fake := newFakeViscaCam(t, []byte{0x90, 0x41, 0xFF}, []byte{0x90, 0x51, 0xFF})
+	if err := NewViscaCam(fake.conn.LocalAddr().String()).SavePreset(12, "Board"); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := <-fake.commands, []byte{0x81, 0x01, 0x04, 0x3F, 0x01, 0x0C, 0xFF}; !bytes.Equal(got, want) {
+		t.Errorf("SavePreset sent % X, want % X", got, want)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/viper"
 	"os"
+	"strings"
 	"time"
 )
 
@@ -171,8 +172,62 @@ type Config struct {
 		Host   string `yaml:"host"`
 		ApiKey string `yaml:"apiKey"`
 	} `yaml:"meili"`
+	WorkerHealth *struct {
+		DiskThreshold float64 `yaml:"diskThreshold"` // used disk space in percent at which workers are drained
+		LoadThreshold float64 `yaml:"loadThreshold"` // cpu usage in percent at which an alert is sent
+		RetentionDays int     `yaml:"retentionDays"` // days to keep heartbeats for
+	} `yaml:"workerHealth"`
+	StreamFailover *struct {
+		Enabled        bool `yaml:"enabled"`        // assign a standby worker to every lecture hall source
+		TimeoutSeconds int  `yaml:"timeoutSeconds"` // seconds without heartbeat after which the standby takes over
+	} `yaml:"streamFailover"`
+	LectureHallHealth *struct {
+		CheckBeforeMinutes int `yaml:"checkBeforeMinutes"` // minutes before a lecture from which on the sources of its lecture hall are checked
+		AlertBeforeMinutes int `yaml:"alertBeforeMinutes"` // minutes before a lecture at which unreachable sources are reported
+	} `yaml:"lectureHallHealth"`
 	VodURLTemplate string `yaml:"vodURLTemplate"`
 	CanonicalURL   string `yaml:"canonicalURL"`
+
+	// EncodingProfiles are the ffmpeg encoding profiles lecture halls and courses can use by name.
+	// The profile "default" is used if neither the course nor the lecture hall has one.
+	EncodingProfiles map[string]EncodingProfile `yaml:"encodingProfiles"`
+	// Archival re-encodes VoDs of old streams to an efficient codec during off-peak hours
+	Archival *struct {
+		Enabled      bool   `yaml:"enabled"`      // VoDs of courses that archive them by retention policy are archived anyway
+		Codec        string `yaml:"codec"`        // av1 or hevc
+		CRF          uint32 `yaml:"crf"`          // quality of archives, 0 uses the default of the codec
+		MinAgeDays   int    `yaml:"minAgeDays"`   // VoDs of streams that ended longer ago are archived
+		OffPeakStart int    `yaml:"offPeakStart"` // hour from which on VoDs are archived
+		OffPeakEnd   int    `yaml:"offPeakEnd"`   // hour until which VoDs are archived
+		MaxJobs      int    `yaml:"maxJobs"`      // maximum number of VoDs archived per hour
+	} `yaml:"archival"`
+	// Retention enforces the retention policies of courses
+	Retention *struct {
+		NoticeDays int `yaml:"noticeDays"` // days course admins are notified before recordings are deleted
+	} `yaml:"retention"`
+	// Admin is the listener of endpoints for operators, e.g. prometheus metrics. It shouldn't be reachable publicly.
+	Admin *struct {
+		Addr string `yaml:"addr"` // e.g. 127.0.0.1:8082
+	} `yaml:"admin"`
+}
+
+// EncodingProfile configures how workers encode live streams and transcode their recordings.
+// Unset fields use the workers' defaults.
+type EncodingProfile struct {
+	Codec         string   `yaml:"codec"`         // ffmpeg video encoder of live streams, e.g. libx264 or h264_nvenc
+	Preset        string   `yaml:"preset"`        // preset of the encoder
+	Bitrate       uint32   `yaml:"bitrate"`       // maximum video bitrate of live streams in kbit/s
+	GOP           uint32   `yaml:"gop"`           // keyframe interval of live streams in frames
+	FPS           uint32   `yaml:"fps"`           // frame rate of live streams
+	Resolution    string   `yaml:"resolution"`    // WIDTHxHEIGHT live streams are scaled to, empty keeps the source's resolution
+	HardwareFlags []string `yaml:"hardwareFlags"` // ffmpeg arguments placed before the input, e.g. -hwaccel cuda
+	CRF           uint32   `yaml:"crf"`           // quality of transcoded recordings, 0 uses the defaults of the source type
+}
+
+// GetEncodingProfile returns the encoding profile with the given name. Names are case-insensitive.
+func (c Config) GetEncodingProfile(name string) (EncodingProfile, bool) {
+	profile, ok := c.EncodingProfiles[strings.ToLower(name)]
+	return profile, ok
 }
 
 func (Config) GetJWTKey() *rsa.PrivateKey {
@@ -0,0 +1,27 @@
+package liveindicator
+
+import go_anel_pwrctrl "github.com/RBG-TUM/go-anel-pwrctrl"
+
+// AnelIndicator is a live light plugged into an outlet of an Anel PwrCtrl power strip
+type AnelIndicator struct {
+	client go_anel_pwrctrl.PwrCtrl
+	index  int
+}
+
+// NewAnelIndicator Acts as a constructor for Anel live lights.
+// addr: the address of the power strip
+// auth: username and password of the power strip (e.g. "user:password")
+// index: the outlet of the live light
+func NewAnelIndicator(addr string, auth string, index int) *AnelIndicator {
+	return &AnelIndicator{client: go_anel_pwrctrl.New(addr, auth), index: index}
+}
+
+// TurnOn switches the outlet on
+func (a *AnelIndicator) TurnOn() error {
+	return a.client.TurnOn(a.index)
+}
+
+// TurnOff switches the outlet off
+func (a *AnelIndicator) TurnOff() error {
+	return a.client.TurnOff(a.index)
+}
@@ -0,0 +1,59 @@
+package liveindicator
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+const httpTimeout = time.Second * 5
+
+// HTTPIndicator is a live light switched by a GET request, e.g. to a smart plug.
+// The placeholders {index} and {state} in the url are replaced by the outlet index and "on" or "off",
+// e.g. "http://10.0.0.5/relay/{index}?turn={state}".
+type HTTPIndicator struct {
+	URL   string
+	Auth  string // basic auth (e.g. "user:password"), not sent if empty
+	Index int
+
+	client *http.Client
+}
+
+// NewHTTPIndicator Acts as a constructor for HTTP live lights.
+// url: the url template of the light
+// auth: username and password for basic auth (e.g. "user:password"), may be empty
+// index: replaces {index} in the url
+func NewHTTPIndicator(url string, auth string, index int) *HTTPIndicator {
+	return &HTTPIndicator{URL: url, Auth: auth, Index: index, client: &http.Client{Timeout: httpTimeout}}
+}
+
+// TurnOn requests the url with state "on"
+func (h *HTTPIndicator) TurnOn() error {
+	return h.switchLight(true)
+}
+
+// TurnOff requests the url with state "off"
+func (h *HTTPIndicator) TurnOff() error {
+	return h.switchLight(false)
+}
+
+func (h *HTTPIndicator) switchLight(on bool) error {
+	req, err := http.NewRequest(http.MethodGet, expand(h.URL, h.Index, on), nil)
+	if err != nil {
+		return fmt.Errorf("create http request: %w", err)
+	}
+	if h.Auth != "" {
+		user, password, _ := strings.Cut(h.Auth, ":")
+		req.SetBasicAuth(user, password)
+	}
+	res, err := h.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf("live light returned status %d", res.StatusCode)
+	}
+	return nil
+}
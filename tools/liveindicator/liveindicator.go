@@ -0,0 +1,48 @@
+package liveindicator
+
+import (
+	"errors"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"strconv"
+	"strings"
+)
+
+// LiveIndicator is a light in a lecture hall that shows whether the lecture is streamed
+type LiveIndicator interface {
+	// TurnOn switches the live light on.
+	TurnOn() error
+	// TurnOff switches the live light off.
+	TurnOff() error
+}
+
+// ErrNoLiveIndicator is returned for lecture halls without live light
+var ErrNoLiveIndicator = errors.New("lecture hall has no live light")
+
+// New returns the driver of the live light of lectureHall.
+// auth: username and password of the device (e.g. "user:password"), may be empty
+func New(lectureHall model.LectureHall, auth string) (LiveIndicator, error) {
+	if lectureHall.PwrCtrlIp == "" {
+		return nil, ErrNoLiveIndicator
+	}
+	switch lectureHall.LiveIndicator {
+	case model.AnelIndicator:
+		return NewAnelIndicator(lectureHall.PwrCtrlIp, auth, lectureHall.LiveLightIndex), nil
+	case model.HTTPIndicator:
+		return NewHTTPIndicator(lectureHall.PwrCtrlIp, auth, lectureHall.LiveLightIndex), nil
+	case model.MQTTIndicator:
+		if lectureHall.LiveIndicatorTopic == "" {
+			return nil, errors.New("mqtt live light without topic")
+		}
+		return NewMQTTIndicator(lectureHall.PwrCtrlIp, auth, lectureHall.LiveIndicatorTopic, lectureHall.LiveLightIndex), nil
+	}
+	return nil, errors.New("invalid live indicator type")
+}
+
+// expand replaces the placeholders {index} and {state} ("on" or "off") in s
+func expand(s string, index int, on bool) string {
+	state := "off"
+	if on {
+		state = "on"
+	}
+	return strings.NewReplacer("{index}", strconv.Itoa(index), "{state}", state).Replace(s)
+}
@@ -0,0 +1,136 @@
+package liveindicator
+
+import (
+	"bufio"
+	"github.com/joschahenningsen/TUM-Live/model"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHTTPIndicator(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if user, password, ok := r.BasicAuth(); !ok || user != "admin" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		requests = append(requests, r.URL.String())
+	}))
+	defer srv.Close()
+
+	indicator, err := New(model.LectureHall{
+		PwrCtrlIp:      srv.URL + "/relay/{index}?turn={state}",
+		LiveLightIndex: 2,
+		LiveIndicator:  model.HTTPIndicator,
+	}, "admin:secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = indicator.TurnOn(); err != nil {
+		t.Fatal(err)
+	}
+	if err = indicator.TurnOff(); err != nil {
+		t.Fatal(err)
+	}
+	if len(requests) != 2 || requests[0] != "/relay/2?turn=on" || requests[1] != "/relay/2?turn=off" {
+		t.Errorf("unexpected requests: %v", requests)
+	}
+
+	if err = NewHTTPIndicator(srv.URL, "admin:wrong", 0).TurnOn(); err == nil {
+		t.Error("expected error for unauthorized request")
+	}
+}
+
+// mqttMessage is a message received by the fake broker
+type mqttMessage struct {
+	user, password, topic, payload string
+	retain                         bool
+}
+
+// newFakeMQTTBroker accepts one connection per message and returns the address of the broker
+func newFakeMQTTBroker(t *testing.T, messages chan<- mqttMessage) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = l.Close() })
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+			r := bufio.NewReader(conn)
+			var msg mqttMessage
+			header, connect, err := readMQTTPacket(r)
+			if err != nil || header != mqttConnect || string(connect[2:6]) != "MQTT" || connect[6] != 4 {
+				t.Errorf("invalid connect packet %X: % X (%v)", header, connect, err)
+				_ = conn.Close()
+				continue
+			}
+			payload := connect[10:]
+			fields := []*string{new(string), &msg.user, &msg.password} // client id, username, password
+			for i := 0; i < len(fields) && len(payload) >= 2; i++ {
+				n := int(payload[0])<<8 | int(payload[1])
+				*fields[i] = string(payload[2 : 2+n])
+				payload = payload[2+n:]
+			}
+			_, _ = conn.Write([]byte{mqttConnack, 2, 0, 0})
+			header, publish, err := readMQTTPacket(r)
+			if err != nil || header&0xF0 != mqttPublish {
+				t.Errorf("invalid publish packet %X: % X (%v)", header, publish, err)
+				_ = conn.Close()
+				continue
+			}
+			n := int(publish[0])<<8 | int(publish[1])
+			msg.topic, msg.payload, msg.retain = string(publish[2:2+n]), string(publish[2+n:]), header&mqttRetain != 0
+			messages <- msg
+			_ = conn.Close()
+		}
+	}()
+	return l.Addr().String()
+}
+
+func TestMQTTIndicator(t *testing.T) {
+	messages := make(chan mqttMessage, 2)
+	broker := newFakeMQTTBroker(t, messages)
+
+	indicator, err := New(model.LectureHall{
+		PwrCtrlIp:          broker,
+		LiveLightIndex:     1,
+		LiveIndicator:      model.MQTTIndicator,
+		LiveIndicatorTopic: "hs1/light/{index}",
+	}, "tumlive:secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = indicator.TurnOn(); err != nil {
+		t.Fatal(err)
+	}
+	if msg := <-messages; msg != (mqttMessage{"tumlive", "secret", "hs1/light/1", "on", true}) {
+		t.Errorf("unexpected message: %+v", msg)
+	}
+	if err = indicator.TurnOff(); err != nil {
+		t.Fatal(err)
+	}
+	if msg := <-messages; msg.payload != "off" {
+		t.Errorf("expected off, got %+v", msg)
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(model.LectureHall{LiveIndicator: model.AnelIndicator}, ""); err != ErrNoLiveIndicator {
+		t.Errorf("expected ErrNoLiveIndicator, got %v", err)
+	}
+	if _, err := New(model.LectureHall{PwrCtrlIp: "10.0.0.5", LiveIndicator: model.MQTTIndicator}, ""); err == nil {
+		t.Error("expected error for mqtt indicator without topic")
+	}
+	if i, err := New(model.LectureHall{PwrCtrlIp: "10.0.0.5", LiveIndicator: model.MQTTIndicator, LiveIndicatorTopic: "t"}, ""); err != nil || i.(*MQTTIndicator).Broker != "10.0.0.5:1883" {
+		t.Errorf("expected mqtt indicator with default port, got %+v, %v", i, err)
+	}
+	if _, err := New(model.LectureHall{PwrCtrlIp: "10.0.0.5", LiveIndicator: model.AnelIndicator}, ""); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
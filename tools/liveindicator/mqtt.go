@@ -0,0 +1,156 @@
+package liveindicator
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	uuid "github.com/satori/go.uuid"
+	"io"
+	"net"
+	"strings"
+	"time"
+)
+
+/**
+*
+* Minimal MQTT 3.1.1 client that publishes a single retained message with QoS 0.
+*
+**/
+
+const (
+	mqttDefaultPort = "1883"
+	mqttTimeout     = time.Second * 5
+
+	mqttConnect    = 0x10
+	mqttConnack    = 0x20
+	mqttPublish    = 0x30
+	mqttDisconnect = 0xE0
+
+	mqttRetain = 0x01
+)
+
+// MQTTIndicator is a live light that subscribes to a topic of an MQTT broker.
+// "on" or "off" is published as retained message to the topic, {index} in the topic is replaced by the outlet index.
+type MQTTIndicator struct {
+	Broker string // host:port of the broker, the default MQTT port is used if no port is given
+	Auth   string // username and password (e.g. "user:password"), may be empty
+	Topic  string
+	Index  int
+}
+
+// NewMQTTIndicator Acts as a constructor for MQTT live lights.
+// broker: the address of the broker, optionally with port (e.g. "10.0.0.5:1883")
+// auth: username and password of the broker (e.g. "user:password"), may be empty
+// topic: the topic the light subscribes to
+// index: replaces {index} in the topic
+func NewMQTTIndicator(broker string, auth string, topic string, index int) *MQTTIndicator {
+	if _, _, err := net.SplitHostPort(broker); err != nil {
+		broker = net.JoinHostPort(broker, mqttDefaultPort)
+	}
+	return &MQTTIndicator{Broker: broker, Auth: auth, Topic: topic, Index: index}
+}
+
+// TurnOn publishes "on"
+func (m *MQTTIndicator) TurnOn() error {
+	return m.publish("on")
+}
+
+// TurnOff publishes "off"
+func (m *MQTTIndicator) TurnOff() error {
+	return m.publish("off")
+}
+
+func (m *MQTTIndicator) publish(payload string) error {
+	conn, err := net.DialTimeout("tcp", m.Broker, mqttTimeout)
+	if err != nil {
+		return fmt.Errorf("dial mqtt broker: %w", err)
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(mqttTimeout)); err != nil {
+		return err
+	}
+
+	if _, err = conn.Write(m.connectPacket()); err != nil {
+		return fmt.Errorf("send mqtt connect: %w", err)
+	}
+	header, connack, err := readMQTTPacket(bufio.NewReader(conn))
+	if err != nil {
+		return fmt.Errorf("read mqtt connack: %w", err)
+	}
+	if header != mqttConnack || len(connack) != 2 {
+		return fmt.Errorf("unexpected mqtt packet %X: % X", header, connack)
+	}
+	if connack[1] != 0 {
+		return fmt.Errorf("mqtt broker refused connection: return code %d", connack[1])
+	}
+
+	topic := expand(m.Topic, m.Index, false)
+	publish := append(mqttString(topic), payload...)
+	if _, err = conn.Write(mqttPacket(mqttPublish|mqttRetain, publish)); err != nil {
+		return fmt.Errorf("publish mqtt message: %w", err)
+	}
+	_, err = conn.Write([]byte{mqttDisconnect, 0})
+	return err
+}
+
+// connectPacket returns the CONNECT packet with clean session and the credentials of the indicator
+func (m *MQTTIndicator) connectPacket() []byte {
+	flags := byte(0x02) // clean session
+	payload := mqttString("tumlive-" + strings.ReplaceAll(uuid.NewV4().String(), "-", "")[:12])
+	if m.Auth != "" {
+		user, password, _ := strings.Cut(m.Auth, ":")
+		flags |= 0x80 | 0x40 // username and password
+		payload = append(payload, mqttString(user)...)
+		payload = append(payload, mqttString(password)...)
+	}
+	variableHeader := append(mqttString("MQTT"), 4, flags, 0, 30) // protocol level 4 (3.1.1), keep alive 30s
+	return mqttPacket(mqttConnect, append(variableHeader, payload...))
+}
+
+// mqttPacket prepends the fixed header to body
+func mqttPacket(header byte, body []byte) []byte {
+	packet := []byte{header}
+	length := len(body)
+	for {
+		b := byte(length % 128)
+		length /= 128
+		if length > 0 {
+			b |= 0x80
+		}
+		packet = append(packet, b)
+		if length == 0 {
+			break
+		}
+	}
+	return append(packet, body...)
+}
+
+// mqttString encodes s with its length as prefix
+func mqttString(s string) []byte {
+	return append([]byte{byte(len(s) >> 8), byte(len(s))}, s...)
+}
+
+// readMQTTPacket reads the next packet and returns its fixed header and body
+func readMQTTPacket(r *bufio.Reader) (header byte, body []byte, err error) {
+	if header, err = r.ReadByte(); err != nil {
+		return 0, nil, err
+	}
+	length, multiplier := 0, 1
+	for i := 0; ; i++ {
+		if i == 4 {
+			return 0, nil, errors.New("malformed mqtt remaining length")
+		}
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, nil, err
+		}
+		length += int(b&0x7F) * multiplier
+		multiplier *= 128
+		if b&0x80 == 0 {
+			break
+		}
+	}
+	body = make([]byte, length)
+	_, err = io.ReadFull(r, body)
+	return header, body, err
+}
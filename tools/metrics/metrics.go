@@ -0,0 +1,68 @@
+// Package metrics provides prometheus metrics for the TUM-Live server.
+package metrics
+
+import (
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"strconv"
+	"time"
+)
+
+var (
+	httpRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tumlive_http_request_duration_seconds",
+		Help:    "Latency of http requests by gin route",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method", "route", "status"})
+
+	// RealtimeConnections is the number of active realtime subscriptions by channel
+	RealtimeConnections = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tumlive_realtime_connections",
+		Help: "The number of active realtime subscriptions by channel",
+	}, []string{"channel"})
+
+	// ChatMessages counts all chat messages sent, use rate() to get messages per second
+	ChatMessages = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "tumlive_chat_messages_total",
+		Help: "The total number of chat messages sent",
+	})
+
+	grpcCallDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tumlive_grpc_call_duration_seconds",
+		Help:    "Latency of grpc calls from (direction=in) and to (direction=out) workers",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"direction", "method", "code"})
+
+	// ProgressFlushSize observes the number of progresses written per flush of the progress buffer
+	ProgressFlushSize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "tumlive_progress_buffer_flush_size",
+		Help:    "The number of progresses written per flush of the progress buffer",
+		Buckets: prometheus.ExponentialBuckets(1, 4, 8),
+	})
+
+	dbQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "tumlive_db_query_duration_seconds",
+		Help:    "Latency of database queries by operation and table",
+		Buckets: []float64{.0005, .001, .0025, .005, .01, .025, .05, .1, .25, .5, 1, 2.5},
+	}, []string{"operation", "table"})
+)
+
+// Middleware records the latency of all requests handled by gin, labeled by the route (not the full path)
+func Middleware(c *gin.Context) {
+	start := time.Now()
+	c.Next()
+	route := c.FullPath()
+	if route == "" {
+		route = "unmatched"
+	}
+	httpRequestDuration.
+		WithLabelValues(c.Request.Method, route, strconv.Itoa(c.Writer.Status())).
+		Observe(time.Since(start).Seconds())
+}
+
+// Handler serves the collected metrics in the prometheus exposition format
+func Handler() gin.HandlerFunc {
+	return gin.WrapH(promhttp.Handler())
+}
@@ -291,6 +291,21 @@ func InitStreamRealtime() realtime.SubscriptionMiddleware {
 	}
 }
 
+// AdminOfCourseRealtime only lets admins of the course subscribe, it requires InitStreamRealtime before.
+func AdminOfCourseRealtime() realtime.SubscriptionMiddleware {
+	return func(context *realtime.Context) *realtime.Error {
+		foundContext, exists := context.Get("TUMLiveContext")
+		if !exists {
+			return realtime.NewError(http.StatusBadRequest, "context should exist but doesn't")
+		}
+		tumLiveContext := foundContext.(TUMLiveContext)
+		if tumLiveContext.User == nil || !tumLiveContext.User.IsAdminOfCourse(*tumLiveContext.Course) {
+			return realtime.NewError(http.StatusForbidden, "forbidden to see messages for admins")
+		}
+		return nil
+	}
+}
+
 func OwnerOfCourse(c *gin.Context) {
 	foundContext, exists := c.Get("TUMLiveContext")
 	if !exists {
@@ -377,6 +392,27 @@ func AdminToken(daoWrapper dao.DaoWrapper) gin.HandlerFunc {
 	}
 }
 
+// AdminBearerToken authenticates requests with an admin token in the Authorization header,
+// e.g. "Authorization: Bearer <token>". Tokens in the URL would end up in logs.
+func AdminBearerToken(daoWrapper dao.DaoWrapper) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		auth := c.GetHeader("Authorization")
+		token := strings.TrimPrefix(auth, "Bearer ")
+		if !strings.HasPrefix(auth, "Bearer ") || token == "" {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		t, err := daoWrapper.TokenDao.GetToken(token)
+		if err != nil || t.Scope != model.TokenScopeAdmin {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		if err = daoWrapper.TokenDao.TokenUsed(t); err != nil {
+			log.WithError(err).Warn("error marking token as used")
+		}
+	}
+}
+
 type TUMLiveContext struct {
 	User          *model.User
 	Course        *model.Course
@@ -35,6 +35,11 @@ func (p presetUtility) ProvideCamera(ctype model.CameraType, ip string) (camera.
 		return camera.NewAxisCam(ip, Cfg.Auths.CamAuth), nil
 	case model.Panasonic:
 		return camera.NewPanasonicCam(ip, nil), nil
+	case model.Visca:
+		return camera.NewViscaCam(ip), nil
+	case model.Onvif:
+		auth := Cfg.Auths.CamAuth
+		return camera.NewOnvifCam(ip, &auth), nil
 	}
 	return nil, errors.New("invalid camera type")
 }
@@ -57,6 +62,9 @@ func (p presetUtility) FetchLHPresets(lectureHall model.LectureHall) {
 			return
 		}
 		presets, err := cam.GetPresets()
+		if errors.Is(err, camera.ErrUnsupported) {
+			return // keep the presets saved in TUM-Live
+		}
 		if err != nil {
 			log.WithError(err).WithField("AxisCam", lectureHall.CameraIP).Warn("FetchCameraPresets: failed to get Presets")
 			return
@@ -1,6 +1,7 @@
 package realtime
 
 import (
+	"encoding/json"
 	"errors"
 	"github.com/getsentry/sentry-go"
 	"strings"
@@ -32,6 +33,7 @@ type Channel struct {
 	path        []string
 	handlers    ChannelHandlers
 	subscribers ChannelSubscribers
+	replays     *ReplayStore
 }
 
 // PathMatches returns true and the params of the channel subscription if the path matches the path of the Channel.
@@ -57,7 +59,8 @@ func (c *Channel) PathMatches(path string) (bool, map[string]string) {
 }
 
 // Subscribe executes the Channels middlewares and(if successful) adds the user to the Channel and executes the channels OnSubscribe handler.
-func (c *Channel) Subscribe(context *Context) {
+// If resume references a previous subscription of the same path, the broadcasts the client missed are sent again before OnSubscribe.
+func (c *Channel) Subscribe(context *Context, resume *ResumeRequest) {
 	for _, middleware := range c.handlers.SubscriptionMiddlewares {
 		if err := middleware(context); err != nil {
 			sentry.CaptureException(errors.New(err.Description))
@@ -68,13 +71,80 @@ func (c *Channel) Subscribe(context *Context) {
 		}
 	}
 
-	c.subscribers.Add(context)
+	c.attach(context, resume)
 
 	if c.handlers.OnSubscribe != nil {
 		c.handlers.OnSubscribe(context)
 	}
 }
 
+// attach adds the context to the subscribers. If the channel keeps replay buffers, the subscription referenced by
+// resume is resumed or a new one is started, and the client gets the broadcasts it missed.
+func (c *Channel) attach(context *Context, resume *ResumeRequest) {
+	if c.replays == nil {
+		c.subscribers.Add(context)
+		return
+	}
+	var buffer *replayBuffer
+	resumed := false
+	if resume != nil && resume.ResumeToken != "" {
+		context.replay, buffer, resumed = c.replays.Resume(resume.ResumeToken, context.FullPath)
+	}
+	if !resumed {
+		context.replay, buffer = c.replays.Create(context.FullPath)
+	}
+	lastSeq := context.replay.lastAck
+	if resume != nil && resume.LastSeq > lastSeq {
+		lastSeq = resume.LastSeq
+	}
+	buffer.attach(lastSeq, func(seq uint64, missed [][]byte, ok bool) {
+		c.subscribers.Add(context)
+		resumed = resumed && ok
+		if err := context.sendSubscribed(seq, resumed); err != nil {
+			sentry.CaptureException(err)
+			return
+		}
+		if !resumed {
+			return
+		}
+		for _, data := range missed {
+			if err := context.Client.Send(data); err != nil {
+				sentry.CaptureException(err)
+				return
+			}
+		}
+	})
+}
+
+// Broadcast sends the payload to all subscribers of path. The message gets the next sequence id of path and is kept
+// for subscribers that resume their subscription after reconnecting.
+func (c *Channel) Broadcast(path string, payload []byte) error {
+	message := Message{
+		Type:    MessageTypeChannelMessage,
+		Channel: path,
+		Payload: payload,
+	}
+	deliver := func(data []byte) {
+		for _, context := range c.subscribers.GetByPath(path) {
+			_ = context.Client.Send(data) // the client gets the message when resuming if sending fails
+		}
+	}
+	if c.replays != nil {
+		if buffer, ok := c.replays.Buffer(path); ok {
+			return buffer.push(func(seq uint64) ([]byte, error) {
+				message.Seq = seq
+				return json.Marshal(message)
+			}, deliver)
+		}
+	}
+	data, err := json.Marshal(message)
+	if err != nil {
+		return err
+	}
+	deliver(data)
+	return nil
+}
+
 // HandleMessage executes the channels OnMessage method if it exists.
 func (c *Channel) HandleMessage(client *Client, message *Message) {
 	if c.handlers.OnMessage == nil {
@@ -103,6 +173,9 @@ func (c *Channel) Unsubscribe(clientId string, path string) bool {
 	}
 
 	c.subscribers.Remove(clientId, path)
+	if c.replays != nil {
+		c.replays.Remove(context.replay)
+	}
 	if c.handlers.OnUnsubscribe != nil {
 		c.handlers.OnUnsubscribe(context)
 	}
@@ -111,9 +184,16 @@ func (c *Channel) Unsubscribe(clientId string, path string) bool {
 }
 
 // UnsubscribeAllPaths unsubscribes a client from all paths of the channel they are connected to.
+// The replay buffers of the subscriptions are kept for a while so that the client can resume them after reconnecting.
 func (c *Channel) UnsubscribeAllPaths(clientId string) bool {
 	removed := c.subscribers.RemoveAllPaths(clientId)
 
+	if c.replays != nil {
+		for _, context := range removed {
+			c.replays.Detach(context.replay)
+		}
+	}
+
 	if c.handlers.OnUnsubscribe != nil {
 		for _, context := range removed {
 			c.handlers.OnUnsubscribe(context)
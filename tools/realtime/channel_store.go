@@ -8,10 +8,12 @@ import (
 // ChannelStore stores pointers to all Channels
 type ChannelStore struct {
 	channels map[string]*Channel
+	replays  ReplayStore
 }
 
 func (s *ChannelStore) init() {
 	s.channels = map[string]*Channel{}
+	s.replays.init()
 }
 
 func (s *ChannelStore) Register(path string, handlers ChannelHandlers) *Channel {
@@ -19,6 +21,7 @@ func (s *ChannelStore) Register(path string, handlers ChannelHandlers) *Channel
 		path:        strings.Split(path, channelPathSep),
 		handlers:    handlers,
 		subscribers: ChannelSubscribers{},
+		replays:     &s.replays,
 	}
 	channel.subscribers.init()
 	s.channels[path] = &channel
@@ -48,14 +51,15 @@ func (s *ChannelStore) OnMessage(client *Client, message *Message) {
 	log.WithField("channel", message.Channel).Warn("unknown channel on websocket message")
 }
 
-func (s *ChannelStore) Subscribe(client *Client, channelPath string) bool {
+// Subscribe subscribes the client to channelPath. resume may be nil or reference a previous subscription to resume.
+func (s *ChannelStore) Subscribe(client *Client, channelPath string, resume *ResumeRequest) bool {
 	if found, channel, params := s.Get(channelPath); found {
 		channel.Subscribe(&Context{
 			Client:     client,
 			FullPath:   channelPath,
 			params:     params,
 			properties: map[string]interface{}{},
-		})
+		}, resume)
 		return true
 	}
 	return false
@@ -68,6 +72,15 @@ func (s *ChannelStore) Unsubscribe(clientId string, channelPath string) bool {
 	return false
 }
 
+// Ack acknowledges all messages up to seq the client received on channelPath.
+func (s *ChannelStore) Ack(clientId string, channelPath string, seq uint64) {
+	if found, channel, _ := s.Get(channelPath); found {
+		if context, ok := channel.FindContext(clientId, channelPath); ok {
+			s.replays.Ack(context.replay, seq)
+		}
+	}
+}
+
 func (s *ChannelStore) UnsubscribeAll(clientId string) {
 	for _, channel := range s.channels {
 		channel.UnsubscribeAllPaths(clientId)
@@ -18,7 +18,7 @@ func TestChannelSubscribe(t *testing.T) {
 			t.Errorf("channel.IsSubscribed(%s, %s) = true, want false", clientId, simplePath)
 		}
 
-		store.Subscribe(&Client{Id: clientId}, simplePath)
+		store.Subscribe(&Client{Id: clientId, sendMessage: noopSend}, simplePath, nil)
 
 		if result := channel.IsSubscribed(clientId, simplePath); !result {
 			t.Errorf("channel.IsSubscribed(%s, %s) = false, want true", clientId, simplePath)
@@ -50,7 +50,7 @@ func TestChannelSubscribe(t *testing.T) {
 			},
 		})
 
-		store.Subscribe(&Client{Id: clientId}, testPath)
+		store.Subscribe(&Client{Id: clientId, sendMessage: noopSend}, testPath, nil)
 
 		if result := channel.IsSubscribed(clientId, path); result {
 			t.Errorf("channel.IsSubscribed(%s, %s) = false, want true", path, clientId)
@@ -71,3 +71,7 @@ func TestChannelSubscribe(t *testing.T) {
 		}
 	})
 }
+
+func noopSend(_ []byte) error {
+	return nil
+}
@@ -9,6 +9,7 @@ type Context struct {
 	FullPath   string
 	params     map[string]string
 	properties map[string]interface{}
+	replay     *resumeSession
 }
 
 type Error struct {
@@ -39,25 +40,39 @@ func (context *Context) SendError(error *Error) error {
 	if err != nil {
 		return err
 	}
-	message := Message{
+	return context.Send(data)
+}
+
+// Send sends the payload to the client only. Such messages have no sequence id and aren't replayed after a reconnect,
+// use Realtime.Broadcast for messages to all subscribers of a channel path.
+func (context *Context) Send(payload []byte) error {
+	data, err := json.Marshal(Message{
 		Type:    MessageTypeChannelMessage,
 		Channel: context.FullPath,
-		Payload: data,
-	}
-	data, err = json.Marshal(message)
+		Payload: payload,
+	})
 	if err != nil {
 		return err
 	}
 	return context.Client.Send(data)
 }
 
-func (context *Context) Send(payload []byte) error {
-	message := Message{
-		Type:    MessageTypeChannelMessage,
+// sendSubscribed confirms the subscription and tells the client its resume token and the current sequence id of the
+// channel path.
+func (context *Context) sendSubscribed(seq uint64, resumed bool) error {
+	payload, err := json.Marshal(SubscriptionState{
+		ResumeToken: context.replay.token,
+		Seq:         seq,
+		Resumed:     resumed,
+	})
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(Message{
+		Type:    MessageTypeSubscribed,
 		Channel: context.FullPath,
 		Payload: payload,
-	}
-	data, err := json.Marshal(message)
+	})
 	if err != nil {
 		return err
 	}
@@ -11,12 +11,33 @@ const (
 	MessageTypeSubscribe      = "subscribe"
 	MessageTypeUnsubscribe    = "unsubscribe"
 	MessageTypeChannelMessage = "message"
+	MessageTypeSubscribed     = "subscribed"
+	MessageTypeAck            = "ack"
 )
 
 type Message struct {
 	Type    string          `json:"type"`
 	Channel string          `json:"channel"`
 	Payload json.RawMessage `json:"payload"`
+	// Seq is the sequence id of a broadcast, unique and increasing per channel path. Messages to single clients have none.
+	// Clients acknowledge received broadcasts by sending it back with MessageTypeAck.
+	Seq uint64 `json:"seq,omitempty"`
+}
+
+// ResumeRequest is the optional payload of a subscribe message.
+// A client that reconnects sends the token of its previous subscription and the last sequence id it received
+// to get all messages it missed in the meantime.
+type ResumeRequest struct {
+	ResumeToken string `json:"resumeToken"`
+	LastSeq     uint64 `json:"lastSeq"`
+}
+
+// SubscriptionState is the payload of the MessageTypeSubscribed message sent after a successful subscription.
+// If Resumed is false, the client could not be caught up and should reload the state of the channel.
+type SubscriptionState struct {
+	ResumeToken string `json:"resumeToken"`
+	Seq         uint64 `json:"seq"`
+	Resumed     bool   `json:"resumed"`
 }
 
 type Realtime struct {
@@ -73,6 +94,22 @@ func (r *Realtime) Send(channelPath string, clientId string, payload []byte) err
 	return context.Send(payload)
 }
 
+// Broadcast sends the payload to all subscribers of channelPath. Subscribers that are disconnected get it when they
+// resume their subscription.
+func (r *Realtime) Broadcast(channelPath string, payload []byte) error {
+	channelExists, channel, _ := r.channels.Get(channelPath)
+	if !channelExists {
+		return errors.New("channel does not exists")
+	}
+	return channel.Broadcast(channelPath, payload)
+}
+
+// IsResumable returns true if channelPath has subscriptions, including those of disconnected clients that can still
+// be resumed. Broadcasts to other paths reach nobody.
+func (r *Realtime) IsResumable(channelPath string) bool {
+	return r.channels.replays.Has(channelPath)
+}
+
 // connectHandler handles a new melody connection
 func (r *Realtime) connectHandler(client *Client) {}
 
@@ -92,11 +129,19 @@ func (r *Realtime) messageHandler(c *Client, msg []byte) {
 
 	switch req.Type {
 	case MessageTypeSubscribe:
-		r.channels.Subscribe(c, req.Channel)
+		var resume ResumeRequest
+		if len(req.Payload) != 0 {
+			if err := json.Unmarshal(req.Payload, &resume); err != nil {
+				log.WithError(err).Warn("could not unmarshal resume request")
+			}
+		}
+		r.channels.Subscribe(c, req.Channel, &resume)
 	case MessageTypeUnsubscribe:
 		r.channels.Unsubscribe(c.Id, req.Channel)
 	case MessageTypeChannelMessage:
 		r.channels.OnMessage(c, &req)
+	case MessageTypeAck:
+		r.channels.Ack(c.Id, req.Channel, req.Seq)
 	default:
 		log.WithField("type", req.Type).Warn("unknown pubsub websocket request type")
 	}
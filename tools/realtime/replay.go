@@ -0,0 +1,211 @@
+package realtime
+
+import (
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	// replayBufferSize is the maximum number of broadcasts kept per channel path.
+	replayBufferSize = 256
+	// replayRetention is the time a subscription can be resumed after its client disconnected.
+	replayRetention = 2 * time.Minute
+)
+
+// replayEntry is a single broadcast of a channel path.
+type replayEntry struct {
+	seq  uint64
+	data []byte
+	at   time.Time
+}
+
+// replayBuffer assigns monotonically increasing sequence ids to all broadcasts of one channel path
+// and keeps the most recent ones so that they can be sent again to clients that reconnect.
+type replayBuffer struct {
+	path    string
+	seq     uint64
+	entries []replayEntry
+	mutex   sync.Mutex
+}
+
+func newReplayBuffer(path string) *replayBuffer {
+	return &replayBuffer{path: path}
+}
+
+// push assigns the next sequence id to a broadcast, stores it and delivers it. The buffer stays locked while
+// delivering, so that all subscribers receive broadcasts in the order of their sequence ids.
+func (b *replayBuffer) push(encode func(seq uint64) ([]byte, error), deliver func(data []byte)) error {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	data, err := encode(b.seq + 1)
+	if err != nil {
+		return err
+	}
+	b.seq++
+	now := time.Now()
+	b.entries = append(b.entries, replayEntry{seq: b.seq, data: data, at: now})
+	// nobody can resume a subscription older than replayRetention, so older broadcasts aren't needed anymore
+	i := 0
+	for i < len(b.entries) && (len(b.entries)-i > replayBufferSize || now.Sub(b.entries[i].at) > replayRetention) {
+		i++
+	}
+	b.entries = b.entries[i:]
+	deliver(data)
+	return nil
+}
+
+// attach calls subscribe with the current sequence id and the broadcasts after seq while no broadcast can happen,
+// so that the subscriber neither misses a broadcast nor receives one twice.
+// ok is false if some of the broadcasts after seq have already been dropped from the buffer.
+func (b *replayBuffer) attach(seq uint64, subscribe func(lastSeq uint64, missed [][]byte, ok bool)) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+	missed, ok := b.since(seq)
+	subscribe(b.seq, missed, ok)
+}
+
+// since returns all buffered broadcasts with a sequence id greater than seq. The caller must hold b.mutex.
+// ok is false if some of these broadcasts have already been dropped from the buffer.
+func (b *replayBuffer) since(seq uint64) (messages [][]byte, ok bool) {
+	if seq > b.seq {
+		return nil, false
+	}
+	if seq < b.seq && (len(b.entries) == 0 || b.entries[0].seq > seq+1) {
+		return nil, false
+	}
+	for _, entry := range b.entries {
+		if entry.seq > seq {
+			messages = append(messages, entry.data)
+		}
+	}
+	return messages, true
+}
+
+// resumeSession is the subscription of one client to a channel path, identified by the token the client uses to
+// resume it after reconnecting.
+type resumeSession struct {
+	token      string
+	path       string
+	lastAck    uint64
+	detachedAt time.Time
+}
+
+// ReplayStore keeps the replay buffers of all channel paths and the sessions of their subscribers, including those
+// of recently disconnected clients. A buffer lives as long as a session of its path can be resumed.
+type ReplayStore struct {
+	buffers  map[string]*replayBuffer
+	sessions map[string]*resumeSession
+	mutex    sync.Mutex
+}
+
+func (s *ReplayStore) init() {
+	s.buffers = map[string]*replayBuffer{}
+	s.sessions = map[string]*resumeSession{}
+}
+
+// Create starts a new session for a subscription to path and returns it with the buffer of path.
+func (s *ReplayStore) Create(path string) (*resumeSession, *replayBuffer) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cleanup()
+
+	session := &resumeSession{token: uuid.NewString(), path: path}
+	s.sessions[session.token] = session
+	return session, s.buffer(path)
+}
+
+// Resume returns the detached session identified by token and the buffer of its path if the session belongs to path
+// and has not expired.
+func (s *ReplayStore) Resume(token string, path string) (*resumeSession, *replayBuffer, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cleanup()
+
+	session, ok := s.sessions[token]
+	if !ok || session.path != path || session.detachedAt.IsZero() {
+		return nil, nil, false
+	}
+	session.detachedAt = time.Time{}
+	return session, s.buffer(path), true
+}
+
+// Buffer returns the buffer of path if there are sessions of it. Broadcasts to paths without sessions
+// don't need to be kept.
+func (s *ReplayStore) Buffer(path string) (*replayBuffer, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	buffer, ok := s.buffers[path]
+	return buffer, ok
+}
+
+// Has returns true if there are sessions of path that are in use or can be resumed.
+func (s *ReplayStore) Has(path string) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.cleanup()
+	_, ok := s.buffers[path]
+	return ok
+}
+
+// Ack stores the last sequence id the client of the session received.
+func (s *ReplayStore) Ack(session *resumeSession, seq uint64) {
+	if session == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if seq > session.lastAck {
+		session.lastAck = seq
+	}
+}
+
+// Detach marks the session as no longer in use; it is kept for replayRetention to allow resuming the subscription.
+func (s *ReplayStore) Detach(session *resumeSession) {
+	if session == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	session.detachedAt = time.Now()
+}
+
+// Remove deletes the session immediately, e.g. when the client unsubscribed on purpose.
+func (s *ReplayStore) Remove(session *resumeSession) {
+	if session == nil {
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	delete(s.sessions, session.token)
+	s.cleanup()
+}
+
+// buffer returns the buffer of path, creating it if necessary. The caller must hold s.mutex.
+func (s *ReplayStore) buffer(path string) *replayBuffer {
+	buffer, ok := s.buffers[path]
+	if !ok {
+		buffer = newReplayBuffer(path)
+		s.buffers[path] = buffer
+	}
+	return buffer
+}
+
+// cleanup removes expired sessions and the buffers of paths without sessions. The caller must hold s.mutex.
+func (s *ReplayStore) cleanup() {
+	used := map[string]bool{}
+	for token, session := range s.sessions {
+		if !session.detachedAt.IsZero() && time.Since(session.detachedAt) > replayRetention {
+			delete(s.sessions, token)
+			continue
+		}
+		used[session.path] = true
+	}
+	for path := range s.buffers {
+		if !used[path] {
+			delete(s.buffers, path)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+package realtime
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func ResumeSubMessage(path string, token string, lastSeq uint64) []byte {
+	payload, _ := json.Marshal(ResumeRequest{ResumeToken: token, LastSeq: lastSeq})
+	message := Message{
+		Type:    MessageTypeSubscribe,
+		Channel: path,
+		Payload: payload,
+	}
+	data, _ := json.Marshal(message)
+	return data
+}
+
+func AckMessage(path string, seq uint64) []byte {
+	message := Message{
+		Type:    MessageTypeAck,
+		Channel: path,
+		Seq:     seq,
+	}
+	data, _ := json.Marshal(message)
+	return data
+}
+
+func noopDeliver(_ []byte) {}
+
+func TestReplayBuffer(t *testing.T) {
+	t.Run("Sequence ids increase", func(t *testing.T) {
+		buffer := newReplayBuffer("example/path")
+		for i := uint64(1); i <= 3; i++ {
+			var got uint64
+			_ = buffer.push(func(seq uint64) ([]byte, error) {
+				got = seq
+				return []byte{}, nil
+			}, noopDeliver)
+			if got != i {
+				t.Errorf("buffer.push(...) seq = %d, want %d", got, i)
+			}
+		}
+	})
+
+	t.Run("Attach returns missed broadcasts", func(t *testing.T) {
+		buffer := newReplayBuffer("example/path")
+		for i := 0; i < 5; i++ {
+			_ = buffer.push(func(seq uint64) ([]byte, error) { return []byte{byte(seq)}, nil }, noopDeliver)
+		}
+		buffer.attach(3, func(seq uint64, missed [][]byte, ok bool) {
+			if seq != 5 || !ok || len(missed) != 2 {
+				t.Errorf("buffer.attach(3) = (%d, %d messages, %t), want (5, 2 messages, true)", seq, len(missed), ok)
+			}
+		})
+		buffer.attach(6, func(_ uint64, _ [][]byte, ok bool) {
+			if ok {
+				t.Errorf("buffer.attach(6) = (..., true), want (..., false)")
+			}
+		})
+	})
+
+	t.Run("Buffer is bounded", func(t *testing.T) {
+		buffer := newReplayBuffer("example/path")
+		for i := 0; i < replayBufferSize+10; i++ {
+			_ = buffer.push(func(seq uint64) ([]byte, error) { return []byte{}, nil }, noopDeliver)
+		}
+		if len(buffer.entries) != replayBufferSize {
+			t.Errorf("len(buffer.entries) = %d, want %d", len(buffer.entries), replayBufferSize)
+		}
+		buffer.attach(0, func(_ uint64, _ [][]byte, ok bool) {
+			if ok {
+				t.Errorf("buffer.attach(0) = (..., true), want (..., false)")
+			}
+		})
+	})
+}
+
+func TestRealtimeResume(t *testing.T) {
+	// newListener returns a message handler of a fake client that stores the received messages in received
+	newListener := func(t *testing.T, received *[]Message) func(msg []byte) {
+		return func(msg []byte) {
+			var m Message
+			if err := json.Unmarshal(msg, &m); err != nil {
+				t.Errorf("could not unmarshal message: %e", err)
+			}
+			*received = append(*received, m)
+		}
+	}
+
+	t.Run("Client receives broadcasts sent while offline after reconnect", func(t *testing.T) {
+		testChannelPath := "example/path/foobar"
+		fakeConnector, fakeSocket := NewFakeConnector()
+		realtime := New(fakeConnector)
+		realtime.RegisterChannel(testChannelPath, ChannelHandlers{})
+
+		var received, receivedOther []Message
+		fakeClient := fakeSocket.NewClientConnects(newListener(t, &received))
+		fakeClient.Send(SubMessage(testChannelPath))
+		otherClient := fakeSocket.NewClientConnects(newListener(t, &receivedOther))
+		otherClient.Send(SubMessage(testChannelPath))
+		if len(received) != 1 || received[0].Type != MessageTypeSubscribed {
+			t.Errorf("first message is not of type %s", MessageTypeSubscribed)
+			return
+		}
+		var state SubscriptionState
+		_ = json.Unmarshal(received[0].Payload, &state)
+
+		_ = realtime.Broadcast(testChannelPath, []byte(`1`))
+		fakeClient.Send(AckMessage(testChannelPath, received[1].Seq))
+		fakeClient.Disconnect()
+
+		_ = realtime.Broadcast(testChannelPath, []byte(`2`))
+		_ = realtime.Broadcast(testChannelPath, []byte(`3`))
+
+		received = nil
+		fakeClient = fakeSocket.NewClientConnects(newListener(t, &received))
+		fakeClient.Send(ResumeSubMessage(testChannelPath, state.ResumeToken, 1))
+
+		if len(received) != 3 {
+			t.Errorf("len(received) = %d, want %d", len(received), 3)
+			return
+		}
+		_ = json.Unmarshal(received[0].Payload, &state)
+		if !state.Resumed || state.Seq != 3 {
+			t.Errorf("state = %+v, want resumed with seq 3", state)
+		}
+		if received[1].Seq != 2 || received[2].Seq != 3 || string(received[2].Payload) != `3` {
+			t.Errorf("replayed seqs = (%d, %d), want (2, 3)", received[1].Seq, received[2].Seq)
+		}
+		// sequence ids are counted per channel path, not per subscription
+		if len(receivedOther) != 4 || receivedOther[3].Seq != 3 {
+			t.Errorf("other subscriber received %+v, want broadcasts 1 to 3", receivedOther)
+		}
+
+		_ = realtime.Broadcast(testChannelPath, []byte(`4`))
+		if len(received) != 4 || received[3].Seq != 4 {
+			t.Errorf("resumed subscription doesn't receive new broadcasts: %+v", received)
+		}
+	})
+
+	t.Run("Unsubscribing ends the subscription", func(t *testing.T) {
+		testChannelPath := "example/path/foobar"
+		fakeConnector, fakeSocket := NewFakeConnector()
+		realtime := New(fakeConnector)
+		realtime.RegisterChannel(testChannelPath, ChannelHandlers{})
+
+		var received []Message
+		fakeClient := fakeSocket.NewClientConnects(newListener(t, &received))
+		fakeClient.Send(SubMessage(testChannelPath))
+		var state SubscriptionState
+		_ = json.Unmarshal(received[0].Payload, &state)
+		fakeClient.Send(UnsubMessage(testChannelPath))
+
+		received = nil
+		fakeClient.Send(ResumeSubMessage(testChannelPath, state.ResumeToken, 0))
+		_ = json.Unmarshal(received[0].Payload, &state)
+		if state.Resumed {
+			t.Errorf("state = %+v, want new subscription", state)
+		}
+	})
+
+	t.Run("Unknown token starts a new subscription", func(t *testing.T) {
+		testChannelPath := "example/path/foobar"
+		fakeConnector, fakeSocket := NewFakeConnector()
+		realtime := New(fakeConnector)
+		realtime.RegisterChannel(testChannelPath, ChannelHandlers{})
+
+		var received Message
+		fakeClient := fakeSocket.NewClientConnects(func(msg []byte) {
+			_ = json.Unmarshal(msg, &received)
+		})
+		fakeClient.Send(ResumeSubMessage(testChannelPath, "invalid", 5))
+
+		var state SubscriptionState
+		_ = json.Unmarshal(received.Payload, &state)
+		if state.Resumed || state.ResumeToken == "invalid" {
+			t.Errorf("state = %+v, want new subscription", state)
+		}
+	})
+}
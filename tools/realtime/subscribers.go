@@ -29,6 +29,19 @@ func (subs *ChannelSubscribers) GetContext(clientId string, path string) (*Conte
 	return context, exists
 }
 
+// GetByPath returns the contexts of all subscribers of path
+func (subs *ChannelSubscribers) GetByPath(path string) []*Context {
+	subs.mutex.Lock()
+	defer subs.mutex.Unlock()
+	var contexts []*Context
+	for _, context := range subs.subscribers {
+		if context.FullPath == path {
+			contexts = append(contexts, context)
+		}
+	}
+	return contexts
+}
+
 func (subs *ChannelSubscribers) Add(context *Context) {
 	subs.mutex.Lock()
 	defer subs.mutex.Unlock()
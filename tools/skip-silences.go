@@ -0,0 +1,171 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/model"
+)
+
+var (
+	extInfRe  = regexp.MustCompile(`^#EXTINF:([0-9.]+)`)
+	extXMapRe = regexp.MustCompile(`(#EXT-X-MAP:.*URI=")([^"]+)(")`)
+
+	playlistClient = &http.Client{Timeout: time.Second * 10}
+)
+
+// ErrNoMediaPlaylist is returned if a master playlist doesn't reference any media playlist
+var ErrNoMediaPlaylist = errors.New("no media playlist in master playlist")
+
+// GetPlaylistWithoutSilences fetches the HLS playlist at playlistUrl and removes all segments that lie within
+// one of the silences. Master playlists are resolved to their first variant.
+func GetPlaylistWithoutSilences(ctx context.Context, playlistUrl string, silences []model.Silence) (string, error) {
+	base, err := url.Parse(playlistUrl)
+	if err != nil {
+		return "", err
+	}
+	playlist, err := fetchPlaylist(ctx, base)
+	if err != nil {
+		return "", err
+	}
+	if strings.Contains(playlist, "#EXT-X-STREAM-INF") {
+		if base, err = firstVariant(playlist, base); err != nil {
+			return "", err
+		}
+		if playlist, err = fetchPlaylist(ctx, base); err != nil {
+			return "", err
+		}
+	}
+	return skipSilences(playlist, base, silences), nil
+}
+
+func fetchPlaylist(ctx context.Context, u *url.URL) (string, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return "", err
+	}
+	resp, err := playlistClient.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("fetch playlist %s: unexpected status %d", u.Path, resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	return string(body), nil
+}
+
+// firstVariant returns the url of the first media playlist referenced by a master playlist
+func firstVariant(playlist string, base *url.URL) (*url.URL, error) {
+	lines := strings.Split(playlist, "\n")
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "#EXT-X-STREAM-INF") {
+			continue
+		}
+		for _, uri := range lines[i+1:] {
+			uri = strings.TrimSpace(uri)
+			if uri != "" && !strings.HasPrefix(uri, "#") {
+				return base.Parse(uri)
+			}
+		}
+	}
+	return nil, ErrNoMediaPlaylist
+}
+
+// skipSilences removes all segments of the media playlist that lie completely within one of the silences and marks
+// the gaps with EXT-X-DISCONTINUITY. Relative URIs are resolved against base, so the playlist can be served from
+// another host.
+func skipSilences(playlist string, base *url.URL, silences []model.Silence) string {
+	var out []string
+	var segment []string // tags of the current segment
+	var position float64 // start of the current segment in seconds
+	skipped := false     // whether segments were removed since the last segment that was kept
+	for _, line := range strings.Split(strings.TrimRight(playlist, "\n"), "\n") {
+		line = strings.TrimRight(line, "\r")
+		switch {
+		case line == "":
+			continue
+		case strings.HasPrefix(line, "#EXT-X-MAP"):
+			out = append(out, extXMapRe.ReplaceAllStringFunc(line, func(m string) string {
+				parts := extXMapRe.FindStringSubmatch(m)
+				return parts[1] + resolve(base, parts[2]) + parts[3]
+			}))
+		case strings.HasPrefix(line, "#EXTINF"), strings.HasPrefix(line, "#EXT-X-BYTERANGE"),
+			strings.HasPrefix(line, "#EXT-X-DISCONTINUITY"), strings.HasPrefix(line, "#EXT-X-PROGRAM-DATE-TIME"):
+			segment = append(segment, line)
+		case strings.HasPrefix(line, "#"):
+			if len(segment) == 0 {
+				out = append(out, line)
+			} else {
+				segment = append(segment, line)
+			}
+		default: // uri of a segment
+			duration := segmentDuration(segment)
+			if inSilence(position, position+duration, silences) {
+				skipped = true
+			} else {
+				if skipped && !containsDiscontinuity(segment) {
+					out = append(out, "#EXT-X-DISCONTINUITY")
+				}
+				out = append(out, segment...)
+				out = append(out, resolve(base, line))
+				skipped = false
+			}
+			position += duration
+			segment = nil
+		}
+	}
+	out = append(out, segment...) // trailing tags such as EXT-X-ENDLIST
+	return strings.Join(out, "\n") + "\n"
+}
+
+// segmentDuration returns the duration of the EXTINF tag of a segment in seconds
+func segmentDuration(tags []string) float64 {
+	for _, tag := range tags {
+		if m := extInfRe.FindStringSubmatch(tag); m != nil {
+			d, _ := strconv.ParseFloat(m[1], 64)
+			return d
+		}
+	}
+	return 0
+}
+
+func containsDiscontinuity(tags []string) bool {
+	for _, tag := range tags {
+		if strings.HasPrefix(tag, "#EXT-X-DISCONTINUITY") {
+			return true
+		}
+	}
+	return false
+}
+
+// inSilence returns whether the interval from start to end (in seconds) lies completely within one of the silences
+func inSilence(start, end float64, silences []model.Silence) bool {
+	for _, s := range silences {
+		if start >= float64(s.Start) && end <= float64(s.End) {
+			return true
+		}
+	}
+	return false
+}
+
+// resolve returns uri as absolute url relative to base
+func resolve(base *url.URL, uri string) string {
+	u, err := base.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	return u.String()
+}
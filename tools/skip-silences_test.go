@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/joschahenningsen/TUM-Live/model"
+)
+
+const testMediaPlaylist = `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-TARGETDURATION:10
+#EXT-X-MAP:URI="init.mp4?jwt=abc"
+#EXTINF:10.0,
+seg0.m4s?jwt=abc
+#EXTINF:10.0,
+seg1.m4s?jwt=abc
+#EXTINF:10.0,
+seg2.m4s?jwt=abc
+#EXTINF:10.0,
+seg3.m4s?jwt=abc
+#EXTINF:10.0,
+seg4.m4s?jwt=abc
+#EXT-X-ENDLIST
+`
+
+func TestSkipSilences(t *testing.T) {
+	base, _ := url.Parse("https://edge.example.com/vod/eidi.mp4/chunklist.m3u8?jwt=abc")
+	// seg1 and seg2 are silent, seg3 is only partially silent
+	got := skipSilences(testMediaPlaylist, base, []model.Silence{{Start: 8, End: 35}})
+	want := `#EXTM3U
+#EXT-X-VERSION:7
+#EXT-X-TARGETDURATION:10
+#EXT-X-MAP:URI="https://edge.example.com/vod/eidi.mp4/init.mp4?jwt=abc"
+#EXTINF:10.0,
+https://edge.example.com/vod/eidi.mp4/seg0.m4s?jwt=abc
+#EXT-X-DISCONTINUITY
+#EXTINF:10.0,
+https://edge.example.com/vod/eidi.mp4/seg3.m4s?jwt=abc
+#EXTINF:10.0,
+https://edge.example.com/vod/eidi.mp4/seg4.m4s?jwt=abc
+#EXT-X-ENDLIST
+`
+	if got != want {
+		t.Errorf("skipSilences() = \n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestSkipSilencesWithoutSilences(t *testing.T) {
+	base, _ := url.Parse("https://edge.example.com/vod/eidi.mp4/chunklist.m3u8")
+	got := skipSilences(testMediaPlaylist, base, nil)
+	if strings.Contains(got, "#EXT-X-DISCONTINUITY") || strings.Count(got, "#EXTINF") != 5 {
+		t.Errorf("skipSilences() without silences changed segments:\n%s", got)
+	}
+}
+
+func TestGetPlaylistWithoutSilences(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/vod/eidi.mp4/playlist.m3u8":
+			_, _ = fmt.Fprint(w, "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\nchunklist.m3u8?jwt=abc\n")
+		case "/vod/eidi.mp4/chunklist.m3u8":
+			_, _ = fmt.Fprint(w, testMediaPlaylist)
+		case "/vod/empty.mp4/playlist.m3u8":
+			_, _ = fmt.Fprint(w, "#EXTM3U\n#EXT-X-STREAM-INF:BANDWIDTH=1000\n")
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	playlist, err := GetPlaylistWithoutSilences(context.Background(), srv.URL+"/vod/eidi.mp4/playlist.m3u8?jwt=abc", []model.Silence{{Start: 0, End: 20}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(playlist, "seg0") || strings.Contains(playlist, "seg1") {
+		t.Errorf("silent segments weren't removed:\n%s", playlist)
+	}
+	if !strings.Contains(playlist, srv.URL+"/vod/eidi.mp4/seg2.m4s?jwt=abc") {
+		t.Errorf("segment uri wasn't resolved against the media playlist:\n%s", playlist)
+	}
+
+	if _, err = GetPlaylistWithoutSilences(context.Background(), srv.URL+"/vod/empty.mp4/playlist.m3u8", nil); err != ErrNoMediaPlaylist {
+		t.Errorf("expected ErrNoMediaPlaylist, got %v", err)
+	}
+	if _, err = GetPlaylistWithoutSilences(context.Background(), srv.URL+"/vod/missing.mp4/playlist.m3u8", nil); err == nil {
+		t.Error("expected error for missing playlist")
+	}
+}
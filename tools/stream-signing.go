@@ -17,7 +17,7 @@ type JWTPlaylistClaims struct {
 	CourseID string
 }
 
-// SetSignedPlaylists adds a signed jwt to all available playlist urls that indicates that the
+// SetSignedPlaylists adds a signed jwt to all available playlist urls (HLS and DASH) that indicates that the
 // user is allowed to consume the playlist. The method assumes that the user has been pre-authorized and doesn't
 // check for permissions.
 func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool) error {
@@ -31,6 +31,21 @@ func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool
 	if s.PlaylistUrlPRES != "" {
 		playlists = append(playlists, struct{ Type, Playlist string }{Type: "PRES", Playlist: s.PlaylistUrlPRES})
 	}
+	if s.PlaylistUrlPIP != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "PIP", Playlist: s.PlaylistUrlPIP})
+	}
+	if s.DashUrl != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DASH", Playlist: s.DashUrl})
+	}
+	if s.DashUrlCAM != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DASH_CAM", Playlist: s.DashUrlCAM})
+	}
+	if s.DashUrlPRES != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DASH_PRES", Playlist: s.DashUrlPRES})
+	}
+	if s.DashUrlPIP != "" {
+		playlists = append(playlists, struct{ Type, Playlist string }{Type: "DASH_PIP", Playlist: s.DashUrlPIP})
+	}
 
 	for _, playlist := range playlists {
 		if strings.Contains(playlist.Playlist, "lrz.de") { // todo: remove after migration from lrz services
@@ -66,6 +81,16 @@ func SetSignedPlaylists(s *model.Stream, user *model.User, allowDownloading bool
 			s.PlaylistUrlPRES += "?jwt=" + str
 		case "COMB":
 			s.PlaylistUrl += "?jwt=" + str
+		case "PIP":
+			s.PlaylistUrlPIP += "?jwt=" + str
+		case "DASH_CAM":
+			s.DashUrlCAM += "?jwt=" + str
+		case "DASH_PRES":
+			s.DashUrlPRES += "?jwt=" + str
+		case "DASH_PIP":
+			s.DashUrlPIP += "?jwt=" + str
+		case "DASH":
+			s.DashUrl += "?jwt=" + str
 		}
 	}
 	return nil
@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"strings"
+	"testing"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/joschahenningsen/TUM-Live/model"
+)
+
+func TestSetSignedPlaylistsDash(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtKey = key
+	s := model.Stream{
+		PlaylistUrl: "https://edge.example.com/vod/eidi.mp4/playlist.m3u8",
+		DashUrl:     "https://edge.example.com/vod/eidi.mp4/manifest.mpd",
+		DashUrlCAM:  "https://edge.example.com/vod/eidiCAM.mp4/manifest.mpd",
+
+		PlaylistUrlPIP: "https://edge.example.com/vod/eidiPIP.mp4/playlist.m3u8",
+		DashUrlPIP:     "https://edge.example.com/vod/eidiPIP.mp4/manifest.mpd",
+	}
+	if err = SetSignedPlaylists(&s, nil, false); err != nil {
+		t.Fatal(err)
+	}
+	for _, url := range []string{s.PlaylistUrl, s.DashUrl, s.DashUrlCAM, s.PlaylistUrlPIP, s.DashUrlPIP} {
+		parts := strings.SplitN(url, "?jwt=", 2)
+		if len(parts) != 2 {
+			t.Fatalf("url not signed: %s", url)
+		}
+		claims := &JWTPlaylistClaims{}
+		_, err = jwt.ParseWithClaims(parts[1], claims, func(token *jwt.Token) (interface{}, error) {
+			return &key.PublicKey, nil
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if claims.Playlist != parts[0] {
+			t.Fatalf("claimed playlist %s doesn't match %s", claims.Playlist, parts[0])
+		}
+	}
+	if s.DashUrlPRES != "" {
+		t.Fatalf("empty url should stay empty: %s", s.DashUrlPRES)
+	}
+}
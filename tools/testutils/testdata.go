@@ -435,7 +435,7 @@ func GetUploadKeyMock(t *testing.T) dao.UploadKeyDao {
 	streamsMock := mock_dao.NewMockUploadKeyDao(gomock.NewController(t))
 	streamsMock.
 		EXPECT().
-		CreateUploadKey(gomock.Any(), gomock.Any()).
+		CreateUploadKey(gomock.Any()).
 		Return(nil)
 	return streamsMock
 }
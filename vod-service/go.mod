@@ -1,3 +1,7 @@
 module github.com/joschahenningsen/TUM-Live/vod-service
 
 go 1.19
+
+require github.com/sirupsen/logrus v1.9.0
+
+require golang.org/x/sys v0.0.0-20220715151400-c0bba94af5f8 // indirect
@@ -0,0 +1,130 @@
+package internal
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+var (
+	errQueueFull            = errors.New("too many queued jobs")
+	errInvalidSegmentLength = errors.New("invalid segment length")
+)
+
+// finishedJobRetention is the time the status of finished jobs is kept
+const finishedJobRetention = time.Hour * 24
+
+type jobStatus string
+
+const (
+	statusQueued    jobStatus = "queued"
+	statusPackaging jobStatus = "packaging"
+	statusDone      jobStatus = "done"
+	statusFailed    jobStatus = "failed"
+)
+
+type packagingOptions struct {
+	SegmentLength int  `json:"segmentLength"` // seconds
+	Dash          bool `json:"dash"`
+}
+
+// job is the packaging of an uploaded file
+type job struct {
+	ID         string           `json:"id"`
+	Name       string           `json:"name"` // name of the vod directory
+	Status     jobStatus        `json:"status"`
+	Error      string           `json:"error,omitempty"`
+	Options    packagingOptions `json:"options"`
+	CreatedAt  time.Time        `json:"createdAt"`
+	StartedAt  *time.Time       `json:"startedAt,omitempty"`
+	FinishedAt *time.Time       `json:"finishedAt,omitempty"`
+
+	file string // uploaded file
+}
+
+// jobStore keeps track of the status of all jobs
+type jobStore struct {
+	mutex sync.Mutex
+	jobs  map[string]*job
+}
+
+func newJobStore() *jobStore {
+	return &jobStore{jobs: map[string]*job{}}
+}
+
+func (s *jobStore) create(name string, file string, opts packagingOptions) *job {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.prune()
+	j := &job{ID: newJobID(), Name: name, Status: statusQueued, Options: opts, CreatedAt: time.Now(), file: file}
+	s.jobs[j.ID] = j
+	return j
+}
+
+func (s *jobStore) start(id string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if j, ok := s.jobs[id]; ok {
+		now := time.Now()
+		j.Status = statusPackaging
+		j.StartedAt = &now
+	}
+}
+
+func (s *jobStore) finish(id string, err error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if j, ok := s.jobs[id]; ok {
+		now := time.Now()
+		j.FinishedAt = &now
+		j.Status = statusDone
+		if err != nil {
+			j.Status = statusFailed
+			j.Error = err.Error()
+		}
+	}
+}
+
+// get returns a copy of the job or nil if it doesn't exist
+func (s *jobStore) get(id string) *job {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	j, ok := s.jobs[id]
+	if !ok {
+		return nil
+	}
+	c := *j
+	return &c
+}
+
+// list returns copies of all jobs, the newest first
+func (s *jobStore) list() []job {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	jobs := make([]job, 0, len(s.jobs))
+	for _, j := range s.jobs {
+		jobs = append(jobs, *j)
+	}
+	sort.Slice(jobs, func(i, k int) bool {
+		return jobs[i].CreatedAt.After(jobs[k].CreatedAt)
+	})
+	return jobs
+}
+
+// prune removes jobs that finished more than finishedJobRetention ago. The caller must hold the lock.
+func (s *jobStore) prune() {
+	for id, j := range s.jobs {
+		if j.FinishedAt != nil && time.Since(*j.FinishedAt) > finishedJobRetention {
+			delete(s.jobs, id)
+		}
+	}
+}
+
+func newJobID() string {
+	b := make([]byte, 8)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
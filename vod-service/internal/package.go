@@ -0,0 +1,116 @@
+package internal
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	log "github.com/sirupsen/logrus"
+)
+
+// packageFile packages the upload of j into outputDir/<name>/ (playlist.m3u8 and, if enabled, manifest.mpd).
+// With DASH enabled, HLS and DASH share the same CMAF segments.
+// The vod is packaged into a temporary directory that replaces an existing vod of the same name once packaging
+// succeeded, so the edge server never serves incomplete packages.
+func (a *App) packageFile(j *job) error {
+	defer func() {
+		if err := os.Remove(j.file); err != nil {
+			log.WithError(err).WithField("job", j.ID).Warn("Can't clean up upload")
+		}
+	}()
+	tmpDir := filepath.Join(a.config.outputDir, "."+j.Name+".tmp-"+j.ID)
+	if err := os.MkdirAll(tmpDir, 0755); err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir) // no-op after the rename succeeded
+
+	if j.Options.Dash {
+		if err := runFFmpeg(j, cmafArgs(j.file, tmpDir, j.Options.SegmentLength)); err != nil {
+			return fmt.Errorf("package cmaf: %w", err)
+		}
+	} else if err := runFFmpeg(j, hlsArgs(j.file, tmpDir, j.Options.SegmentLength)); err != nil {
+		return fmt.Errorf("package hls: %w", err)
+	}
+
+	return a.publish(tmpDir, filepath.Join(a.config.outputDir, j.Name))
+}
+
+// publish replaces outDir with the package in tmpDir. An existing package is moved out of the way and deleted after
+// the new one is in place. Publishing is serialized, so concurrent jobs of the same name can't interleave the renames.
+func (a *App) publish(tmpDir, outDir string) error {
+	oldDir := tmpDir + ".old"
+	a.publishMutex.Lock()
+	replaced := true
+	if err := os.Rename(outDir, oldDir); os.IsNotExist(err) {
+		replaced = false
+	} else if err != nil {
+		a.publishMutex.Unlock()
+		return err
+	}
+	if err := os.Rename(tmpDir, outDir); err != nil {
+		if replaced {
+			_ = os.Rename(oldDir, outDir) // keep serving the previous package
+		}
+		a.publishMutex.Unlock()
+		return err
+	}
+	a.publishMutex.Unlock()
+
+	if replaced {
+		if err := os.RemoveAll(oldDir); err != nil {
+			log.WithError(err).WithField("dir", oldDir).Warn("Can't remove replaced package")
+		}
+	}
+	return nil
+}
+
+func hlsArgs(file, dir string, segmentLength int) []string {
+	return []string{
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-i", file,
+		"-c", "copy",
+		"-f", "hls",
+		"-hls_time", strconv.Itoa(segmentLength),
+		"-hls_playlist_type", "vod",
+		"-hls_flags", "independent_segments",
+		"-hls_segment_type", "mpegts",
+		"-hls_segment_filename", filepath.Join(dir, "segment%04d.ts"),
+		filepath.Join(dir, "playlist.m3u8"),
+	}
+}
+
+// cmafArgs packages fragmented mp4 (CMAF) segments with a DASH manifest (manifest.mpd) and a HLS playlist
+// (playlist.m3u8) that both reference the same segments.
+func cmafArgs(file, dir string, segmentLength int) []string {
+	return []string{
+		"-hide_banner", "-loglevel", "error", "-y",
+		"-i", file,
+		"-map", "0",
+		"-c", "copy",
+		"-f", "dash",
+		"-seg_duration", strconv.Itoa(segmentLength),
+		"-use_template", "1",
+		"-use_timeline", "1",
+		"-init_seg_name", "init_$RepresentationID$.m4s",
+		"-media_seg_name", "chunk_$RepresentationID$_$Number%05d$.m4s",
+		"-hls_playlist", "1",
+		"-hls_master_name", "playlist.m3u8",
+		filepath.Join(dir, "manifest.mpd"),
+	}
+}
+
+// runFFmpeg runs ffmpeg with args and returns its error output if it fails
+func runFFmpeg(j *job, args []string) error {
+	log.WithFields(log.Fields{"job": j.ID, "args": strings.Join(args, " ")}).Debug("Running ffmpeg")
+	var stderr bytes.Buffer
+	c := exec.Command("ffmpeg", args...)
+	c.Stderr = &stderr
+	if err := c.Run(); err != nil {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
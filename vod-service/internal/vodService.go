@@ -1,117 +1,248 @@
 package internal
 
 import (
-	"fmt"
+	"crypto/subtle"
+	"encoding/json"
 	"io"
-	"log"
 	"net/http"
 	"os"
-	"os/exec"
+	"path/filepath"
 	"regexp"
+	"strconv"
 	"strings"
+	"sync"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	defaultPort          = "8089"
+	defaultSegmentLength = 8
+	defaultWorkers       = 2
+	maxSegmentLength     = 60
 )
 
 type config struct {
-	outputDir string
+	outputDir     string // packaged vods are stored in outputDir/<name>/, the directory layout the edge server serves
+	tempDir       string // uploads are stored here until they are packaged
+	port          string
+	token         string // all requests require this bearer token, they are refused if it isn't set
+	segmentLength int    // default segment length in seconds
+	dash          bool   // whether to package DASH in addition to HLS by default
+	workers       int    // number of files packaged concurrently
 }
 
 type App struct {
-	config config
+	config       config
+	jobs         *jobStore
+	queue        chan *job
+	publishMutex sync.Mutex // serializes replacing packaged vods
 }
 
 func NewApp() *App {
+	log.SetFormatter(&log.JSONFormatter{})
 	outputDir := os.Getenv("OUTPUT_DIR")
 	if outputDir == "" {
 		log.Fatal("OUTPUT_DIR environment variable not set.")
 	}
-	if !strings.HasSuffix(outputDir, "/") {
-		outputDir += "/"
+	token := os.Getenv("UPLOAD_TOKEN")
+	if token == "" {
+		log.Fatal("UPLOAD_TOKEN environment variable not set.")
+	}
+	c := config{
+		outputDir:     outputDir,
+		tempDir:       os.Getenv("TEMP_DIR"),
+		port:          os.Getenv("PORT"),
+		token:         token,
+		segmentLength: defaultSegmentLength,
+		dash:          os.Getenv("DASH") == "true",
+		workers:       defaultWorkers,
+	}
+	if c.tempDir == "" {
+		c.tempDir = os.TempDir()
+	}
+	if c.port == "" {
+		c.port = defaultPort
+	}
+	if s, err := strconv.Atoi(os.Getenv("SEGMENT_LENGTH")); err == nil && s > 0 && s <= maxSegmentLength {
+		c.segmentLength = s
+	}
+	if w, err := strconv.Atoi(os.Getenv("WORKERS")); err == nil && w > 0 {
+		c.workers = w
 	}
-	return &App{config: config{outputDir: outputDir}}
+	return newApp(c)
+}
+
+func newApp(c config) *App {
+	return &App{config: c, jobs: newJobStore(), queue: make(chan *job, 100)}
 }
 
 func (a *App) Run() {
-	http.HandleFunc("/", a.uploadHandler)
-	err := http.ListenAndServe(":8089", nil)
+	for i := 0; i < a.config.workers; i++ {
+		go a.packageJobs()
+	}
+	log.WithField("port", a.config.port).Info("Serving vod-service")
+	err := http.ListenAndServe(":"+a.config.port, a.routes())
 	if err != nil {
-		fmt.Println(err)
+		log.WithError(err).Fatal("Can't serve")
 	}
 }
 
+func (a *App) routes() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/", a.authenticated(a.uploadHandler))
+	mux.HandleFunc("/jobs", a.authenticated(a.jobsHandler))
+	mux.HandleFunc("/jobs/", a.authenticated(a.jobHandler))
+	return mux
+}
+
+// authenticated requires the bearer token of the config. Without a configured token, all requests are refused.
+func (a *App) authenticated(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		header := r.Header.Get("Authorization")
+		if a.config.token == "" || !strings.HasPrefix(header, "Bearer ") ||
+			subtle.ConstantTimeCompare([]byte(strings.TrimPrefix(header, "Bearer ")), []byte(a.config.token)) != 1 {
+			log.WithField("remote", r.RemoteAddr).Warn("Unauthenticated request")
+			writeError(w, http.StatusUnauthorized, "invalid token")
+			return
+		}
+		next(w, r)
+	}
+}
+
+// uploadHandler accepts a multipart upload with the file in the field "filename", compatible with the LRZ upload form.
+// The optional query parameters segmentLength (seconds) and dash (true/false) override the defaults of the config.
 func (a *App) uploadHandler(w http.ResponseWriter, r *http.Request) {
-	fmt.Println("got upload request")
-	err := r.ParseMultipartForm(10 << 20)
+	if r.URL.Path != "/" {
+		writeError(w, http.StatusNotFound, "not found")
+		return
+	}
+	if r.Method != http.MethodPost {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	opts, err := a.packagingOptions(r)
 	if err != nil {
-		fmt.Println(err)
+		writeError(w, http.StatusBadRequest, err.Error())
 		return
 	}
 	file, handler, err := r.FormFile("filename")
 	if err != nil {
-		fmt.Println("Error Retrieving the File")
-		fmt.Println(err)
+		log.WithError(err).Warn("Can't read upload")
+		writeError(w, http.StatusBadRequest, "missing file")
 		return
 	}
 	defer file.Close()
-	fmt.Printf("Uploaded File: %+v\n", handler.Filename)
-	fmt.Printf("File Size: %+v\n", handler.Size)
-	fmt.Printf("MIME Header: %+v\n", handler.Header)
+	name := sanitizeName(handler.Filename)
+	if name == "" {
+		writeError(w, http.StatusBadRequest, "invalid filename")
+		return
+	}
 
-	// Create a temporary file within our temp-images directory that follows
-	// a particular naming pattern
-	tempFile, err := os.CreateTemp(os.TempDir(), "upload-*"+handler.Filename)
+	tempFile, err := os.CreateTemp(a.config.tempDir, "upload-*"+filepath.Ext(name))
 	if err != nil {
-		fmt.Println(err)
+		log.WithError(err).Error("Can't create temp file")
+		writeError(w, http.StatusInternalServerError, "can't store upload")
+		return
 	}
-
-	defer tempFile.Close()
-
 	_, err = io.Copy(tempFile, file)
+	if closeErr := tempFile.Close(); err == nil {
+		err = closeErr
+	}
 	if err != nil {
-		log.Println(err)
+		_ = os.Remove(tempFile.Name())
+		log.WithError(err).Error("Can't store upload")
+		writeError(w, http.StatusInternalServerError, "can't store upload")
 		return
 	}
-	// write this byte array to our temporary file
-	// return that we have successfully uploaded our file!
-	fmt.Fprintf(w, "Successfully Uploaded File to %s\n", tempFile.Name())
-	go a.packageFile(tempFile.Name(), handler.Filename)
-}
 
-var fileNameIllegal = regexp.MustCompile(`[^a-zA-Z0-9_\\.]+`)
+	j := a.jobs.create(name, tempFile.Name(), opts)
+	log.WithFields(log.Fields{"job": j.ID, "name": name, "size": handler.Size, "segmentLength": opts.SegmentLength, "dash": opts.Dash}).
+		Info("Upload received")
+	select {
+	case a.queue <- j:
+	default:
+		a.jobs.finish(j.ID, errQueueFull)
+		_ = os.Remove(tempFile.Name())
+		writeError(w, http.StatusServiceUnavailable, errQueueFull.Error())
+		return
+	}
+	writeJSON(w, http.StatusOK, a.jobs.get(j.ID))
+}
 
-func (a *App) packageFile(file, name string) {
-	defer func() {
-		err := os.Remove(file)
-		if err != nil {
-			log.Printf("Error cleaning up file: %v", err)
+// packagingOptions returns the packaging options of the request
+func (a *App) packagingOptions(r *http.Request) (packagingOptions, error) {
+	opts := packagingOptions{SegmentLength: a.config.segmentLength, Dash: a.config.dash}
+	if s := r.URL.Query().Get("segmentLength"); s != "" {
+		segmentLength, err := strconv.Atoi(s)
+		if err != nil || segmentLength <= 0 || segmentLength > maxSegmentLength {
+			return opts, errInvalidSegmentLength
 		}
-	}()
-	name = fileNameIllegal.ReplaceAllString(name, "_")
-	// override eventually existing files
-	err := os.RemoveAll(a.config.outputDir + name)
-	if err != nil {
-		log.Println(err)
-		// try to continue anyway
+		opts.SegmentLength = segmentLength
 	}
-	err = os.MkdirAll(a.config.outputDir+name, os.ModePerm)
-	if err != nil {
-		log.Println(err)
+	if d := r.URL.Query().Get("dash"); d != "" {
+		opts.Dash = d == "true" || d == "1"
+	}
+	return opts, nil
+}
+
+// jobsHandler lists all jobs
+func (a *App) jobsHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
 		return
 	}
-	c := exec.Command("ffmpeg",
-		strings.Split(
-			"-i "+file+
-				" -c copy "+
-				"-f hls "+
-				"-hls_time 8 "+
-				"-hls_playlist_type vod "+
-				"-hls_flags independent_segments "+
-				"-hls_segment_type mpegts "+
-				"-hls_segment_filename "+a.config.outputDir+name+"/"+"segment%04d.ts "+
-				a.config.outputDir+name+"/"+"playlist.m3u8", " ")...)
-	c.Stdout = os.Stdout
-	c.Stderr = os.Stderr
-	err = c.Run()
-	if err != nil {
-		fmt.Println(err)
+	writeJSON(w, http.StatusOK, a.jobs.list())
+}
+
+// jobHandler returns the status of the job /jobs/<id>
+func (a *App) jobHandler(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+	j := a.jobs.get(strings.TrimPrefix(r.URL.Path, "/jobs/"))
+	if j == nil {
+		writeError(w, http.StatusNotFound, "job not found")
+		return
 	}
+	writeJSON(w, http.StatusOK, j)
+}
+
+// packageJobs packages queued jobs until the queue is closed
+func (a *App) packageJobs() {
+	for j := range a.queue {
+		a.jobs.start(j.ID)
+		start := time.Now()
+		err := a.packageFile(j)
+		a.jobs.finish(j.ID, err)
+		logger := log.WithFields(log.Fields{"job": j.ID, "name": j.Name, "duration": time.Since(start).String()})
+		if err != nil {
+			logger.WithError(err).Error("Packaging failed")
+		} else {
+			logger.Info("Packaging finished")
+		}
+	}
+}
+
+var fileNameIllegal = regexp.MustCompile(`[^a-zA-Z0-9_\\.]+`)
+
+// sanitizeName returns the name of the vod directory for an uploaded file, e.g. eidi_2021_09_23_10_00COMB.mp4
+func sanitizeName(filename string) string {
+	name := fileNameIllegal.ReplaceAllString(filepath.Base(filename), "_")
+	if strings.Trim(name, ".") == "" {
+		return ""
+	}
+	return name
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]string{"error": msg})
 }
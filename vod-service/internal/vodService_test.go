@@ -0,0 +1,166 @@
+package internal
+
+import (
+	"bytes"
+	"encoding/json"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"testing"
+)
+
+func newTestApp(t *testing.T) *App {
+	return newApp(config{outputDir: t.TempDir(), tempDir: t.TempDir(), token: "secret", segmentLength: 8})
+}
+
+func uploadRequest(t *testing.T, url string, token string) *http.Request {
+	var body bytes.Buffer
+	w := multipart.NewWriter(&body)
+	f, err := w.CreateFormFile("filename", "/mass/eidi-2021-09-23-08-00COMB.mp4")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, _ = f.Write([]byte("video"))
+	_ = w.WriteField("benutzer", "user")
+	_ = w.Close()
+	r := httptest.NewRequest(http.MethodPost, url, &body)
+	r.Header.Set("Content-Type", w.FormDataContentType())
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	return r
+}
+
+func TestUploadAuth(t *testing.T) {
+	a := newTestApp(t)
+	for _, token := range []string{"", "wrong"} {
+		w := httptest.NewRecorder()
+		a.routes().ServeHTTP(w, uploadRequest(t, "/", token))
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for token %q, got %d", token, w.Code)
+		}
+	}
+	w := httptest.NewRecorder()
+	a.routes().ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/jobs", nil))
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for status api, got %d", w.Code)
+	}
+	// the token must be sent as bearer token
+	r := uploadRequest(t, "/", "")
+	r.Header.Set("Authorization", "secret")
+	w = httptest.NewRecorder()
+	a.routes().ServeHTTP(w, r)
+	if w.Code != http.StatusUnauthorized {
+		t.Fatalf("expected 401 for token without Bearer prefix, got %d", w.Code)
+	}
+}
+
+func TestUploadAuthWithoutToken(t *testing.T) {
+	a := newApp(config{outputDir: t.TempDir(), tempDir: t.TempDir(), segmentLength: 8})
+	for _, token := range []string{"", "secret"} {
+		w := httptest.NewRecorder()
+		a.routes().ServeHTTP(w, uploadRequest(t, "/", token))
+		if w.Code != http.StatusUnauthorized {
+			t.Fatalf("expected 401 for token %q without configured token, got %d", token, w.Code)
+		}
+	}
+}
+
+func TestUploadCreatesJob(t *testing.T) {
+	a := newTestApp(t)
+	w := httptest.NewRecorder()
+	a.routes().ServeHTTP(w, uploadRequest(t, "/?segmentLength=4&dash=true", "secret"))
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d: %s", w.Code, w.Body.String())
+	}
+	var created job
+	if err := json.NewDecoder(w.Body).Decode(&created); err != nil {
+		t.Fatal(err)
+	}
+	if created.Name != "eidi_2021_09_23_08_00COMB.mp4" || created.Status != statusQueued ||
+		created.Options.SegmentLength != 4 || !created.Options.Dash {
+		t.Fatalf("unexpected job: %+v", created)
+	}
+
+	r := httptest.NewRequest(http.MethodGet, "/jobs/"+created.ID, nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	a.routes().ServeHTTP(w, r)
+	if w.Code != http.StatusOK {
+		t.Fatalf("unexpected status %d", w.Code)
+	}
+
+	r = httptest.NewRequest(http.MethodGet, "/jobs/unknown", nil)
+	r.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	a.routes().ServeHTTP(w, r)
+	if w.Code != http.StatusNotFound {
+		t.Fatalf("expected 404, got %d", w.Code)
+	}
+}
+
+func TestUploadInvalidSegmentLength(t *testing.T) {
+	a := newTestApp(t)
+	for _, s := range []string{"abc", "0", "1000"} {
+		w := httptest.NewRecorder()
+		a.routes().ServeHTTP(w, uploadRequest(t, "/?segmentLength="+s, "secret"))
+		if w.Code != http.StatusBadRequest {
+			t.Fatalf("expected 400 for segment length %s, got %d", s, w.Code)
+		}
+	}
+}
+
+func TestSanitizeName(t *testing.T) {
+	tests := map[string]string{
+		"/mass/eidi-2021-09-23-08-00COMB.mp4": "eidi_2021_09_23_08_00COMB.mp4",
+		"my video.mp4":                        "my_video.mp4",
+		"..":                                  "",
+	}
+	for in, want := range tests {
+		if got := sanitizeName(in); got != want {
+			t.Errorf("sanitizeName(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestPublishReplacesPackage(t *testing.T) {
+	a := newTestApp(t)
+	outDir := filepath.Join(a.config.outputDir, "vod")
+	publish := func(id string, content string) error {
+		tmpDir := filepath.Join(a.config.outputDir, ".vod.tmp-"+id)
+		if err := os.MkdirAll(tmpDir, 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(tmpDir, "playlist.m3u8"), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		return a.publish(tmpDir, outDir)
+	}
+	if err := publish("1", "first"); err != nil {
+		t.Fatalf("publish() error = %v", err)
+	}
+
+	// concurrent jobs of the same name
+	var wg sync.WaitGroup
+	for i := 2; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			if err := publish(strconv.Itoa(i), strconv.Itoa(i)); err != nil {
+				t.Errorf("publish() error = %v", err)
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	if data, err := os.ReadFile(filepath.Join(outDir, "playlist.m3u8")); err != nil || string(data) == "first" {
+		t.Errorf("playlist = %q, %v, want replaced package", data, err)
+	}
+	if entries, _ := os.ReadDir(a.config.outputDir); len(entries) != 1 {
+		t.Errorf("output dir has %d entries, want only the published package", len(entries))
+	}
+}
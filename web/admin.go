@@ -15,6 +15,8 @@ import (
 	"gorm.io/gorm"
 	"net/http"
 	"regexp"
+	"strconv"
+	"strings"
 )
 
 // AdminPage serves all administration pages. todo: refactor into multiple methods
@@ -285,6 +287,49 @@ func (r mainRoutes) UpdateCourse(c *gin.Context) {
 	tumLiveContext.Course.ModeratedChatEnabled = enChatMod
 	tumLiveContext.Course.LivePrivate = livePrivate
 	tumLiveContext.Course.VodPrivate = vodPrivate
+	pipSize, _ := strconv.Atoi(c.PostForm("pipSize"))
+	pipLayout := model.PipLayout{
+		Enabled:  c.PostForm("enPip") == "on",
+		Position: model.PipPosition(c.PostForm("pipPosition")),
+		Size:     uint(pipSize),
+	}
+	if !pipLayout.IsValid() {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "bad picture-in-picture layout"})
+		return
+	}
+	tumLiveContext.Course.SetPipLayout(pipLayout)
+	minSilence, err := strconv.Atoi(c.PostForm("minSilence"))
+	if err != nil || minSilence < model.MinSilenceDurationMin || minSilence > model.MinSilenceDurationMax {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "bad minimum pause duration"})
+		return
+	}
+	tumLiveContext.Course.MinSilenceDuration = uint(minSilence)
+	tumLiveContext.Course.NormalizeLoudness = c.PostForm("normalizeLoudness") == "on"
+	tumLiveContext.Course.Denoise = c.PostForm("denoise") == "on"
+	encodingProfile := strings.TrimSpace(c.PostForm("encodingProfile"))
+	if _, ok := tools.Cfg.GetEncodingProfile(encodingProfile); encodingProfile != "" && !ok {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "unknown encoding profile"})
+		return
+	}
+	tumLiveContext.Course.EncodingProfile = encodingProfile
+	retentionPolicy, err := strconv.Atoi(c.PostForm("retentionPolicy"))
+	if err != nil || !model.RetentionPolicy(retentionPolicy).IsValid() {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "bad retention policy"})
+		return
+	}
+	retentionPeriod, err := strconv.Atoi(c.PostForm("retentionPeriod"))
+	if err != nil || retentionPeriod < 0 || retentionPeriod > model.RetentionPeriodMax {
+		c.AbortWithStatusJSON(http.StatusBadRequest, gin.H{"msg": "bad retention period"})
+		return
+	}
+	if tumLiveContext.Course.RetentionPolicy != model.RetentionPolicy(retentionPolicy) {
+		// admins are notified again about deletions of the new policy
+		if err = r.RetentionDao.DeleteNotices(tumLiveContext.Course.ID); err != nil {
+			log.WithError(err).Error("Can't delete retention notices")
+		}
+	}
+	tumLiveContext.Course.RetentionPolicy = model.RetentionPolicy(retentionPolicy)
+	tumLiveContext.Course.RetentionPeriod = uint(retentionPeriod)
 	r.CoursesDao.UpdateCourseMetadata(context.Background(), *tumLiveContext.Course)
 	c.Redirect(http.StatusFound, fmt.Sprintf("/admin/course/%v", tumLiveContext.Course.ID))
 }
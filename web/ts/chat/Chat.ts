@@ -205,6 +205,15 @@ export class Chat {
         });
     }
 
+    // onResync reloads the chat if messages were missed while the connection was lost
+    onResync(e) {
+        const channel = `chat/${this.streamId}`;
+        if (e.detail.channel !== channel && e.detail.channel !== `${channel}/admin`) {
+            return;
+        }
+        Promise.all([this.loadMessages(), this.poll.load(), this.loadPollHistory()]);
+    }
+
     onMessage(e) {
         this.addMessage(e.detail);
     }
@@ -70,6 +70,14 @@ class TranscodingProgress {
     progress: number;
 }
 
+class PresetSwitch {
+    readonly id: number;
+    readonly presetID: number;
+    readonly offset: number; // minutes after the start of the lecture
+    readonly weekday?: number; // set for weekly switches
+    readonly timeOfDay: number; // minutes after midnight
+}
+
 export class Lecture {
     static dateFormatOptions: Intl.DateTimeFormatOptions = {
         weekday: "long",
@@ -114,6 +122,9 @@ export class Lecture {
     files: LectureFile[];
     private: boolean;
     downloadableVods: DownloadableVod[];
+    readonly loudness?: number; // integrated loudness of the recording in LUFS
+    readonly truePeak?: number; // maximum true peak of the recording in dBTP
+    presetSwitches: PresetSwitch[] = [];
 
     clone() {
         return Object.assign(Object.create(Object.getPrototypeOf(this)), this);
@@ -354,6 +365,46 @@ export class Lecture {
         return this.downloadableVods;
     }
 
+    async loadPresetSwitches() {
+        const res = await fetch(`/api/course/${this.courseId}/stream/${this.lectureId}/presetSwitches`);
+        if (res.status === StatusCodes.OK) {
+            this.presetSwitches = await res.json();
+        }
+    }
+
+    // addPresetSwitch schedules a switch to presetID either offset minutes after the start of the lectures of the
+    // series or, if weekday isn't empty, weekly at time ("HH:MM").
+    async addPresetSwitch(presetID: string, offset: string, weekday: string, time: string) {
+        const [hours, minutes] = time.split(":").map((v) => parseInt(v));
+        const res = await postData(`/api/course/${this.courseId}/stream/${this.lectureId}/presetSwitches`, {
+            presetID: parseInt(presetID),
+            offset: parseInt(offset),
+            weekday: weekday === "" ? null : parseInt(weekday),
+            timeOfDay: hours * 60 + minutes,
+        });
+        if (res.status !== StatusCodes.OK) {
+            this.lastErrors = ["Couldn't schedule the preset: " + (await res.text())];
+            return;
+        }
+        await this.loadPresetSwitches();
+    }
+
+    async deletePresetSwitch(id: number) {
+        const res = await Delete(`/api/course/${this.courseId}/stream/${this.lectureId}/presetSwitches/${id}`);
+        if (res.status === StatusCodes.OK) {
+            this.presetSwitches = this.presetSwitches.filter((s) => s.id !== id);
+        }
+    }
+
+    presetSwitchDescription(s: PresetSwitch): string {
+        if (s.weekday === null || s.weekday === undefined) {
+            return `${s.offset} min after the start`;
+        }
+        const weekday = ["Sundays", "Mondays", "Tuesdays", "Wednesdays", "Thursdays", "Fridays", "Saturdays"][s.weekday];
+        const time = `${Math.floor(s.timeOfDay / 60)}:${String(s.timeOfDay % 60).padStart(2, "0")}`;
+        return `${weekday} at ${time}`;
+    }
+
     async deleteFile(fileId: number) {
         await fetch(`/api/stream/${this.lectureId}/files/${fileId}`, {
             method: "DELETE",
@@ -509,6 +560,9 @@ export function showHideUnits(id: number) {
     }
 }
 
+const vodUploadChunkSize = 16 * 1024 * 1024;
+const vodUploadMaxRetries = 5;
+
 export function createLectureForm(args: { s: [] }) {
     return {
         formData: {
@@ -630,30 +684,87 @@ export function createLectureForm(args: { s: [] }) {
                     });
             }
         },
-        uploadVod() {
-            const xhr = new XMLHttpRequest();
-            const vodUploadFormData = new FormData();
-            vodUploadFormData.append("file", this.formData.file[0]);
-            xhr.onloadend = () => {
-                if (xhr.status === 200) {
-                    window.location.reload();
+        async uploadVod() {
+            const file: File = this.formData.file[0];
+            const uploadID = `vodupload-${this.courseID}-${file.name}-${file.size}-${file.lastModified}`;
+            const tusHeaders = { "Tus-Resumable": "1.0.0" };
+
+            // resume the upload if this file has been uploaded partially before
+            let location = localStorage.getItem(uploadID);
+            let offset = 0;
+            if (location !== null) {
+                const res = await fetch(location, { method: "HEAD", headers: tusHeaders });
+                if (res.ok) {
+                    offset = parseInt(res.headers.get("Upload-Offset"));
                 } else {
+                    location = null;
+                }
+            }
+            if (location === null) {
+                const res = await fetch(
+                    `/api/course/${this.courseID}/uploadVOD?start=${this.formData.start}&title=${this.formData.title}`,
+                    {
+                        method: "POST",
+                        headers: {
+                            ...tusHeaders,
+                            "Upload-Length": file.size.toString(),
+                            "Upload-Metadata": `filename ${btoa(unescape(encodeURIComponent(file.name)))}`,
+                        },
+                    },
+                );
+                if (res.status !== StatusCodes.CREATED) {
+                    this.loading = false;
                     this.error = true;
+                    return;
                 }
-            };
-            xhr.upload.onprogress = (e: ProgressEvent) => {
-                if (!e.lengthComputable) {
+                location = res.headers.get("Location");
+                localStorage.setItem(uploadID, location);
+            }
+
+            let retries = 0;
+            while (offset < file.size) {
+                const chunk = await file.slice(offset, offset + vodUploadChunkSize).arrayBuffer();
+                const digest = await crypto.subtle.digest("SHA-256", chunk);
+                const checksum = btoa(String.fromCharCode.apply(null, Array.from(new Uint8Array(digest))));
+                try {
+                    const res = await fetch(location, {
+                        method: "PATCH",
+                        headers: {
+                            ...tusHeaders,
+                            "Content-Type": "application/offset+octet-stream",
+                            "Upload-Offset": offset.toString(),
+                            "Upload-Checksum": `sha256 ${checksum}`,
+                        },
+                        body: chunk,
+                    });
+                    if (res.status === StatusCodes.NO_CONTENT || res.status === StatusCodes.CONFLICT) {
+                        offset = parseInt(res.headers.get("Upload-Offset"));
+                        retries = 0;
+                        window.dispatchEvent(
+                            new CustomEvent("voduploadprogress", { detail: Math.floor(100 * (offset / file.size)) }),
+                        );
+                        continue;
+                    }
+                } catch (e) {
+                    console.error(e);
+                }
+                // connection lost or chunk corrupted, try again later
+                if (++retries > vodUploadMaxRetries) {
+                    this.loading = false;
+                    this.error = true;
                     return;
                 }
-                window.dispatchEvent(
-                    new CustomEvent("voduploadprogress", { detail: Math.floor(100 * (e.loaded / e.total)) }),
-                );
-            };
-            xhr.open(
-                "POST",
-                `/api/course/${this.courseID}/uploadVOD?start=${this.formData.start}&title=${this.formData.title}`,
-            );
-            xhr.send(vodUploadFormData);
+                await new Promise((resolve) => setTimeout(resolve, 1000 * 2 ** retries));
+            }
+            localStorage.removeItem(uploadID);
+            window.location.reload();
+        },
+        pendingUploads: [],
+        async loadPendingUploads() {
+            const res = await fetch(`/api/course/${this.courseID}/uploads`);
+            if (res.ok) {
+                this.pendingUploads = await res.json();
+            }
         },
     };
 }
@@ -32,3 +32,29 @@ export function setDefaultPreset(lectureHallID: number, presetID: number): Promi
         return res.ok;
     });
 }
+
+export function switchLiveLight(lectureHallID: number, on: boolean): Promise<boolean> {
+    return fetch(`/api/lectureHall/${lectureHallID}/liveLight`, {
+        method: "POST",
+        headers: {
+            "Content-Type": "application/json",
+        },
+        body: JSON.stringify({ on: on }),
+    }).then(function (res) {
+        return res.ok;
+    });
+}
+
+export type SourceHealth = {
+    sourceType: string;
+    source: string;
+    reachable: boolean;
+    error: string;
+    checkedAt: string;
+};
+
+export function getLectureHallHealth(lectureHallID: number): Promise<SourceHealth[]> {
+    return fetch(`/api/lectureHall/${lectureHallID}/health`).then(function (res) {
+        return res.ok ? res.json() : [];
+    });
+}
@@ -18,8 +18,28 @@ interface maintenancePage {
     fetchTranscodingFailures(): void;
     transcodingFailures: { ID: number }[];
     deleteTranscodingFailure(id: number): void;
+
+    fetchArchival(): void;
+    archival: ArchivalSavings | null;
 }
 
+type ArchivalStats = {
+    codec: string;
+    state: number; // 1: pending, 2: done, 3: failed
+    count: number;
+    originalSize: number;
+    archiveSize: number;
+    fallbackSize: number;
+};
+
+type ArchivalSavings = {
+    enabled: boolean;
+    codec: string;
+    minAgeDays: number;
+    stats: ArchivalStats[] | null;
+    savedBytes: number;
+};
+
 export function maintenancePage(): maintenancePage {
     return {
         generateThumbnails() {
@@ -84,5 +104,11 @@ export function maintenancePage(): maintenancePage {
                 }
             });
         },
+        fetchArchival() {
+            fetch("/api/maintenance/archival")
+                .then((r) => r.json())
+                .then((r) => (this.archival = r));
+        },
+        archival: null,
     };
 }
@@ -3,16 +3,25 @@ const PAGE_LOADED = new Date();
 
 type MessageHandlerFn = (payload: object) => void;
 
+// Subscription keeps the state needed to resume a channel subscription after a reconnect.
+type Subscription = {
+    resumeToken: string;
+    lastSeq: number;
+};
+
 const RealtimeMessageTypes = {
     RealtimeMessageTypeSubscribe: "subscribe",
     RealtimeMessageTypeUnsubscribe: "unsubscribe",
     RealtimeMessageTypeChannelMessage: "message",
+    RealtimeMessageTypeSubscribed: "subscribed",
+    RealtimeMessageTypeAck: "ack",
 };
 
 export class Realtime {
     private debugging = false;
     private ws: WebSocket;
     private handler: object = {};
+    private subscriptions: { [channel: string]: Subscription } = {};
 
     // Singleton
     private static instance;
@@ -25,16 +34,17 @@ export class Realtime {
         return this.connect(WS_INITIAL_RETRY_DELAY);
     }
 
-    async send(channel: string, { payload = {}, type = RealtimeMessageTypes.RealtimeMessageTypeChannelMessage }) {
+    async send(channel: string, { payload = {}, type = RealtimeMessageTypes.RealtimeMessageTypeChannelMessage, seq = 0 }) {
         await this.lazyInit();
         await this.ws.send(
             JSON.stringify({
                 type: type,
                 channel: channel,
                 payload: payload,
+                seq: seq,
             }),
         );
-        this.debug("🔵 Send", { type, channel, payload });
+        this.debug("🔵 Send", { type, channel, payload, seq });
     }
 
     public async subscribeChannel(channel: string, handler?: MessageHandlerFn) {
@@ -51,6 +61,7 @@ export class Realtime {
         if (unregisterHandler) {
             delete this.handler[channel];
         }
+        delete this.subscriptions[channel];
         await this.send(channel, {
             type: RealtimeMessageTypes.RealtimeMessageTypeUnsubscribe,
         });
@@ -74,8 +85,26 @@ export class Realtime {
         return this.init();
     }
 
-    private handleMessage({ channel, payload }) {
-        this.debug("⚪️️ Received", { channel, payload });
+    private handleMessage({ type, channel, payload, seq }) {
+        this.debug("⚪️️ Received", { type, channel, payload, seq });
+        if (type === RealtimeMessageTypes.RealtimeMessageTypeSubscribed) {
+            const missed = this.subscriptions[channel] && !payload.resumed;
+            this.subscriptions[channel] = { resumeToken: payload.resumeToken, lastSeq: payload.seq };
+            if (missed) {
+                // missed messages couldn't be replayed, listeners reload the state of the channel instead
+                this.debug("Could not resume, reloading channel state", channel);
+                const event = new CustomEvent("wsrealtimeresync", { detail: { channel } });
+                window.dispatchEvent(event);
+            }
+            return;
+        }
+        if (seq && this.subscriptions[channel]) {
+            if (seq <= this.subscriptions[channel].lastSeq) {
+                return; // already received before the reconnect
+            }
+            this.subscriptions[channel].lastSeq = seq;
+            this.send(channel, { type: RealtimeMessageTypes.RealtimeMessageTypeAck, seq });
+        }
         if (this.handler[channel]) {
             for (const handler of this.handler[channel]) {
                 handler(payload);
@@ -96,10 +125,12 @@ export class Realtime {
     private async afterConnect(): Promise<void> {
         this.debug("connected");
 
-        // Re-Subscribe to all channels
+        // Re-Subscribe to all channels, resuming previous subscriptions to receive missed messages
         for (const channel of Object.keys(this.handler)) {
+            const subscription = this.subscriptions[channel];
             await this.send(channel, {
                 type: RealtimeMessageTypes.RealtimeMessageTypeSubscribe,
+                payload: subscription ? { resumeToken: subscription.resumeToken, lastSeq: subscription.lastSeq } : {},
             });
             this.debug("Re-Subscribed", channel);
         }
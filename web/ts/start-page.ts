@@ -1,8 +1,9 @@
 import { Realtime } from "./socket";
 
 export const liveUpdateListener = {
-    async init() {
-        await Realtime.get().subscribeChannel("live-update", this.handle);
+    // userId is 0 for users that aren't logged in
+    async init(userId: number) {
+        await Realtime.get().subscribeChannel(`live-update/${userId}`, this.handle);
     },
 
     handle(payload: object) {
@@ -15,6 +15,30 @@ export function usePreset(cID: number, lectureHallID: number, presetID: number)
     });
 }
 
+export function moveCamera(cID: number, lectureHallID: number, pan: number, tilt: number, zoom: number) {
+    const streamID = (document.getElementById("streamID") as HTMLInputElement).value;
+    postData(`/api/course/${cID}/moveCamera/${lectureHallID}/${streamID}`, { pan, tilt, zoom }).then((response) => {
+        if (response.status !== StatusCodes.OK) {
+            alert("Couldn't move the camera.");
+        }
+    });
+}
+
+export function saveCameraPreset(cID: number, lectureHallID: number) {
+    const name = prompt("Name of the new preset:");
+    if (!name) {
+        return;
+    }
+    const streamID = (document.getElementById("streamID") as HTMLInputElement).value;
+    postData(`/api/course/${cID}/saveCameraPreset/${lectureHallID}/${streamID}`, { name }).then((response) => {
+        if (response.status !== StatusCodes.OK) {
+            alert("Couldn't save the preset.");
+            return;
+        }
+        window.location.reload();
+    });
+}
+
 class Issue {
     readonly name: string;
     readonly phone: string;
@@ -44,3 +68,25 @@ export function sendIssue(
         return response.status === StatusCodes.OK;
     });
 }
+
+export type StreamHealth = {
+    sourceType: string;
+    bitrate: number;
+    fps: number;
+    speed: number;
+    frames: number;
+    droppedFrames: number;
+    duplicatedFrames: number;
+    restarts: number;
+    reportedAt: string;
+};
+
+// pollStreamHealth passes the latest health reports of the stream's sources to update every 30 seconds.
+export function pollStreamHealth(streamID: number, update: (health: StreamHealth[]) => void) {
+    const poll = () =>
+        fetch(`/api/stream/${streamID}/health`)
+            .then((res) => (res.ok ? res.json() : []))
+            .then(update);
+    poll();
+    setInterval(poll, 30000);
+}
@@ -9,6 +9,7 @@ let currentChatChannel = "";
 const retryInt = 5000; //retry connecting to websocket after this timeout
 
 const scrollDelay = 100; // delay before scrolling to bottom to make sure chat is rendered
+const liveSourceReloadJitter = 15000; // viewers reload within this time after the live source changed, spreading the load
 const pageloaded = new Date();
 
 enum WSMessageType {
@@ -77,7 +78,7 @@ export function initChatScrollListener() {
     });
 }
 
-export async function startWebsocket() {
+export async function startWebsocket(isAdminOfCourse = false) {
     const streamId = (document.getElementById("streamID") as HTMLInputElement).value;
     currentChatChannel = `chat/${streamId}`;
 
@@ -92,6 +93,11 @@ export async function startWebsocket() {
                 // stream end, show message
                 window.dispatchEvent(new CustomEvent("streamended"));
             }
+        } else if ("liveSource" in data) {
+            // the published source changed, e.g. the camera replaces a black presentation
+            if ((document.getElementById("followsLive") as HTMLInputElement)?.value === "true") {
+                setTimeout(() => window.location.reload(), Math.random() * liveSourceReloadJitter);
+            }
         } else if ("server" in data) {
             const scroll = shouldScroll();
             const serverElem = createServerMessage(data);
@@ -157,6 +163,10 @@ export async function startWebsocket() {
     //window.dispatchEvent(new CustomEvent("disconnected"));
 
     await Realtime.get().subscribeChannel(currentChatChannel, messageHandler);
+    if (isAdminOfCourse) {
+        // e.g. unapproved messages and poll votes are only sent to admins
+        await Realtime.get().subscribeChannel(`${currentChatChannel}/admin`, messageHandler);
+    }
     window.dispatchEvent(new CustomEvent("connected"));
 }
 
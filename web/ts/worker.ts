@@ -1,6 +1,46 @@
+import Chart from "chart.js/auto";
+import { postData } from "./global";
+
 /** Make DELETE call to /api/workers/:id with given worker-id */
 export async function deleteWorker(id: string) {
     return await fetch("/api/workers/" + id, {
         method: "DELETE",
     });
 }
+
+/** Put a worker into maintenance mode: it finishes its running jobs but doesn't get new ones until resumed */
+export async function drainWorker(id: string) {
+    const reason = prompt("Reason for draining the worker:", "maintenance");
+    if (reason === null) {
+        return;
+    }
+    return await postData(`/api/workers/${id}/drain`, { reason });
+}
+
+/** End the maintenance mode of a worker */
+export async function resumeWorker(id: string) {
+    return await postData(`/api/workers/${id}/resume`);
+}
+
+/** Fetch the heartbeats of the last 24 hours of a worker and render them as a chart into the canvas with id canvasId */
+export async function loadWorkerHistory(id: string, canvasId: string) {
+    const res = await fetch(`/api/workers/${id}/heartbeats?hours=24`);
+    if (!res.ok) {
+        return;
+    }
+    const heartbeats = await res.json();
+    const canvas = document.getElementById(canvasId) as HTMLCanvasElement;
+    new Chart(canvas.getContext("2d"), {
+        type: "line",
+        data: {
+            labels: heartbeats.map((h) => new Date(h.time).toLocaleTimeString()),
+            datasets: [
+                { label: "CPU %", data: heartbeats.map((h) => h.cpu), borderColor: "#3b82f6", pointRadius: 0 },
+                { label: "Memory %", data: heartbeats.map((h) => h.memory), borderColor: "#22c55e", pointRadius: 0 },
+                { label: "Disk %", data: heartbeats.map((h) => h.disk), borderColor: "#ef4444", pointRadius: 0 },
+                { label: "Workload", data: heartbeats.map((h) => h.workload), borderColor: "#a855f7", pointRadius: 0 },
+            ],
+        },
+        options: { animation: false, scales: { y: { beginAtZero: true } } },
+    });
+}
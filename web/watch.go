@@ -55,6 +55,7 @@ func (r mainRoutes) WatchPage(c *gin.Context) {
 			sentry.CaptureException(err)
 		} else {
 			data.Presets = lectureHall.CameraPresets
+			data.HasCamera = lectureHall.CameraIP != ""
 		}
 	}
 
@@ -67,6 +68,14 @@ func (r mainRoutes) WatchPage(c *gin.Context) {
 		}
 	}
 
+	if c.Param("version") == "" {
+		data.FollowsLive = true
+		// the presentation might have been replaced by the camera while it has no signal
+		if tumLiveContext.Stream.LiveNow && tumLiveContext.Stream.LiveSource != "" {
+			data.Version = tumLiveContext.Stream.LiveSource
+		}
+	}
+
 	if tumLiveContext.Stream.LectureHallID != 0 {
 		switch data.IndexData.TUMLiveContext.Course.GetSourceModeForLectureHall(data.IndexData.TUMLiveContext.Stream.LectureHallID) {
 		// SourceMode == 1 -> Override Version to PRES
@@ -74,11 +83,19 @@ func (r mainRoutes) WatchPage(c *gin.Context) {
 			data.Version = "PRES"
 			data.IndexData.TUMLiveContext.Stream.PlaylistUrlCAM = ""
 			data.IndexData.TUMLiveContext.Stream.PlaylistUrl = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrlCAM = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrl = ""
+			data.IndexData.TUMLiveContext.Stream.PlaylistUrlPIP = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrlPIP = ""
 		// SourceMode == 2 -> Override Version to CAM
 		case 2:
 			data.Version = "CAM"
 			data.IndexData.TUMLiveContext.Stream.PlaylistUrlPRES = ""
 			data.IndexData.TUMLiveContext.Stream.PlaylistUrl = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrlPRES = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrl = ""
+			data.IndexData.TUMLiveContext.Stream.PlaylistUrlPIP = ""
+			data.IndexData.TUMLiveContext.Stream.DashUrlPIP = ""
 		}
 	}
 
@@ -99,6 +116,7 @@ func (r mainRoutes) WatchPage(c *gin.Context) {
 		c.Redirect(http.StatusFound, strings.Split(c.Request.RequestURI, "?")[0])
 		return
 	}
+	data.SkipSilences = c.Query("skipSilences") == "1" && data.CanSkipSilences()
 	if _, dvr := c.GetQuery("dvr"); dvr {
 		data.DVR = "?dvr"
 	} else {
@@ -129,17 +147,27 @@ type WatchPageData struct {
 	IsHighlightPage bool
 	AlertsEnabled   bool // whether the alert config is set
 	Version         string
+	FollowsLive     bool // whether the viewer didn't choose a version and gets the source published while live
 	Unit            *model.StreamUnit
 	Presets         []model.CameraPreset
+	HasCamera       bool // whether the lecture hall of the stream has a ptz camera, only set for admins
 	Progress        model.StreamProgress
 	IndexData       IndexData
 	Description     template.HTML
 	CutOffLength    int    // The maximum length for the preview of a description.
 	DVR             string // ?dvr if dvr is enabled, empty string otherwise
+	SkipSilences    bool   // whether the recording is played without its silences
 	LectureHallName string
 	ChatData        ChatData
 }
 
+// CanSkipSilences returns whether the recording can be played without its silences. This is not possible for units
+// and cut recordings, as their playlists are cut by the edge server.
+func (d WatchPageData) CanSkipSilences() bool {
+	stream := d.IndexData.TUMLiveContext.Stream
+	return stream.Recording && len(stream.Silences) > 0 && d.Unit == nil && stream.StartOffset == 0 && d.Version != "SPLIT"
+}
+
 // Prepare populates the data for the watch page.
 func (d *WatchPageData) Prepare(c *gin.Context, lectureHallsDao dao.LectureHallsDao) error {
 	// todo prepare rest of data here as well
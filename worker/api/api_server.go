@@ -66,6 +66,28 @@ func (s server) RequestStreamEnd(ctx context.Context, request *pb.EndStreamReque
 	return &pb.Status{Ok: true}, nil
 }
 
+// RequestTakeover is a gRPC endpoint for the server to make a standby worker take over the ingest of a failed worker
+func (s server) RequestTakeover(ctx context.Context, request *pb.TakeoverRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to take over stream")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	if err := worker.HandleTakeoverRequest(request); err != nil {
+		return &pb.Status{Ok: false}, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+// StitchRecordings stitches the partial recording of a failed worker with the recording of the standby that took over
+func (s server) StitchRecordings(ctx context.Context, request *pb.StitchRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to stitch recordings")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	go worker.HandleStitchRequest(request)
+	return &pb.Status{Ok: true}, nil
+}
+
 func (s server) GenerateThumbnails(ctx context.Context, request *pb.GenerateThumbnailRequest) (*pb.Status, error) {
 	if request.WorkerID != cfg.WorkerID {
 		log.Info("Rejected request to generate thumbnails")
@@ -149,6 +171,71 @@ func (s server) CombineThumbnails(ctx context.Context, request *pb.CombineThumbn
 	return &pb.CombineThumbnailsResponse{FilePath: request.Path}, nil
 }
 
+// RenderPip renders the presentation with the camera as inset into an additional vod
+func (s server) RenderPip(ctx context.Context, request *pb.RenderPipRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to render picture-in-picture")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	go worker.HandleRenderPip(request)
+	return &pb.Status{Ok: true}, nil
+}
+
+// ProbeSources checks whether the sources of a lecture hall are reachable from this worker
+func (s server) ProbeSources(ctx context.Context, request *pb.ProbeSourcesRequest) (*pb.ProbeSourcesResponse, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to probe sources")
+		return nil, errors.New("unauthenticated: wrong worker id")
+	}
+	return worker.HandleProbeSources(request), nil
+}
+
+// RequestArchival re-encodes a vod to an efficient codec for long term storage
+func (s server) RequestArchival(ctx context.Context, request *pb.ArchivalRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to archive vod")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	go worker.HandleArchivalRequest(request)
+	return &pb.Status{Ok: true}, nil
+}
+
+// DeleteFiles removes recordings from the storage, e.g. because of the retention policy of their course
+func (s server) DeleteFiles(ctx context.Context, request *pb.DeleteFilesRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to delete files")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	if err := worker.DeleteFiles(request.GetPaths()); err != nil {
+		return &pb.Status{Ok: false}, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+// DeleteRawRecordings removes the raw recordings of a stream, e.g. because of the retention policy of its course
+func (s server) DeleteRawRecordings(ctx context.Context, request *pb.DeleteRawRecordingsRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to delete raw recordings")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	if err := worker.DeleteRawRecordings(request.GetCourseSlug(), request.GetStart().AsTime().Local()); err != nil {
+		return &pb.Status{Ok: false}, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
+// DeleteVods removes the vods of a stream from the vod storage, e.g. because of the retention policy of its course
+func (s server) DeleteVods(ctx context.Context, request *pb.DeleteVodsRequest) (*pb.Status, error) {
+	if request.WorkerID != cfg.WorkerID {
+		log.Info("Rejected request to delete vods")
+		return &pb.Status{Ok: false}, errors.New("unauthenticated: wrong worker id")
+	}
+	if err := worker.DeleteVods(request.GetCourseSlug(), request.GetStart().AsTime().Local()); err != nil {
+		return &pb.Status{Ok: false}, err
+	}
+	return &pb.Status{Ok: true}, nil
+}
+
 // InitApi Initializes api endpoints
 // addr: port to run on, e.g. ":8080"
 func InitApi(addr string) {
@@ -19,12 +19,29 @@ var (
 	LrzSubDir      string
 	MainBase       string
 	LrzUploadUrl   string
+	LrzUploadToken string // optional bearer token for the upload, e.g. for the vod-service
 	VodURLTemplate string
 	LogDir         string
 	Hostname       string
 	Token          string // setup token. Used to connect initially and to get a "WorkerID"
 	PersistDir     string // PersistDir is the directory, tum-live-worker will use to store persistent data
 	LogLevel       = log.InfoLevel
+
+	VodStorage  string // VodStorage is the backend transcoded recordings are uploaded to, one of VodStorageLrz, VodStorageLocal or VodStorageS3
+	LocalVodDir string // LocalVodDir is the directory recordings are copied to by the local storage
+	S3Endpoint  string // eg. https://s3.example.com
+	S3Bucket    string
+	S3Region    string
+	S3AccessKey string
+	S3SecretKey string
+
+	DashURLTemplate string // optional, e.g. https://edge.example.com/vod/%s.mp4/manifest.mpd if the vod storage packages DASH
+)
+
+const (
+	VodStorageLrz   = "lrz"
+	VodStorageLocal = "local"
+	VodStorageS3    = "s3"
 )
 
 // SetConfig sets the values of the parameter config and stops the execution
@@ -45,8 +62,25 @@ func SetConfig() {
 	LrzPhone = os.Getenv("LrzPhone")
 	LrzSubDir = os.Getenv("LrzSubDir")
 	LrzUploadUrl = os.Getenv("LrzUploadUrl")
+	LrzUploadToken = os.Getenv("LrzUploadToken")
 	MainBase = os.Getenv("MainBase")             // eg. live.mm.rbg.tum.de
 	VodURLTemplate = os.Getenv("VodURLTemplate") // eg. https://stream.lrz.de/vod/_definst_/mp4:tum/RBG/%s.mp4/playlist.m3u8
+	DashURLTemplate = os.Getenv("DashURLTemplate")
+
+	// vod storage, defaults to the lrz upload
+	VodStorage = os.Getenv("VodStorage")
+	if VodStorage == "" {
+		VodStorage = VodStorageLrz
+	}
+	LocalVodDir = os.Getenv("LocalVodDir")
+	S3Endpoint = os.Getenv("S3Endpoint")
+	S3Bucket = os.Getenv("S3Bucket")
+	S3Region = os.Getenv("S3Region")
+	if S3Region == "" {
+		S3Region = "us-east-1"
+	}
+	S3AccessKey = os.Getenv("S3AccessKey")
+	S3SecretKey = os.Getenv("S3SecretKey")
 
 	// logging
 	LogDir = os.Getenv("LogDir")
@@ -33,7 +33,7 @@ var (
 	inflightLock = sync.Mutex{}
 	inflight     = make(map[string]*sync.Mutex)
 
-	allowedRe = regexp.MustCompile(`^/[a-zA-Z0-9]+/([a-zA-Z0-9_]+/)*[a-zA-Z0-9_]+\.(ts|m3u8)$`) // e.g. /vm123/live/stream/1234.ts
+	allowedRe = regexp.MustCompile(`^/[a-zA-Z0-9]+/([a-zA-Z0-9_]+/)*[a-zA-Z0-9_]+\.(ts|m3u8|mpd|m4s)$`) // e.g. /vm123/live/stream/1234.ts
 	//allowedRe = regexp.MustCompile("^.*$") // e.g. /vm123/live/strean/1234.ts
 )
 
@@ -216,8 +216,8 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 		if claims.UserID != 0 {
 			uid = fmt.Sprintf("%d", claims.UserID)
 		}
-		// add the jwt to all .ts files in the playlist for subsequent verification
-		if strings.HasSuffix(r.URL.Path, ".m3u8") {
+		// add the jwt to all files referenced by the playlist or manifest for subsequent verification
+		if strings.HasSuffix(r.URL.Path, ".m3u8") || strings.HasSuffix(r.URL.Path, ".mpd") {
 			playlistsRequested.WithLabelValues(claims.StreamID, claims.CourseID).Inc()
 			// map request path to path under `vod_path`
 			upath := r.URL.Path
@@ -239,16 +239,15 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 				_, _ = w.Write([]byte("Internal server error. Can't read file: " + f.Name()))
 				return
 			}
-			lines := strings.Split(string(fileContents), "\n")
-			for i, line := range lines {
-				if strings.HasSuffix(line, ".ts") {
-					lines[i] = line + "?jwt=" + r.URL.Query().Get("jwt")
-				}
+			jwtParam := "?jwt=" + r.URL.Query().Get("jwt")
+			if strings.HasSuffix(r.URL.Path, ".mpd") {
+				w.Header().Set("Content-Type", "application/dash+xml")
+				_, _ = w.Write([]byte(signManifest(string(fileContents), jwtParam)))
+				return
 			}
-			resp := strings.Join(lines, "\n")
-			_, _ = w.Write([]byte(resp))
+			_, _ = w.Write([]byte(signPlaylist(string(fileContents), jwtParam)))
 			return
-		} else if strings.HasSuffix(r.URL.Path, ".ts") {
+		} else if strings.HasSuffix(r.URL.Path, ".ts") || strings.HasSuffix(r.URL.Path, ".m4s") {
 			chunksRequested.WithLabelValues(claims.StreamID, claims.CourseID).Inc()
 		}
 	}
@@ -263,6 +262,28 @@ func vodHandler(w http.ResponseWriter, r *http.Request) {
 	http.StripPrefix("/vod", vodFileServer).ServeHTTP(w, r)
 }
 
+var extXMapRe = regexp.MustCompile(`(#EXT-X-MAP:URI="[^"?]+)"`)
+
+// signPlaylist appends jwtParam to all segments, variant playlists and CMAF init segments of a HLS playlist
+func signPlaylist(playlist string, jwtParam string) string {
+	lines := strings.Split(playlist, "\n")
+	for i, line := range lines {
+		if strings.HasSuffix(line, ".ts") || strings.HasSuffix(line, ".m4s") || strings.HasSuffix(line, ".m3u8") {
+			lines[i] = line + jwtParam
+		} else if strings.HasPrefix(line, "#EXT-X-MAP:") {
+			lines[i] = extXMapRe.ReplaceAllString(line, `${1}`+jwtParam+`"`)
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+var dashSegmentRe = regexp.MustCompile(`((?:initialization|media)="[^"?]+)"`)
+
+// signManifest appends jwtParam to the initialization and media segment templates of a DASH manifest
+func signManifest(manifest string, jwtParam string) string {
+	return dashSegmentRe.ReplaceAllString(manifest, `${1}`+jwtParam+`"`)
+}
+
 func handleTLS(mux *http.ServeMux) {
 	if os.Getenv(CertDirEnv) == "" {
 		return
@@ -301,8 +322,8 @@ func edgeHandler(writer http.ResponseWriter, request *http.Request) {
 	}
 	urlParts := strings.SplitN(request.URL.Path, "/", 3) // -> ["", "vm123", "live/stream/1234.ts"]
 
-	// proxy m3u8 playlist
-	if strings.HasSuffix(request.URL.Path, ".m3u8") {
+	// proxy m3u8 playlists and mpd manifests, they change while the stream is live
+	if strings.HasSuffix(request.URL.Path, ".m3u8") || strings.HasSuffix(request.URL.Path, ".mpd") {
 		request.Host = urlParts[1]
 		request.URL.Path = "" // override by proxy
 		u, err := url.Parse(fmt.Sprintf("%s%s:%s/%s", originProto, urlParts[1], originPort, urlParts[2]))
@@ -438,6 +459,12 @@ func prepare() {
 	if err != nil {
 		log.Println("Error setting mimetype for m3u8:", err)
 	}
+	if err = mime.AddExtensionType(".mpd", "application/dash+xml"); err != nil {
+		log.Println("Error setting mimetype for mpd:", err)
+	}
+	if err = mime.AddExtensionType(".m4s", "video/iso.segment"); err != nil {
+		log.Println("Error setting mimetype for m4s:", err)
+	}
 	retries := 0
 	backoff := time.Second
 	for retries < 5 { // allow for 5 retries with backoff to reach main instance
@@ -167,3 +167,33 @@ func prepareJWT(exp time.Duration, playlist string) (string, error) {
 	}
 	return t.SignedString(key)
 }
+
+func TestAllowedRe(t *testing.T) {
+	allowed := []string{"/vm123/live/stream/1234.ts", "/vm123/live/stream/playlist.m3u8", "/vm123/live/stream/manifest.mpd", "/vm123/live/stream/chunk_0_00001.m4s"}
+	for _, p := range allowed {
+		if !allowedRe.MatchString(p) {
+			t.Errorf("%s should be allowed", p)
+		}
+	}
+	for _, p := range []string{"/vm123/live/stream/secret.mp4", "/vm123/../etc/passwd.ts"} {
+		if allowedRe.MatchString(p) {
+			t.Errorf("%s should not be allowed", p)
+		}
+	}
+}
+
+func TestSignPlaylist(t *testing.T) {
+	playlist := "#EXTM3U\n#EXT-X-MAP:URI=\"init_0.m4s\"\n#EXTINF:8.0,\nchunk_0_00001.m4s\n#EXTINF:8.0,\nsegment0001.ts\nmedia_0.m3u8\n#EXT-X-ENDLIST"
+	expected := "#EXTM3U\n#EXT-X-MAP:URI=\"init_0.m4s?jwt=abc\"\n#EXTINF:8.0,\nchunk_0_00001.m4s?jwt=abc\n#EXTINF:8.0,\nsegment0001.ts?jwt=abc\nmedia_0.m3u8?jwt=abc\n#EXT-X-ENDLIST"
+	if got := signPlaylist(playlist, "?jwt=abc"); got != expected {
+		t.Errorf("unexpected playlist:\n%s", got)
+	}
+}
+
+func TestSignManifest(t *testing.T) {
+	manifest := `<SegmentTemplate timescale="90000" initialization="init_$RepresentationID$.m4s" media="chunk_$RepresentationID$_$Number%05d$.m4s" startNumber="1">`
+	expected := `<SegmentTemplate timescale="90000" initialization="init_$RepresentationID$.m4s?jwt=abc" media="chunk_$RepresentationID$_$Number%05d$.m4s?jwt=abc" startNumber="1">`
+	if got := signManifest(manifest, "?jwt=abc"); got != expected {
+		t.Errorf("unexpected manifest:\n%s", got)
+	}
+}
@@ -21,6 +21,187 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type DeleteFilesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	Paths    []string `protobuf:"bytes,2,rep,name=Paths,proto3" json:"Paths,omitempty"`
+}
+
+func (x *DeleteFilesRequest) Reset() {
+	*x = DeleteFilesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFilesRequest) ProtoMessage() {}
+
+func (x *DeleteFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFilesRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFilesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeleteFilesRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *DeleteFilesRequest) GetPaths() []string {
+	if x != nil {
+		return x.Paths
+	}
+	return nil
+}
+
+type DeleteRawRecordingsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string               `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	CourseSlug string               `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	Start      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Start,proto3" json:"Start,omitempty"` // raw recordings are named after the course and the start of the stream
+}
+
+func (x *DeleteRawRecordingsRequest) Reset() {
+	*x = DeleteRawRecordingsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteRawRecordingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRawRecordingsRequest) ProtoMessage() {}
+
+func (x *DeleteRawRecordingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRawRecordingsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRawRecordingsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *DeleteRawRecordingsRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *DeleteRawRecordingsRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+func (x *DeleteRawRecordingsRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
+type DeleteVodsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string               `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	CourseSlug string               `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	Start      *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Start,proto3" json:"Start,omitempty"` // vods are named after the course and the start of the stream
+}
+
+func (x *DeleteVodsRequest) Reset() {
+	*x = DeleteVodsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteVodsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVodsRequest) ProtoMessage() {}
+
+func (x *DeleteVodsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVodsRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVodsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *DeleteVodsRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *DeleteVodsRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+func (x *DeleteVodsRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
+}
+
 type DeleteSectionImageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -32,7 +213,7 @@ type DeleteSectionImageRequest struct {
 func (x *DeleteSectionImageRequest) Reset() {
 	*x = DeleteSectionImageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[0]
+		mi := &file_api_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -45,7 +226,7 @@ func (x *DeleteSectionImageRequest) String() string {
 func (*DeleteSectionImageRequest) ProtoMessage() {}
 
 func (x *DeleteSectionImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[0]
+	mi := &file_api_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -58,7 +239,7 @@ func (x *DeleteSectionImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteSectionImageRequest.ProtoReflect.Descriptor instead.
 func (*DeleteSectionImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{0}
+	return file_api_proto_rawDescGZIP(), []int{3}
 }
 
 func (x *DeleteSectionImageRequest) GetPath() string {
@@ -79,7 +260,7 @@ type GenerateSectionImageResponse struct {
 func (x *GenerateSectionImageResponse) Reset() {
 	*x = GenerateSectionImageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[1]
+		mi := &file_api_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -92,7 +273,7 @@ func (x *GenerateSectionImageResponse) String() string {
 func (*GenerateSectionImageResponse) ProtoMessage() {}
 
 func (x *GenerateSectionImageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[1]
+	mi := &file_api_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -105,7 +286,7 @@ func (x *GenerateSectionImageResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateSectionImageResponse.ProtoReflect.Descriptor instead.
 func (*GenerateSectionImageResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{1}
+	return file_api_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GenerateSectionImageResponse) GetPaths() []string {
@@ -133,7 +314,7 @@ type GenerateThumbnailRequest struct {
 func (x *GenerateThumbnailRequest) Reset() {
 	*x = GenerateThumbnailRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[2]
+		mi := &file_api_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -146,7 +327,7 @@ func (x *GenerateThumbnailRequest) String() string {
 func (*GenerateThumbnailRequest) ProtoMessage() {}
 
 func (x *GenerateThumbnailRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[2]
+	mi := &file_api_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -159,7 +340,7 @@ func (x *GenerateThumbnailRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateThumbnailRequest.ProtoReflect.Descriptor instead.
 func (*GenerateThumbnailRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{2}
+	return file_api_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *GenerateThumbnailRequest) GetPath() string {
@@ -231,7 +412,7 @@ type Section struct {
 func (x *Section) Reset() {
 	*x = Section{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[3]
+		mi := &file_api_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -244,7 +425,7 @@ func (x *Section) String() string {
 func (*Section) ProtoMessage() {}
 
 func (x *Section) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[3]
+	mi := &file_api_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -257,7 +438,7 @@ func (x *Section) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Section.ProtoReflect.Descriptor instead.
 func (*Section) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{3}
+	return file_api_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *Section) GetHours() uint32 {
@@ -296,7 +477,7 @@ type GenerateSectionImageRequest struct {
 func (x *GenerateSectionImageRequest) Reset() {
 	*x = GenerateSectionImageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[4]
+		mi := &file_api_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -309,7 +490,7 @@ func (x *GenerateSectionImageRequest) String() string {
 func (*GenerateSectionImageRequest) ProtoMessage() {}
 
 func (x *GenerateSectionImageRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[4]
+	mi := &file_api_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -322,7 +503,7 @@ func (x *GenerateSectionImageRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateSectionImageRequest.ProtoReflect.Descriptor instead.
 func (*GenerateSectionImageRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{4}
+	return file_api_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GenerateSectionImageRequest) GetPlaylistURL() string {
@@ -374,7 +555,7 @@ type CutRequest struct {
 func (x *CutRequest) Reset() {
 	*x = CutRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[5]
+		mi := &file_api_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -387,7 +568,7 @@ func (x *CutRequest) String() string {
 func (*CutRequest) ProtoMessage() {}
 
 func (x *CutRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[5]
+	mi := &file_api_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -400,7 +581,7 @@ func (x *CutRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CutRequest.ProtoReflect.Descriptor instead.
 func (*CutRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{5}
+	return file_api_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *CutRequest) GetWorkerId() string {
@@ -443,7 +624,7 @@ type CutResponse struct {
 func (x *CutResponse) Reset() {
 	*x = CutResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[6]
+		mi := &file_api_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -456,7 +637,7 @@ func (x *CutResponse) String() string {
 func (*CutResponse) ProtoMessage() {}
 
 func (x *CutResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[6]
+	mi := &file_api_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -469,7 +650,7 @@ func (x *CutResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CutResponse.ProtoReflect.Descriptor instead.
 func (*CutResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{6}
+	return file_api_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *CutResponse) GetSuccess() bool {
@@ -498,7 +679,7 @@ type WaveformRequest struct {
 func (x *WaveformRequest) Reset() {
 	*x = WaveformRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[7]
+		mi := &file_api_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -511,7 +692,7 @@ func (x *WaveformRequest) String() string {
 func (*WaveformRequest) ProtoMessage() {}
 
 func (x *WaveformRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[7]
+	mi := &file_api_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -524,7 +705,7 @@ func (x *WaveformRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaveformRequest.ProtoReflect.Descriptor instead.
 func (*WaveformRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{7}
+	return file_api_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *WaveformRequest) GetWorkerId() string {
@@ -552,7 +733,7 @@ type WaveFormResponse struct {
 func (x *WaveFormResponse) Reset() {
 	*x = WaveFormResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[8]
+		mi := &file_api_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -565,7 +746,7 @@ func (x *WaveFormResponse) String() string {
 func (*WaveFormResponse) ProtoMessage() {}
 
 func (x *WaveFormResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[8]
+	mi := &file_api_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -578,7 +759,7 @@ func (x *WaveFormResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use WaveFormResponse.ProtoReflect.Descriptor instead.
 func (*WaveFormResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{8}
+	return file_api_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *WaveFormResponse) GetWaveform() []byte {
@@ -606,12 +787,19 @@ type StreamRequest struct {
 	StreamName   string               `protobuf:"bytes,13,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
 	IngestServer string               `protobuf:"bytes,14,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
 	OutUrl       string               `protobuf:"bytes,15,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	// Standby workers record the source without pushing it to the ingest server until they are asked to take over.
+	Standby            bool             `protobuf:"varint,16,opt,name=Standby,proto3" json:"Standby,omitempty"`
+	MinSilenceDuration uint32           `protobuf:"varint,17,opt,name=MinSilenceDuration,proto3" json:"MinSilenceDuration,omitempty"` // minimum length of detected silences in seconds
+	NormalizeLoudness  bool             `protobuf:"varint,18,opt,name=NormalizeLoudness,proto3" json:"NormalizeLoudness,omitempty"`   // EBU R128 loudness normalization of the recording
+	Denoise            bool             `protobuf:"varint,19,opt,name=Denoise,proto3" json:"Denoise,omitempty"`                       // noise reduction of the recording
+	EncodingProfile    *EncodingProfile `protobuf:"bytes,20,opt,name=EncodingProfile,proto3" json:"EncodingProfile,omitempty"`        // encoding of the live stream and the recording, unset uses the worker's defaults
+	ExtractAudio       bool             `protobuf:"varint,21,opt,name=ExtractAudio,proto3" json:"ExtractAudio,omitempty"`             // extract the audio of the recording for the podcast feed, set for the published source
 }
 
 func (x *StreamRequest) Reset() {
 	*x = StreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[9]
+		mi := &file_api_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -624,7 +812,7 @@ func (x *StreamRequest) String() string {
 func (*StreamRequest) ProtoMessage() {}
 
 func (x *StreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[9]
+	mi := &file_api_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -637,7 +825,7 @@ func (x *StreamRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StreamRequest.ProtoReflect.Descriptor instead.
 func (*StreamRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{9}
+	return file_api_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *StreamRequest) GetWorkerId() string {
@@ -731,36 +919,83 @@ func (x *StreamRequest) GetOutUrl() string {
 	return ""
 }
 
-type PremiereRequest struct {
+func (x *StreamRequest) GetStandby() bool {
+	if x != nil {
+		return x.Standby
+	}
+	return false
+}
+
+func (x *StreamRequest) GetMinSilenceDuration() uint32 {
+	if x != nil {
+		return x.MinSilenceDuration
+	}
+	return 0
+}
+
+func (x *StreamRequest) GetNormalizeLoudness() bool {
+	if x != nil {
+		return x.NormalizeLoudness
+	}
+	return false
+}
+
+func (x *StreamRequest) GetDenoise() bool {
+	if x != nil {
+		return x.Denoise
+	}
+	return false
+}
+
+func (x *StreamRequest) GetEncodingProfile() *EncodingProfile {
+	if x != nil {
+		return x.EncodingProfile
+	}
+	return nil
+}
+
+func (x *StreamRequest) GetExtractAudio() bool {
+	if x != nil {
+		return x.ExtractAudio
+	}
+	return false
+}
+
+// EncodingProfile configures how a worker encodes a live stream and transcodes its recording.
+// Unset fields use the worker's defaults.
+type EncodingProfile struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID     uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath     string `protobuf:"bytes,2,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	WorkerID     string `protobuf:"bytes,3,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamName   string `protobuf:"bytes,4,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
-	IngestServer string `protobuf:"bytes,5,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
-	OutUrl       string `protobuf:"bytes,6,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	Name          string   `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Codec         string   `protobuf:"bytes,2,opt,name=Codec,proto3" json:"Codec,omitempty"`                 // ffmpeg video encoder of the live stream, e.g. libx264 or h264_nvenc
+	Preset        string   `protobuf:"bytes,3,opt,name=Preset,proto3" json:"Preset,omitempty"`               // preset of the encoder
+	Bitrate       uint32   `protobuf:"varint,4,opt,name=Bitrate,proto3" json:"Bitrate,omitempty"`            // maximum video bitrate of the live stream in kbit/s
+	GOP           uint32   `protobuf:"varint,5,opt,name=GOP,proto3" json:"GOP,omitempty"`                    // keyframe interval of the live stream in frames
+	FPS           uint32   `protobuf:"varint,6,opt,name=FPS,proto3" json:"FPS,omitempty"`                    // frame rate of the live stream
+	Resolution    string   `protobuf:"bytes,7,opt,name=Resolution,proto3" json:"Resolution,omitempty"`       // WIDTHxHEIGHT the live stream is scaled to, empty keeps the source's resolution
+	HardwareFlags []string `protobuf:"bytes,8,rep,name=HardwareFlags,proto3" json:"HardwareFlags,omitempty"` // ffmpeg arguments placed before the input, e.g. -hwaccel cuda
+	CRF           uint32   `protobuf:"varint,9,opt,name=CRF,proto3" json:"CRF,omitempty"`                    // quality of the transcoded recording, 0 uses the default of the source type
 }
 
-func (x *PremiereRequest) Reset() {
-	*x = PremiereRequest{}
+func (x *EncodingProfile) Reset() {
+	*x = EncodingProfile{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[10]
+		mi := &file_api_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PremiereRequest) String() string {
+func (x *EncodingProfile) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PremiereRequest) ProtoMessage() {}
+func (*EncodingProfile) ProtoMessage() {}
 
-func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[10]
+func (x *EncodingProfile) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,80 +1006,102 @@ func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PremiereRequest.ProtoReflect.Descriptor instead.
-func (*PremiereRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use EncodingProfile.ProtoReflect.Descriptor instead.
+func (*EncodingProfile) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *PremiereRequest) GetStreamID() uint32 {
+func (x *EncodingProfile) GetName() string {
 	if x != nil {
-		return x.StreamID
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *PremiereRequest) GetFilePath() string {
+func (x *EncodingProfile) GetCodec() string {
 	if x != nil {
-		return x.FilePath
+		return x.Codec
 	}
 	return ""
 }
 
-func (x *PremiereRequest) GetWorkerID() string {
+func (x *EncodingProfile) GetPreset() string {
 	if x != nil {
-		return x.WorkerID
+		return x.Preset
 	}
 	return ""
 }
 
-func (x *PremiereRequest) GetStreamName() string {
+func (x *EncodingProfile) GetBitrate() uint32 {
 	if x != nil {
-		return x.StreamName
+		return x.Bitrate
 	}
-	return ""
+	return 0
 }
 
-func (x *PremiereRequest) GetIngestServer() string {
+func (x *EncodingProfile) GetGOP() uint32 {
 	if x != nil {
-		return x.IngestServer
+		return x.GOP
 	}
-	return ""
+	return 0
 }
 
-func (x *PremiereRequest) GetOutUrl() string {
+func (x *EncodingProfile) GetFPS() uint32 {
 	if x != nil {
-		return x.OutUrl
+		return x.FPS
+	}
+	return 0
+}
+
+func (x *EncodingProfile) GetResolution() string {
+	if x != nil {
+		return x.Resolution
 	}
 	return ""
 }
 
-type EndStreamRequest struct {
+func (x *EncodingProfile) GetHardwareFlags() []string {
+	if x != nil {
+		return x.HardwareFlags
+	}
+	return nil
+}
+
+func (x *EncodingProfile) GetCRF() uint32 {
+	if x != nil {
+		return x.CRF
+	}
+	return 0
+}
+
+// TakeoverRequest asks a standby worker to take over the ingest of a source from its failed primary worker.
+type TakeoverRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	StreamID   uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	WorkerID   string `protobuf:"bytes,2,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	DiscardVoD bool   `protobuf:"varint,3,opt,name=DiscardVoD,proto3" json:"DiscardVoD,omitempty"`
+	SourceType string `protobuf:"bytes,2,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	WorkerID   string `protobuf:"bytes,3,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
 }
 
-func (x *EndStreamRequest) Reset() {
-	*x = EndStreamRequest{}
+func (x *TakeoverRequest) Reset() {
+	*x = TakeoverRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[11]
+		mi := &file_api_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *EndStreamRequest) String() string {
+func (x *TakeoverRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EndStreamRequest) ProtoMessage() {}
+func (*TakeoverRequest) ProtoMessage() {}
 
-func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[11]
+func (x *TakeoverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -855,57 +1112,69 @@ func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EndStreamRequest.ProtoReflect.Descriptor instead.
-func (*EndStreamRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use TakeoverRequest.ProtoReflect.Descriptor instead.
+func (*TakeoverRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *EndStreamRequest) GetStreamID() uint32 {
+func (x *TakeoverRequest) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *EndStreamRequest) GetWorkerID() string {
+func (x *TakeoverRequest) GetSourceType() string {
 	if x != nil {
-		return x.WorkerID
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *EndStreamRequest) GetDiscardVoD() bool {
+func (x *TakeoverRequest) GetWorkerID() string {
 	if x != nil {
-		return x.DiscardVoD
+		return x.WorkerID
 	}
-	return false
+	return ""
 }
 
-type Status struct {
+// StitchRequest asks a worker to replace the recording of a standby that took over with the partial recording of the
+// failed primary worker, continued by the recording of the standby from where the primary's recording ends.
+type StitchRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
-}
-
-func (x *Status) Reset() {
-	*x = Status{}
+	WorkerID        string               `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID        uint32               `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	CourseSlug      string               `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseTerm      string               `protobuf:"bytes,4,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	CourseYear      uint32               `protobuf:"varint,5,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	Start           *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Start,proto3" json:"Start,omitempty"`
+	End             *timestamp.Timestamp `protobuf:"bytes,7,opt,name=End,proto3" json:"End,omitempty"`
+	SourceType      string               `protobuf:"bytes,8,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	PublishVoD      bool                 `protobuf:"varint,9,opt,name=PublishVoD,proto3" json:"PublishVoD,omitempty"`
+	PrimaryPath     string               `protobuf:"bytes,10,opt,name=PrimaryPath,proto3" json:"PrimaryPath,omitempty"`          // transcoded recording of the failed primary worker
+	PrimaryDuration uint32               `protobuf:"varint,11,opt,name=PrimaryDuration,proto3" json:"PrimaryDuration,omitempty"` // duration of the primary's recording in seconds
+}
+
+func (x *StitchRequest) Reset() {
+	*x = StitchRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[12]
+		mi := &file_api_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Status) String() string {
+func (x *StitchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Status) ProtoMessage() {}
+func (*StitchRequest) ProtoMessage() {}
 
-func (x *Status) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[12]
+func (x *StitchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -916,115 +1185,118 @@ func (x *Status) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Status.ProtoReflect.Descriptor instead.
-func (*Status) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use StitchRequest.ProtoReflect.Descriptor instead.
+func (*StitchRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *Status) GetOk() bool {
+func (x *StitchRequest) GetWorkerID() string {
 	if x != nil {
-		return x.Ok
+		return x.WorkerID
 	}
-	return false
+	return ""
 }
 
-type NotifyTranscodingProgressRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamId uint32 `protobuf:"varint,2,opt,name=StreamId,proto3" json:"StreamId,omitempty"`
-	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
-	Progress int32  `protobuf:"varint,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
+func (x *StitchRequest) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
 }
 
-func (x *NotifyTranscodingProgressRequest) Reset() {
-	*x = NotifyTranscodingProgressRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[13]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *StitchRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
 	}
+	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *StitchRequest) GetCourseTerm() string {
+	if x != nil {
+		return x.CourseTerm
+	}
+	return ""
 }
 
-func (*NotifyTranscodingProgressRequest) ProtoMessage() {}
+func (x *StitchRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
 
-func (x *NotifyTranscodingProgressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[13]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *StitchRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use NotifyTranscodingProgressRequest.ProtoReflect.Descriptor instead.
-func (*NotifyTranscodingProgressRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{13}
+func (x *StitchRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
+	}
+	return nil
 }
 
-func (x *NotifyTranscodingProgressRequest) GetWorkerID() string {
+func (x *StitchRequest) GetSourceType() string {
 	if x != nil {
-		return x.WorkerID
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetStreamId() uint32 {
+func (x *StitchRequest) GetPublishVoD() bool {
 	if x != nil {
-		return x.StreamId
+		return x.PublishVoD
 	}
-	return 0
+	return false
 }
 
-func (x *NotifyTranscodingProgressRequest) GetVersion() string {
+func (x *StitchRequest) GetPrimaryPath() string {
 	if x != nil {
-		return x.Version
+		return x.PrimaryPath
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingProgressRequest) GetProgress() int32 {
+func (x *StitchRequest) GetPrimaryDuration() uint32 {
 	if x != nil {
-		return x.Progress
+		return x.PrimaryDuration
 	}
 	return 0
 }
 
-type JoinWorkersRequest struct {
+type PremiereRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // token to authenticate the worker
-	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"` // hostname of the worker
+	StreamID     uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath     string `protobuf:"bytes,2,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	WorkerID     string `protobuf:"bytes,3,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamName   string `protobuf:"bytes,4,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
+	IngestServer string `protobuf:"bytes,5,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
+	OutUrl       string `protobuf:"bytes,6,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
 }
 
-func (x *JoinWorkersRequest) Reset() {
-	*x = JoinWorkersRequest{}
+func (x *PremiereRequest) Reset() {
+	*x = PremiereRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[14]
+		mi := &file_api_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *JoinWorkersRequest) String() string {
+func (x *PremiereRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinWorkersRequest) ProtoMessage() {}
+func (*PremiereRequest) ProtoMessage() {}
 
-func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[14]
+func (x *PremiereRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1035,99 +1307,80 @@ func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinWorkersRequest.ProtoReflect.Descriptor instead.
-func (*JoinWorkersRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{14}
+// Deprecated: Use PremiereRequest.ProtoReflect.Descriptor instead.
+func (*PremiereRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *JoinWorkersRequest) GetToken() string {
+func (x *PremiereRequest) GetStreamID() uint32 {
 	if x != nil {
-		return x.Token
+		return x.StreamID
 	}
-	return ""
+	return 0
 }
 
-func (x *JoinWorkersRequest) GetHostname() string {
+func (x *PremiereRequest) GetFilePath() string {
 	if x != nil {
-		return x.Hostname
+		return x.FilePath
 	}
 	return ""
 }
 
-type JoinWorkersResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"` // worker id, secret set for further communication in case of success
-}
-
-func (x *JoinWorkersResponse) Reset() {
-	*x = JoinWorkersResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[15]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *PremiereRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
 	}
+	return ""
 }
 
-func (x *JoinWorkersResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*JoinWorkersResponse) ProtoMessage() {}
-
-func (x *JoinWorkersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[15]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *PremiereRequest) GetStreamName() string {
+	if x != nil {
+		return x.StreamName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use JoinWorkersResponse.ProtoReflect.Descriptor instead.
-func (*JoinWorkersResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{15}
+func (x *PremiereRequest) GetIngestServer() string {
+	if x != nil {
+		return x.IngestServer
+	}
+	return ""
 }
 
-func (x *JoinWorkersResponse) GetWorkerId() string {
+func (x *PremiereRequest) GetOutUrl() string {
 	if x != nil {
-		return x.WorkerId
+		return x.OutUrl
 	}
 	return ""
 }
 
-type SelfStreamRequest struct {
+type EndStreamRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamKey  string `protobuf:"bytes,2,opt,name=StreamKey,proto3" json:"StreamKey,omitempty"`
-	CourseSlug string `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	StreamID   uint32 `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	WorkerID   string `protobuf:"bytes,2,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	DiscardVoD bool   `protobuf:"varint,3,opt,name=DiscardVoD,proto3" json:"DiscardVoD,omitempty"`
 }
 
-func (x *SelfStreamRequest) Reset() {
-	*x = SelfStreamRequest{}
+func (x *EndStreamRequest) Reset() {
+	*x = EndStreamRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[16]
+		mi := &file_api_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SelfStreamRequest) String() string {
+func (x *EndStreamRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelfStreamRequest) ProtoMessage() {}
+func (*EndStreamRequest) ProtoMessage() {}
 
-func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[16]
+func (x *EndStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1138,65 +1391,113 @@ func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelfStreamRequest.ProtoReflect.Descriptor instead.
-func (*SelfStreamRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{16}
-}
+// Deprecated: Use EndStreamRequest.ProtoReflect.Descriptor instead.
+func (*EndStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
 
-func (x *SelfStreamRequest) GetWorkerID() string {
+func (x *EndStreamRequest) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *EndStreamRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *SelfStreamRequest) GetStreamKey() string {
+func (x *EndStreamRequest) GetDiscardVoD() bool {
 	if x != nil {
-		return x.StreamKey
+		return x.DiscardVoD
 	}
-	return ""
+	return false
 }
 
-func (x *SelfStreamRequest) GetCourseSlug() string {
+type Status struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ok bool `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+}
+
+func (x *Status) Reset() {
+	*x = Status{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Status) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Status) ProtoMessage() {}
+
+func (x *Status) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Status.ProtoReflect.Descriptor instead.
+func (*Status) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *Status) GetOk() bool {
 	if x != nil {
-		return x.CourseSlug
+		return x.Ok
 	}
-	return ""
+	return false
 }
 
-type SelfStreamResponse struct {
+type StreamHealth struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StreamID     uint32               `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	CourseSlug   string               `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
-	CourseYear   uint32               `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
-	StreamStart  *timestamp.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
-	CourseTerm   string               `protobuf:"bytes,5,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
-	UploadVoD    bool                 `protobuf:"varint,6,opt,name=uploadVoD,proto3" json:"uploadVoD,omitempty"`
-	IngestServer string               `protobuf:"bytes,7,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
-	StreamName   string               `protobuf:"bytes,8,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
-	OutUrl       string               `protobuf:"bytes,9,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	WorkerID         string  `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID         uint32  `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	SourceType       string  `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Bitrate          float64 `protobuf:"fixed64,4,opt,name=Bitrate,proto3" json:"Bitrate,omitempty"` // output bitrate in kbit/s
+	FPS              float64 `protobuf:"fixed64,5,opt,name=FPS,proto3" json:"FPS,omitempty"`
+	Speed            float64 `protobuf:"fixed64,6,opt,name=Speed,proto3" json:"Speed,omitempty"`                      // processing speed relative to realtime, below 1 the worker falls behind
+	Frames           uint64  `protobuf:"varint,7,opt,name=Frames,proto3" json:"Frames,omitempty"`                     // frames processed since the last restart of ffmpeg
+	DroppedFrames    uint64  `protobuf:"varint,8,opt,name=DroppedFrames,proto3" json:"DroppedFrames,omitempty"`       // since the last restart of ffmpeg
+	DuplicatedFrames uint64  `protobuf:"varint,9,opt,name=DuplicatedFrames,proto3" json:"DuplicatedFrames,omitempty"` // since the last restart of ffmpeg
+	Restarts         uint32  `protobuf:"varint,10,opt,name=Restarts,proto3" json:"Restarts,omitempty"`                // times ffmpeg was restarted after an error
 }
 
-func (x *SelfStreamResponse) Reset() {
-	*x = SelfStreamResponse{}
+func (x *StreamHealth) Reset() {
+	*x = StreamHealth{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[17]
+		mi := &file_api_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SelfStreamResponse) String() string {
+func (x *StreamHealth) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SelfStreamResponse) ProtoMessage() {}
+func (*StreamHealth) ProtoMessage() {}
 
-func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[17]
+func (x *StreamHealth) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1207,106 +1508,111 @@ func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SelfStreamResponse.ProtoReflect.Descriptor instead.
-func (*SelfStreamResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use StreamHealth.ProtoReflect.Descriptor instead.
+func (*StreamHealth) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *SelfStreamResponse) GetStreamID() uint32 {
+func (x *StreamHealth) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *StreamHealth) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *SelfStreamResponse) GetCourseSlug() string {
+func (x *StreamHealth) GetSourceType() string {
 	if x != nil {
-		return x.CourseSlug
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *SelfStreamResponse) GetCourseYear() uint32 {
+func (x *StreamHealth) GetBitrate() float64 {
 	if x != nil {
-		return x.CourseYear
+		return x.Bitrate
 	}
 	return 0
 }
 
-func (x *SelfStreamResponse) GetStreamStart() *timestamp.Timestamp {
+func (x *StreamHealth) GetFPS() float64 {
 	if x != nil {
-		return x.StreamStart
+		return x.FPS
 	}
-	return nil
+	return 0
 }
 
-func (x *SelfStreamResponse) GetCourseTerm() string {
+func (x *StreamHealth) GetSpeed() float64 {
 	if x != nil {
-		return x.CourseTerm
+		return x.Speed
 	}
-	return ""
+	return 0
 }
 
-func (x *SelfStreamResponse) GetUploadVoD() bool {
+func (x *StreamHealth) GetFrames() uint64 {
 	if x != nil {
-		return x.UploadVoD
+		return x.Frames
 	}
-	return false
+	return 0
 }
 
-func (x *SelfStreamResponse) GetIngestServer() string {
+func (x *StreamHealth) GetDroppedFrames() uint64 {
 	if x != nil {
-		return x.IngestServer
+		return x.DroppedFrames
 	}
-	return ""
+	return 0
 }
 
-func (x *SelfStreamResponse) GetStreamName() string {
+func (x *StreamHealth) GetDuplicatedFrames() uint64 {
 	if x != nil {
-		return x.StreamName
+		return x.DuplicatedFrames
 	}
-	return ""
+	return 0
 }
 
-func (x *SelfStreamResponse) GetOutUrl() string {
+func (x *StreamHealth) GetRestarts() uint32 {
 	if x != nil {
-		return x.OutUrl
+		return x.Restarts
 	}
-	return ""
+	return 0
 }
 
-type HeartBeat struct {
+type SignalStatus struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	Workload uint32   `protobuf:"varint,2,opt,name=Workload,proto3" json:"Workload,omitempty"`
-	Jobs     []string `protobuf:"bytes,3,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
-	Version  string   `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
-	CPU      string   `protobuf:"bytes,5,opt,name=CPU,proto3" json:"CPU,omitempty"`
-	Memory   string   `protobuf:"bytes,6,opt,name=Memory,proto3" json:"Memory,omitempty"`
-	Disk     string   `protobuf:"bytes,7,opt,name=Disk,proto3" json:"Disk,omitempty"`
-	Uptime   string   `protobuf:"bytes,8,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	SourceType string `protobuf:"bytes,3,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Black      bool   `protobuf:"varint,4,opt,name=Black,proto3" json:"Black,omitempty"`   // the video was black during the last check
+	Frozen     bool   `protobuf:"varint,5,opt,name=Frozen,proto3" json:"Frozen,omitempty"` // the video didn't change during the last check
+	Silent     bool   `protobuf:"varint,6,opt,name=Silent,proto3" json:"Silent,omitempty"` // the audio was silent during the last check
 }
 
-func (x *HeartBeat) Reset() {
-	*x = HeartBeat{}
+func (x *SignalStatus) Reset() {
+	*x = SignalStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[18]
+		mi := &file_api_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HeartBeat) String() string {
+func (x *SignalStatus) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HeartBeat) ProtoMessage() {}
+func (*SignalStatus) ProtoMessage() {}
 
-func (x *HeartBeat) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[18]
+func (x *SignalStatus) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1317,93 +1623,81 @@ func (x *HeartBeat) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HeartBeat.ProtoReflect.Descriptor instead.
-func (*HeartBeat) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SignalStatus.ProtoReflect.Descriptor instead.
+func (*SignalStatus) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *HeartBeat) GetWorkerID() string {
+func (x *SignalStatus) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *HeartBeat) GetWorkload() uint32 {
+func (x *SignalStatus) GetStreamID() uint32 {
 	if x != nil {
-		return x.Workload
+		return x.StreamID
 	}
 	return 0
 }
 
-func (x *HeartBeat) GetJobs() []string {
-	if x != nil {
-		return x.Jobs
-	}
-	return nil
-}
-
-func (x *HeartBeat) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
-}
-
-func (x *HeartBeat) GetCPU() string {
+func (x *SignalStatus) GetSourceType() string {
 	if x != nil {
-		return x.CPU
+		return x.SourceType
 	}
 	return ""
 }
 
-func (x *HeartBeat) GetMemory() string {
+func (x *SignalStatus) GetBlack() bool {
 	if x != nil {
-		return x.Memory
+		return x.Black
 	}
-	return ""
+	return false
 }
 
-func (x *HeartBeat) GetDisk() string {
+func (x *SignalStatus) GetFrozen() bool {
 	if x != nil {
-		return x.Disk
+		return x.Frozen
 	}
-	return ""
+	return false
 }
 
-func (x *HeartBeat) GetUptime() string {
+func (x *SignalStatus) GetSilent() bool {
 	if x != nil {
-		return x.Uptime
+		return x.Silent
 	}
-	return ""
+	return false
 }
 
-type StreamFinished struct {
+type NotifyTranscodingProgressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	StreamId uint32 `protobuf:"varint,2,opt,name=StreamId,proto3" json:"StreamId,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	Progress int32  `protobuf:"varint,4,opt,name=Progress,proto3" json:"Progress,omitempty"`
 }
 
-func (x *StreamFinished) Reset() {
-	*x = StreamFinished{}
+func (x *NotifyTranscodingProgressRequest) Reset() {
+	*x = NotifyTranscodingProgressRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[19]
+		mi := &file_api_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamFinished) String() string {
+func (x *NotifyTranscodingProgressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamFinished) ProtoMessage() {}
+func (*NotifyTranscodingProgressRequest) ProtoMessage() {}
 
-func (x *StreamFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[19]
+func (x *NotifyTranscodingProgressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1414,55 +1708,65 @@ func (x *StreamFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamFinished.ProtoReflect.Descriptor instead.
-func (*StreamFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use NotifyTranscodingProgressRequest.ProtoReflect.Descriptor instead.
+func (*NotifyTranscodingProgressRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *StreamFinished) GetWorkerID() string {
+func (x *NotifyTranscodingProgressRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *StreamFinished) GetStreamID() uint32 {
+func (x *NotifyTranscodingProgressRequest) GetStreamId() uint32 {
 	if x != nil {
-		return x.StreamID
+		return x.StreamId
 	}
 	return 0
 }
 
-type ThumbnailsFinished struct {
+func (x *NotifyTranscodingProgressRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingProgressRequest) GetProgress() int32 {
+	if x != nil {
+		return x.Progress
+	}
+	return 0
+}
+
+type JoinWorkersRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID           string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID           uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath           string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Interval           uint32 `protobuf:"varint,4,opt,name=Interval,proto3" json:"Interval,omitempty"`
-	SourceType         string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	LargeThumbnailPath string `protobuf:"bytes,6,opt,name=LargeThumbnailPath,proto3" json:"LargeThumbnailPath,omitempty"`
+	Token    string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`       // token to authenticate the worker
+	Hostname string `protobuf:"bytes,2,opt,name=hostname,proto3" json:"hostname,omitempty"` // hostname of the worker
 }
 
-func (x *ThumbnailsFinished) Reset() {
-	*x = ThumbnailsFinished{}
+func (x *JoinWorkersRequest) Reset() {
+	*x = JoinWorkersRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[20]
+		mi := &file_api_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ThumbnailsFinished) String() string {
+func (x *JoinWorkersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ThumbnailsFinished) ProtoMessage() {}
+func (*JoinWorkersRequest) ProtoMessage() {}
 
-func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[20]
+func (x *JoinWorkersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1473,82 +1777,312 @@ func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ThumbnailsFinished.ProtoReflect.Descriptor instead.
-func (*ThumbnailsFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use JoinWorkersRequest.ProtoReflect.Descriptor instead.
+func (*JoinWorkersRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ThumbnailsFinished) GetWorkerID() string {
+func (x *JoinWorkersRequest) GetToken() string {
 	if x != nil {
-		return x.WorkerID
+		return x.Token
 	}
 	return ""
 }
 
-func (x *ThumbnailsFinished) GetStreamID() uint32 {
+func (x *JoinWorkersRequest) GetHostname() string {
 	if x != nil {
-		return x.StreamID
+		return x.Hostname
+	}
+	return ""
+}
+
+type JoinWorkersResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerId string `protobuf:"bytes,1,opt,name=worker_id,json=workerId,proto3" json:"worker_id,omitempty"` // worker id, secret set for further communication in case of success
+}
+
+func (x *JoinWorkersResponse) Reset() {
+	*x = JoinWorkersResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *JoinWorkersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JoinWorkersResponse) ProtoMessage() {}
+
+func (x *JoinWorkersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JoinWorkersResponse.ProtoReflect.Descriptor instead.
+func (*JoinWorkersResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *JoinWorkersResponse) GetWorkerId() string {
+	if x != nil {
+		return x.WorkerId
+	}
+	return ""
+}
+
+type SelfStreamRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamKey  string `protobuf:"bytes,2,opt,name=StreamKey,proto3" json:"StreamKey,omitempty"`
+	CourseSlug string `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+}
+
+func (x *SelfStreamRequest) Reset() {
+	*x = SelfStreamRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfStreamRequest) ProtoMessage() {}
+
+func (x *SelfStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfStreamRequest.ProtoReflect.Descriptor instead.
+func (*SelfStreamRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SelfStreamRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *SelfStreamRequest) GetStreamKey() string {
+	if x != nil {
+		return x.StreamKey
+	}
+	return ""
+}
+
+func (x *SelfStreamRequest) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+type SelfStreamResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	StreamID           uint32               `protobuf:"varint,1,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	CourseSlug         string               `protobuf:"bytes,2,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseYear         uint32               `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	StreamStart        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
+	CourseTerm         string               `protobuf:"bytes,5,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	UploadVoD          bool                 `protobuf:"varint,6,opt,name=uploadVoD,proto3" json:"uploadVoD,omitempty"`
+	IngestServer       string               `protobuf:"bytes,7,opt,name=IngestServer,proto3" json:"IngestServer,omitempty"`
+	StreamName         string               `protobuf:"bytes,8,opt,name=StreamName,proto3" json:"StreamName,omitempty"`
+	OutUrl             string               `protobuf:"bytes,9,opt,name=OutUrl,proto3" json:"OutUrl,omitempty"`
+	MinSilenceDuration uint32               `protobuf:"varint,10,opt,name=MinSilenceDuration,proto3" json:"MinSilenceDuration,omitempty"` // minimum length of detected silences in seconds
+	NormalizeLoudness  bool                 `protobuf:"varint,11,opt,name=NormalizeLoudness,proto3" json:"NormalizeLoudness,omitempty"`   // EBU R128 loudness normalization of the recording
+	Denoise            bool                 `protobuf:"varint,12,opt,name=Denoise,proto3" json:"Denoise,omitempty"`                       // noise reduction of the recording
+	EncodingProfile    *EncodingProfile     `protobuf:"bytes,13,opt,name=EncodingProfile,proto3" json:"EncodingProfile,omitempty"`        // encoding of the live stream and the recording, unset uses the worker's defaults
+}
+
+func (x *SelfStreamResponse) Reset() {
+	*x = SelfStreamResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SelfStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SelfStreamResponse) ProtoMessage() {}
+
+func (x *SelfStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SelfStreamResponse.ProtoReflect.Descriptor instead.
+func (*SelfStreamResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SelfStreamResponse) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
 	}
 	return 0
 }
 
-func (x *ThumbnailsFinished) GetFilePath() string {
+func (x *SelfStreamResponse) GetCourseSlug() string {
 	if x != nil {
-		return x.FilePath
+		return x.CourseSlug
 	}
 	return ""
 }
 
-func (x *ThumbnailsFinished) GetInterval() uint32 {
+func (x *SelfStreamResponse) GetCourseYear() uint32 {
 	if x != nil {
-		return x.Interval
+		return x.CourseYear
 	}
 	return 0
 }
 
-func (x *ThumbnailsFinished) GetSourceType() string {
+func (x *SelfStreamResponse) GetStreamStart() *timestamp.Timestamp {
 	if x != nil {
-		return x.SourceType
+		return x.StreamStart
+	}
+	return nil
+}
+
+func (x *SelfStreamResponse) GetCourseTerm() string {
+	if x != nil {
+		return x.CourseTerm
 	}
 	return ""
 }
 
-func (x *ThumbnailsFinished) GetLargeThumbnailPath() string {
+func (x *SelfStreamResponse) GetUploadVoD() bool {
 	if x != nil {
-		return x.LargeThumbnailPath
+		return x.UploadVoD
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetIngestServer() string {
+	if x != nil {
+		return x.IngestServer
 	}
 	return ""
 }
 
-type TranscodingFinished struct {
+func (x *SelfStreamResponse) GetStreamName() string {
+	if x != nil {
+		return x.StreamName
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetOutUrl() string {
+	if x != nil {
+		return x.OutUrl
+	}
+	return ""
+}
+
+func (x *SelfStreamResponse) GetMinSilenceDuration() uint32 {
+	if x != nil {
+		return x.MinSilenceDuration
+	}
+	return 0
+}
+
+func (x *SelfStreamResponse) GetNormalizeLoudness() bool {
+	if x != nil {
+		return x.NormalizeLoudness
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetDenoise() bool {
+	if x != nil {
+		return x.Denoise
+	}
+	return false
+}
+
+func (x *SelfStreamResponse) GetEncodingProfile() *EncodingProfile {
+	if x != nil {
+		return x.EncodingProfile
+	}
+	return nil
+}
+
+type HeartBeat struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	FilePath   string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Duration   uint32 `protobuf:"varint,4,opt,name=Duration,proto3" json:"Duration,omitempty"`
-	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	Workload uint32   `protobuf:"varint,2,opt,name=Workload,proto3" json:"Workload,omitempty"`
+	Jobs     []string `protobuf:"bytes,3,rep,name=Jobs,proto3" json:"Jobs,omitempty"`
+	Version  string   `protobuf:"bytes,4,opt,name=Version,proto3" json:"Version,omitempty"`
+	CPU      string   `protobuf:"bytes,5,opt,name=CPU,proto3" json:"CPU,omitempty"`
+	Memory   string   `protobuf:"bytes,6,opt,name=Memory,proto3" json:"Memory,omitempty"`
+	Disk     string   `protobuf:"bytes,7,opt,name=Disk,proto3" json:"Disk,omitempty"`
+	Uptime   string   `protobuf:"bytes,8,opt,name=Uptime,proto3" json:"Uptime,omitempty"`
+	// Jobs currently running on the worker. Draining workers are drained once this is empty.
+	InFlightJobs []*InFlightJob `protobuf:"bytes,9,rep,name=InFlightJobs,proto3" json:"InFlightJobs,omitempty"`
 }
 
-func (x *TranscodingFinished) Reset() {
-	*x = TranscodingFinished{}
+func (x *HeartBeat) Reset() {
+	*x = HeartBeat{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[21]
+		mi := &file_api_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TranscodingFinished) String() string {
+func (x *HeartBeat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TranscodingFinished) ProtoMessage() {}
+func (*HeartBeat) ProtoMessage() {}
 
-func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[21]
+func (x *HeartBeat) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1559,75 +2093,1154 @@ func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TranscodingFinished.ProtoReflect.Descriptor instead.
-func (*TranscodingFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use HeartBeat.ProtoReflect.Descriptor instead.
+func (*HeartBeat) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *TranscodingFinished) GetWorkerID() string {
+func (x *HeartBeat) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetWorkload() uint32 {
+	if x != nil {
+		return x.Workload
+	}
+	return 0
+}
+
+func (x *HeartBeat) GetJobs() []string {
+	if x != nil {
+		return x.Jobs
+	}
+	return nil
+}
+
+func (x *HeartBeat) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetCPU() string {
+	if x != nil {
+		return x.CPU
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetMemory() string {
+	if x != nil {
+		return x.Memory
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetDisk() string {
+	if x != nil {
+		return x.Disk
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetUptime() string {
+	if x != nil {
+		return x.Uptime
+	}
+	return ""
+}
+
+func (x *HeartBeat) GetInFlightJobs() []*InFlightJob {
+	if x != nil {
+		return x.InFlightJobs
+	}
+	return nil
+}
+
+type InFlightJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type     string               `protobuf:"bytes,1,opt,name=Type,proto3" json:"Type,omitempty"` // stream, transcoding, transcoding_audio, silence_detection, thumbnails, archival or upload
+	StreamID uint32               `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Started  *timestamp.Timestamp `protobuf:"bytes,3,opt,name=Started,proto3" json:"Started,omitempty"`
+}
+
+func (x *InFlightJob) Reset() {
+	*x = InFlightJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InFlightJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InFlightJob) ProtoMessage() {}
+
+func (x *InFlightJob) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InFlightJob.ProtoReflect.Descriptor instead.
+func (*InFlightJob) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *InFlightJob) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *InFlightJob) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *InFlightJob) GetStarted() *timestamp.Timestamp {
+	if x != nil {
+		return x.Started
+	}
+	return nil
+}
+
+type StreamFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+}
+
+func (x *StreamFinished) Reset() {
+	*x = StreamFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamFinished) ProtoMessage() {}
+
+func (x *StreamFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamFinished.ProtoReflect.Descriptor instead.
+func (*StreamFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *StreamFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *StreamFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+type ThumbnailsFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID           string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID           uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath           string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Interval           uint32 `protobuf:"varint,4,opt,name=Interval,proto3" json:"Interval,omitempty"`
+	SourceType         string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	LargeThumbnailPath string `protobuf:"bytes,6,opt,name=LargeThumbnailPath,proto3" json:"LargeThumbnailPath,omitempty"`
+}
+
+func (x *ThumbnailsFinished) Reset() {
+	*x = ThumbnailsFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ThumbnailsFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ThumbnailsFinished) ProtoMessage() {}
+
+func (x *ThumbnailsFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ThumbnailsFinished.ProtoReflect.Descriptor instead.
+func (*ThumbnailsFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ThumbnailsFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *ThumbnailsFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *ThumbnailsFinished) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *ThumbnailsFinished) GetInterval() uint32 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *ThumbnailsFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *ThumbnailsFinished) GetLargeThumbnailPath() string {
+	if x != nil {
+		return x.LargeThumbnailPath
+	}
+	return ""
+}
+
+type AudioFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath   string `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"` // audio only version of the vod
+	SourceType string `protobuf:"bytes,4,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *AudioFinished) Reset() {
+	*x = AudioFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AudioFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AudioFinished) ProtoMessage() {}
+
+func (x *AudioFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AudioFinished.ProtoReflect.Descriptor instead.
+func (*AudioFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *AudioFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *AudioFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *AudioFinished) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *AudioFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type TranscodingFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string    `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32    `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath   string    `protobuf:"bytes,3,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Duration   uint32    `protobuf:"varint,4,opt,name=Duration,proto3" json:"Duration,omitempty"`
+	SourceType string    `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	Loudness   *Loudness `protobuf:"bytes,6,opt,name=Loudness,proto3" json:"Loudness,omitempty"` // loudness of the recording before normalization (after noise reduction if enabled), unset if not normalized or it couldn't be measured
+}
+
+func (x *TranscodingFinished) Reset() {
+	*x = TranscodingFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TranscodingFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TranscodingFinished) ProtoMessage() {}
+
+func (x *TranscodingFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TranscodingFinished.ProtoReflect.Descriptor instead.
+func (*TranscodingFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *TranscodingFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *TranscodingFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *TranscodingFinished) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *TranscodingFinished) GetDuration() uint32 {
+	if x != nil {
+		return x.Duration
+	}
+	return 0
+}
+
+func (x *TranscodingFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *TranscodingFinished) GetLoudness() *Loudness {
+	if x != nil {
+		return x.Loudness
+	}
+	return nil
+}
+
+// Loudness is the EBU R128 loudness of a recording
+type Loudness struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Integrated float64 `protobuf:"fixed64,1,opt,name=Integrated,proto3" json:"Integrated,omitempty"` // integrated loudness in LUFS
+	Range      float64 `protobuf:"fixed64,2,opt,name=Range,proto3" json:"Range,omitempty"`           // loudness range in LU
+	TruePeak   float64 `protobuf:"fixed64,3,opt,name=TruePeak,proto3" json:"TruePeak,omitempty"`     // maximum true peak in dBTP
+}
+
+func (x *Loudness) Reset() {
+	*x = Loudness{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Loudness) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Loudness) ProtoMessage() {}
+
+func (x *Loudness) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Loudness.ProtoReflect.Descriptor instead.
+func (*Loudness) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *Loudness) GetIntegrated() float64 {
+	if x != nil {
+		return x.Integrated
+	}
+	return 0
+}
+
+func (x *Loudness) GetRange() float64 {
+	if x != nil {
+		return x.Range
+	}
+	return 0
+}
+
+func (x *Loudness) GetTruePeak() float64 {
+	if x != nil {
+		return x.TruePeak
+	}
+	return 0
+}
+
+type UploadFinished struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID     string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID     uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	HLSUrl       string `protobuf:"bytes,4,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
+	SourceType   string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+	ThumbnailUrl string `protobuf:"bytes,6,opt,name=ThumbnailUrl,proto3" json:"ThumbnailUrl,omitempty"`
+	DashUrl      string `protobuf:"bytes,7,opt,name=DashUrl,proto3" json:"DashUrl,omitempty"` // DASH manifest of the vod, empty if no DASH is packaged
+}
+
+func (x *UploadFinished) Reset() {
+	*x = UploadFinished{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UploadFinished) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadFinished) ProtoMessage() {}
+
+func (x *UploadFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadFinished.ProtoReflect.Descriptor instead.
+func (*UploadFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *UploadFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *UploadFinished) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *UploadFinished) GetHLSUrl() string {
+	if x != nil {
+		return x.HLSUrl
+	}
+	return ""
+}
+
+func (x *UploadFinished) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+func (x *UploadFinished) GetThumbnailUrl() string {
+	if x != nil {
+		return x.ThumbnailUrl
+	}
+	return ""
+}
+
+func (x *UploadFinished) GetDashUrl() string {
+	if x != nil {
+		return x.DashUrl
+	}
+	return ""
+}
+
+type StreamStarted struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	HlsUrl     string `protobuf:"bytes,3,opt,name=HlsUrl,proto3" json:"HlsUrl,omitempty"`
+	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
+}
+
+func (x *StreamStarted) Reset() {
+	*x = StreamStarted{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StreamStarted) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamStarted) ProtoMessage() {}
+
+func (x *StreamStarted) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamStarted.ProtoReflect.Descriptor instead.
+func (*StreamStarted) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *StreamStarted) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *StreamStarted) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *StreamStarted) GetHlsUrl() string {
+	if x != nil {
+		return x.HlsUrl
+	}
+	return ""
+}
+
+func (x *StreamStarted) GetSourceType() string {
+	if x != nil {
+		return x.SourceType
+	}
+	return ""
+}
+
+type SilenceResults struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32   `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Starts   []uint32 `protobuf:"varint,3,rep,packed,name=starts,proto3" json:"starts,omitempty"`
+	Ends     []uint32 `protobuf:"varint,4,rep,packed,name=ends,proto3" json:"ends,omitempty"`
+}
+
+func (x *SilenceResults) Reset() {
+	*x = SilenceResults{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SilenceResults) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SilenceResults) ProtoMessage() {}
+
+func (x *SilenceResults) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SilenceResults.ProtoReflect.Descriptor instead.
+func (*SilenceResults) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *SilenceResults) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *SilenceResults) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *SilenceResults) GetStarts() []uint32 {
+	if x != nil {
+		return x.Starts
+	}
+	return nil
+}
+
+func (x *SilenceResults) GetEnds() []uint32 {
+	if x != nil {
+		return x.Ends
+	}
+	return nil
+}
+
+type GetStreamInfoForUploadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID  string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	UploadKey string `protobuf:"bytes,2,opt,name=UploadKey,proto3" json:"UploadKey,omitempty"`
+}
+
+func (x *GetStreamInfoForUploadRequest) Reset() {
+	*x = GetStreamInfoForUploadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamInfoForUploadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamInfoForUploadRequest) ProtoMessage() {}
+
+func (x *GetStreamInfoForUploadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamInfoForUploadRequest.ProtoReflect.Descriptor instead.
+func (*GetStreamInfoForUploadRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetStreamInfoForUploadRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *GetStreamInfoForUploadRequest) GetUploadKey() string {
+	if x != nil {
+		return x.UploadKey
+	}
+	return ""
+}
+
+type GetStreamInfoForUploadResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CourseSlug         string               `protobuf:"bytes,1,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseTerm         string               `protobuf:"bytes,2,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	CourseYear         uint32               `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	StreamStart        *timestamp.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
+	StreamEnd          *timestamp.Timestamp `protobuf:"bytes,5,opt,name=StreamEnd,proto3" json:"StreamEnd,omitempty"`
+	StreamID           uint32               `protobuf:"varint,6,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	MinSilenceDuration uint32               `protobuf:"varint,7,opt,name=MinSilenceDuration,proto3" json:"MinSilenceDuration,omitempty"` // minimum length of detected silences in seconds
+	NormalizeLoudness  bool                 `protobuf:"varint,8,opt,name=NormalizeLoudness,proto3" json:"NormalizeLoudness,omitempty"`   // EBU R128 loudness normalization of the recording
+	Denoise            bool                 `protobuf:"varint,9,opt,name=Denoise,proto3" json:"Denoise,omitempty"`                       // noise reduction of the recording
+}
+
+func (x *GetStreamInfoForUploadResponse) Reset() {
+	*x = GetStreamInfoForUploadResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStreamInfoForUploadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStreamInfoForUploadResponse) ProtoMessage() {}
+
+func (x *GetStreamInfoForUploadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStreamInfoForUploadResponse.ProtoReflect.Descriptor instead.
+func (*GetStreamInfoForUploadResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetStreamInfoForUploadResponse) GetCourseSlug() string {
+	if x != nil {
+		return x.CourseSlug
+	}
+	return ""
+}
+
+func (x *GetStreamInfoForUploadResponse) GetCourseTerm() string {
+	if x != nil {
+		return x.CourseTerm
+	}
+	return ""
+}
+
+func (x *GetStreamInfoForUploadResponse) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
+	}
+	return 0
+}
+
+func (x *GetStreamInfoForUploadResponse) GetStreamStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.StreamStart
+	}
+	return nil
+}
+
+func (x *GetStreamInfoForUploadResponse) GetStreamEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.StreamEnd
+	}
+	return nil
+}
+
+func (x *GetStreamInfoForUploadResponse) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *GetStreamInfoForUploadResponse) GetMinSilenceDuration() uint32 {
+	if x != nil {
+		return x.MinSilenceDuration
+	}
+	return 0
+}
+
+func (x *GetStreamInfoForUploadResponse) GetNormalizeLoudness() bool {
+	if x != nil {
+		return x.NormalizeLoudness
+	}
+	return false
+}
+
+func (x *GetStreamInfoForUploadResponse) GetDenoise() bool {
+	if x != nil {
+		return x.Denoise
+	}
+	return false
+}
+
+type LivePreviewRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	HLSUrl   string `protobuf:"bytes,2,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
+}
+
+func (x *LivePreviewRequest) Reset() {
+	*x = LivePreviewRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivePreviewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivePreviewRequest) ProtoMessage() {}
+
+func (x *LivePreviewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivePreviewRequest.ProtoReflect.Descriptor instead.
+func (*LivePreviewRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *LivePreviewRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *LivePreviewRequest) GetHLSUrl() string {
+	if x != nil {
+		return x.HLSUrl
+	}
+	return ""
+}
+
+type LivePreviewResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	LiveThumb []byte `protobuf:"bytes,1,opt,name=LiveThumb,proto3" json:"LiveThumb,omitempty"`
+}
+
+func (x *LivePreviewResponse) Reset() {
+	*x = LivePreviewResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LivePreviewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LivePreviewResponse) ProtoMessage() {}
+
+func (x *LivePreviewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LivePreviewResponse.ProtoReflect.Descriptor instead.
+func (*LivePreviewResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *LivePreviewResponse) GetLiveThumb() []byte {
+	if x != nil {
+		return x.LiveThumb
+	}
+	return nil
+}
+
+type NotifyTranscodingFailureRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	FilePath string `protobuf:"bytes,4,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Logs     string `protobuf:"bytes,5,opt,name=Logs,proto3" json:"Logs,omitempty"`
+	ExitCode int64  `protobuf:"varint,6,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
+}
+
+func (x *NotifyTranscodingFailureRequest) Reset() {
+	*x = NotifyTranscodingFailureRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *NotifyTranscodingFailureRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyTranscodingFailureRequest) ProtoMessage() {}
+
+func (x *NotifyTranscodingFailureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotifyTranscodingFailureRequest.ProtoReflect.Descriptor instead.
+func (*NotifyTranscodingFailureRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *NotifyTranscodingFailureRequest) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingFailureRequest) GetStreamID() uint32 {
+	if x != nil {
+		return x.StreamID
+	}
+	return 0
+}
+
+func (x *NotifyTranscodingFailureRequest) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingFailureRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *NotifyTranscodingFailureRequest) GetLogs() string {
 	if x != nil {
-		return x.WorkerID
+		return x.Logs
 	}
 	return ""
 }
 
-func (x *TranscodingFinished) GetStreamID() uint32 {
+func (x *NotifyTranscodingFailureRequest) GetExitCode() int64 {
 	if x != nil {
-		return x.StreamID
+		return x.ExitCode
 	}
 	return 0
 }
 
-func (x *TranscodingFinished) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
+type NotifyTranscodingFailureResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *TranscodingFinished) GetDuration() uint32 {
-	if x != nil {
-		return x.Duration
+func (x *NotifyTranscodingFailureResponse) Reset() {
+	*x = NotifyTranscodingFailureResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_api_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return 0
 }
 
-func (x *TranscodingFinished) GetSourceType() string {
-	if x != nil {
-		return x.SourceType
+func (x *NotifyTranscodingFailureResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotifyTranscodingFailureResponse) ProtoMessage() {}
+
+func (x *NotifyTranscodingFailureResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-type UploadFinished struct {
+// Deprecated: Use NotifyTranscodingFailureResponse.ProtoReflect.Descriptor instead.
+func (*NotifyTranscodingFailureResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{41}
+}
+
+// NotifyUploadFailureRequest is sent when a vod couldn't be uploaded to the vod storage after all retries.
+type NotifyUploadFailureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID     string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID     uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	HLSUrl       string `protobuf:"bytes,4,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
-	SourceType   string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-	ThumbnailUrl string `protobuf:"bytes,6,opt,name=ThumbnailUrl,proto3" json:"ThumbnailUrl,omitempty"`
+	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
+	FilePath string `protobuf:"bytes,4,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	Error    string `protobuf:"bytes,5,opt,name=Error,proto3" json:"Error,omitempty"`
+	Attempts uint32 `protobuf:"varint,6,opt,name=Attempts,proto3" json:"Attempts,omitempty"`
 }
 
-func (x *UploadFinished) Reset() {
-	*x = UploadFinished{}
+func (x *NotifyUploadFailureRequest) Reset() {
+	*x = NotifyUploadFailureRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[22]
+		mi := &file_api_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UploadFinished) String() string {
+func (x *NotifyUploadFailureRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UploadFinished) ProtoMessage() {}
+func (*NotifyUploadFailureRequest) ProtoMessage() {}
 
-func (x *UploadFinished) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[22]
+func (x *NotifyUploadFailureRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1638,74 +3251,89 @@ func (x *UploadFinished) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UploadFinished.ProtoReflect.Descriptor instead.
-func (*UploadFinished) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use NotifyUploadFailureRequest.ProtoReflect.Descriptor instead.
+func (*NotifyUploadFailureRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UploadFinished) GetWorkerID() string {
+func (x *NotifyUploadFailureRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *UploadFinished) GetStreamID() uint32 {
+func (x *NotifyUploadFailureRequest) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *UploadFinished) GetHLSUrl() string {
+func (x *NotifyUploadFailureRequest) GetVersion() string {
 	if x != nil {
-		return x.HLSUrl
+		return x.Version
 	}
 	return ""
 }
 
-func (x *UploadFinished) GetSourceType() string {
+func (x *NotifyUploadFailureRequest) GetFilePath() string {
 	if x != nil {
-		return x.SourceType
+		return x.FilePath
 	}
 	return ""
 }
 
-func (x *UploadFinished) GetThumbnailUrl() string {
+func (x *NotifyUploadFailureRequest) GetError() string {
 	if x != nil {
-		return x.ThumbnailUrl
+		return x.Error
 	}
 	return ""
 }
 
-type StreamStarted struct {
+func (x *NotifyUploadFailureRequest) GetAttempts() uint32 {
+	if x != nil {
+		return x.Attempts
+	}
+	return 0
+}
+
+type RenderPipRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID   string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID   uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	HlsUrl     string `protobuf:"bytes,3,opt,name=HlsUrl,proto3" json:"HlsUrl,omitempty"`
-	SourceType string `protobuf:"bytes,5,opt,name=SourceType,proto3" json:"SourceType,omitempty"`
-}
-
-func (x *StreamStarted) Reset() {
-	*x = StreamStarted{}
+	WorkerID         string               `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	StreamID         uint32               `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	CourseSlug       string               `protobuf:"bytes,3,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
+	CourseTerm       string               `protobuf:"bytes,4,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
+	CourseYear       uint32               `protobuf:"varint,5,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
+	Start            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=Start,proto3" json:"Start,omitempty"`
+	End              *timestamp.Timestamp `protobuf:"bytes,7,opt,name=End,proto3" json:"End,omitempty"`
+	PublishVoD       bool                 `protobuf:"varint,8,opt,name=PublishVoD,proto3" json:"PublishVoD,omitempty"`
+	PresentationPath string               `protobuf:"bytes,9,opt,name=PresentationPath,proto3" json:"PresentationPath,omitempty"` // transcoded presentation
+	CameraPath       string               `protobuf:"bytes,10,opt,name=CameraPath,proto3" json:"CameraPath,omitempty"`            // transcoded camera
+	Position         string               `protobuf:"bytes,11,opt,name=Position,proto3" json:"Position,omitempty"`                // corner of the camera: top-left, top-right, bottom-left or bottom-right
+	Size             uint32               `protobuf:"varint,12,opt,name=Size,proto3" json:"Size,omitempty"`                       // width of the camera in percent of the presentation's width
+}
+
+func (x *RenderPipRequest) Reset() {
+	*x = RenderPipRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[23]
+		mi := &file_api_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *StreamStarted) String() string {
+func (x *RenderPipRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamStarted) ProtoMessage() {}
+func (*RenderPipRequest) ProtoMessage() {}
 
-func (x *StreamStarted) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[23]
+func (x *RenderPipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,136 +3344,122 @@ func (x *StreamStarted) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamStarted.ProtoReflect.Descriptor instead.
-func (*StreamStarted) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use RenderPipRequest.ProtoReflect.Descriptor instead.
+func (*RenderPipRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *StreamStarted) GetWorkerID() string {
+func (x *RenderPipRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *StreamStarted) GetStreamID() uint32 {
+func (x *RenderPipRequest) GetStreamID() uint32 {
 	if x != nil {
 		return x.StreamID
 	}
 	return 0
 }
 
-func (x *StreamStarted) GetHlsUrl() string {
+func (x *RenderPipRequest) GetCourseSlug() string {
 	if x != nil {
-		return x.HlsUrl
+		return x.CourseSlug
 	}
 	return ""
 }
 
-func (x *StreamStarted) GetSourceType() string {
+func (x *RenderPipRequest) GetCourseTerm() string {
 	if x != nil {
-		return x.SourceType
+		return x.CourseTerm
 	}
 	return ""
 }
 
-type SilenceResults struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID uint32   `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	Starts   []uint32 `protobuf:"varint,3,rep,packed,name=starts,proto3" json:"starts,omitempty"`
-	Ends     []uint32 `protobuf:"varint,4,rep,packed,name=ends,proto3" json:"ends,omitempty"`
-}
-
-func (x *SilenceResults) Reset() {
-	*x = SilenceResults{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[24]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *RenderPipRequest) GetCourseYear() uint32 {
+	if x != nil {
+		return x.CourseYear
 	}
+	return 0
 }
 
-func (x *SilenceResults) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RenderPipRequest) GetStart() *timestamp.Timestamp {
+	if x != nil {
+		return x.Start
+	}
+	return nil
 }
 
-func (*SilenceResults) ProtoMessage() {}
-
-func (x *SilenceResults) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[24]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *RenderPipRequest) GetEnd() *timestamp.Timestamp {
+	if x != nil {
+		return x.End
 	}
-	return mi.MessageOf(x)
+	return nil
 }
 
-// Deprecated: Use SilenceResults.ProtoReflect.Descriptor instead.
-func (*SilenceResults) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{24}
+func (x *RenderPipRequest) GetPublishVoD() bool {
+	if x != nil {
+		return x.PublishVoD
+	}
+	return false
 }
 
-func (x *SilenceResults) GetWorkerID() string {
+func (x *RenderPipRequest) GetPresentationPath() string {
 	if x != nil {
-		return x.WorkerID
+		return x.PresentationPath
 	}
 	return ""
 }
 
-func (x *SilenceResults) GetStreamID() uint32 {
+func (x *RenderPipRequest) GetCameraPath() string {
 	if x != nil {
-		return x.StreamID
+		return x.CameraPath
 	}
-	return 0
+	return ""
 }
 
-func (x *SilenceResults) GetStarts() []uint32 {
+func (x *RenderPipRequest) GetPosition() string {
 	if x != nil {
-		return x.Starts
+		return x.Position
 	}
-	return nil
+	return ""
 }
 
-func (x *SilenceResults) GetEnds() []uint32 {
+func (x *RenderPipRequest) GetSize() uint32 {
 	if x != nil {
-		return x.Ends
+		return x.Size
 	}
-	return nil
+	return 0
 }
 
-type GetStreamInfoForUploadRequest struct {
+type CombineThumbnailsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID  string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	UploadKey string `protobuf:"bytes,2,opt,name=UploadKey,proto3" json:"UploadKey,omitempty"`
+	PrimaryThumbnail   string `protobuf:"bytes,1,opt,name=PrimaryThumbnail,proto3" json:"PrimaryThumbnail,omitempty"`
+	SecondaryThumbnail string `protobuf:"bytes,2,opt,name=SecondaryThumbnail,proto3" json:"SecondaryThumbnail,omitempty"`
+	Path               string `protobuf:"bytes,3,opt,name=Path,proto3" json:"Path,omitempty"`
 }
 
-func (x *GetStreamInfoForUploadRequest) Reset() {
-	*x = GetStreamInfoForUploadRequest{}
+func (x *CombineThumbnailsRequest) Reset() {
+	*x = CombineThumbnailsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[25]
+		mi := &file_api_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStreamInfoForUploadRequest) String() string {
+func (x *CombineThumbnailsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamInfoForUploadRequest) ProtoMessage() {}
+func (*CombineThumbnailsRequest) ProtoMessage() {}
 
-func (x *GetStreamInfoForUploadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[25]
+func (x *CombineThumbnailsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1856,55 +3470,57 @@ func (x *GetStreamInfoForUploadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetStreamInfoForUploadRequest.ProtoReflect.Descriptor instead.
-func (*GetStreamInfoForUploadRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use CombineThumbnailsRequest.ProtoReflect.Descriptor instead.
+func (*CombineThumbnailsRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *GetStreamInfoForUploadRequest) GetWorkerID() string {
+func (x *CombineThumbnailsRequest) GetPrimaryThumbnail() string {
 	if x != nil {
-		return x.WorkerID
+		return x.PrimaryThumbnail
 	}
 	return ""
 }
 
-func (x *GetStreamInfoForUploadRequest) GetUploadKey() string {
+func (x *CombineThumbnailsRequest) GetSecondaryThumbnail() string {
 	if x != nil {
-		return x.UploadKey
+		return x.SecondaryThumbnail
 	}
 	return ""
 }
 
-type GetStreamInfoForUploadResponse struct {
+func (x *CombineThumbnailsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type CombineThumbnailsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	CourseSlug  string               `protobuf:"bytes,1,opt,name=CourseSlug,proto3" json:"CourseSlug,omitempty"`
-	CourseTerm  string               `protobuf:"bytes,2,opt,name=CourseTerm,proto3" json:"CourseTerm,omitempty"`
-	CourseYear  uint32               `protobuf:"varint,3,opt,name=CourseYear,proto3" json:"CourseYear,omitempty"`
-	StreamStart *timestamp.Timestamp `protobuf:"bytes,4,opt,name=StreamStart,proto3" json:"StreamStart,omitempty"`
-	StreamEnd   *timestamp.Timestamp `protobuf:"bytes,5,opt,name=StreamEnd,proto3" json:"StreamEnd,omitempty"`
-	StreamID    uint32               `protobuf:"varint,6,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	FilePath string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
 }
 
-func (x *GetStreamInfoForUploadResponse) Reset() {
-	*x = GetStreamInfoForUploadResponse{}
+func (x *CombineThumbnailsResponse) Reset() {
+	*x = CombineThumbnailsResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[26]
+		mi := &file_api_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetStreamInfoForUploadResponse) String() string {
+func (x *CombineThumbnailsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetStreamInfoForUploadResponse) ProtoMessage() {}
+func (*CombineThumbnailsResponse) ProtoMessage() {}
 
-func (x *GetStreamInfoForUploadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[26]
+func (x *CombineThumbnailsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1912,82 +3528,47 @@ func (x *GetStreamInfoForUploadResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use GetStreamInfoForUploadResponse.ProtoReflect.Descriptor instead.
-func (*GetStreamInfoForUploadResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{26}
-}
-
-func (x *GetStreamInfoForUploadResponse) GetCourseSlug() string {
-	if x != nil {
-		return x.CourseSlug
-	}
-	return ""
-}
-
-func (x *GetStreamInfoForUploadResponse) GetCourseTerm() string {
-	if x != nil {
-		return x.CourseTerm
-	}
-	return ""
-}
-
-func (x *GetStreamInfoForUploadResponse) GetCourseYear() uint32 {
-	if x != nil {
-		return x.CourseYear
-	}
-	return 0
-}
-
-func (x *GetStreamInfoForUploadResponse) GetStreamStart() *timestamp.Timestamp {
-	if x != nil {
-		return x.StreamStart
-	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *GetStreamInfoForUploadResponse) GetStreamEnd() *timestamp.Timestamp {
-	if x != nil {
-		return x.StreamEnd
-	}
-	return nil
+// Deprecated: Use CombineThumbnailsResponse.ProtoReflect.Descriptor instead.
+func (*CombineThumbnailsResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *GetStreamInfoForUploadResponse) GetStreamID() uint32 {
+func (x *CombineThumbnailsResponse) GetFilePath() string {
 	if x != nil {
-		return x.StreamID
+		return x.FilePath
 	}
-	return 0
+	return ""
 }
 
-type LivePreviewRequest struct {
+type ProbeSourcesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	HLSUrl   string `protobuf:"bytes,2,opt,name=HLSUrl,proto3" json:"HLSUrl,omitempty"`
+	WorkerID string   `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	Sources  []string `protobuf:"bytes,2,rep,name=Sources,proto3" json:"Sources,omitempty"` // e.g. 10.0.0.1/extron3, prefixed with rtsp:// unless the source has a scheme
 }
 
-func (x *LivePreviewRequest) Reset() {
-	*x = LivePreviewRequest{}
+func (x *ProbeSourcesRequest) Reset() {
+	*x = ProbeSourcesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[27]
+		mi := &file_api_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LivePreviewRequest) String() string {
+func (x *ProbeSourcesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivePreviewRequest) ProtoMessage() {}
+func (*ProbeSourcesRequest) ProtoMessage() {}
 
-func (x *LivePreviewRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[27]
+func (x *ProbeSourcesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1998,50 +3579,50 @@ func (x *LivePreviewRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LivePreviewRequest.ProtoReflect.Descriptor instead.
-func (*LivePreviewRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use ProbeSourcesRequest.ProtoReflect.Descriptor instead.
+func (*ProbeSourcesRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *LivePreviewRequest) GetWorkerID() string {
+func (x *ProbeSourcesRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *LivePreviewRequest) GetHLSUrl() string {
+func (x *ProbeSourcesRequest) GetSources() []string {
 	if x != nil {
-		return x.HLSUrl
+		return x.Sources
 	}
-	return ""
+	return nil
 }
 
-type LivePreviewResponse struct {
+type ProbeSourcesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	LiveThumb []byte `protobuf:"bytes,1,opt,name=LiveThumb,proto3" json:"LiveThumb,omitempty"`
+	Results []*ProbeSourcesResponse_Result `protobuf:"bytes,1,rep,name=Results,proto3" json:"Results,omitempty"`
 }
 
-func (x *LivePreviewResponse) Reset() {
-	*x = LivePreviewResponse{}
+func (x *ProbeSourcesResponse) Reset() {
+	*x = ProbeSourcesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[28]
+		mi := &file_api_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LivePreviewResponse) String() string {
+func (x *ProbeSourcesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LivePreviewResponse) ProtoMessage() {}
+func (*ProbeSourcesResponse) ProtoMessage() {}
 
-func (x *LivePreviewResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[28]
+func (x *ProbeSourcesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,48 +3633,49 @@ func (x *LivePreviewResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LivePreviewResponse.ProtoReflect.Descriptor instead.
-func (*LivePreviewResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use ProbeSourcesResponse.ProtoReflect.Descriptor instead.
+func (*ProbeSourcesResponse) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *LivePreviewResponse) GetLiveThumb() []byte {
+func (x *ProbeSourcesResponse) GetResults() []*ProbeSourcesResponse_Result {
 	if x != nil {
-		return x.LiveThumb
+		return x.Results
 	}
 	return nil
 }
 
-type NotifyTranscodingFailureRequest struct {
+type ArchivalRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	WorkerID string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
-	StreamID uint32 `protobuf:"varint,2,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
-	Version  string `protobuf:"bytes,3,opt,name=Version,proto3" json:"Version,omitempty"`
-	FilePath string `protobuf:"bytes,4,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
-	Logs     string `protobuf:"bytes,5,opt,name=Logs,proto3" json:"Logs,omitempty"`
-	ExitCode int64  `protobuf:"varint,6,opt,name=ExitCode,proto3" json:"ExitCode,omitempty"`
+	FileID   uint32 `protobuf:"varint,2,opt,name=FileID,proto3" json:"FileID,omitempty"`
+	StreamID uint32 `protobuf:"varint,3,opt,name=StreamID,proto3" json:"StreamID,omitempty"`
+	Path     string `protobuf:"bytes,4,opt,name=Path,proto3" json:"Path,omitempty"`          // H.264 VoD to re-encode
+	Codec    string `protobuf:"bytes,5,opt,name=Codec,proto3" json:"Codec,omitempty"`        // av1 or hevc
+	CRF      uint32 `protobuf:"varint,6,opt,name=CRF,proto3" json:"CRF,omitempty"`           // quality of the archive, 0 uses the default of the codec
+	Fallback bool   `protobuf:"varint,7,opt,name=Fallback,proto3" json:"Fallback,omitempty"` // keep an H.264 fallback at Path instead of removing the original
 }
 
-func (x *NotifyTranscodingFailureRequest) Reset() {
-	*x = NotifyTranscodingFailureRequest{}
+func (x *ArchivalRequest) Reset() {
+	*x = ArchivalRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[29]
+		mi := &file_api_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotifyTranscodingFailureRequest) String() string {
+func (x *ArchivalRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyTranscodingFailureRequest) ProtoMessage() {}
+func (*ArchivalRequest) ProtoMessage() {}
 
-func (x *NotifyTranscodingFailureRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[29]
+func (x *ArchivalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2104,76 +3686,91 @@ func (x *NotifyTranscodingFailureRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyTranscodingFailureRequest.ProtoReflect.Descriptor instead.
-func (*NotifyTranscodingFailureRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ArchivalRequest.ProtoReflect.Descriptor instead.
+func (*ArchivalRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *NotifyTranscodingFailureRequest) GetWorkerID() string {
+func (x *ArchivalRequest) GetWorkerID() string {
 	if x != nil {
 		return x.WorkerID
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingFailureRequest) GetStreamID() uint32 {
+func (x *ArchivalRequest) GetFileID() uint32 {
 	if x != nil {
-		return x.StreamID
+		return x.FileID
 	}
 	return 0
 }
 
-func (x *NotifyTranscodingFailureRequest) GetVersion() string {
+func (x *ArchivalRequest) GetStreamID() uint32 {
 	if x != nil {
-		return x.Version
+		return x.StreamID
 	}
-	return ""
+	return 0
 }
 
-func (x *NotifyTranscodingFailureRequest) GetFilePath() string {
+func (x *ArchivalRequest) GetPath() string {
 	if x != nil {
-		return x.FilePath
+		return x.Path
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingFailureRequest) GetLogs() string {
+func (x *ArchivalRequest) GetCodec() string {
 	if x != nil {
-		return x.Logs
+		return x.Codec
 	}
 	return ""
 }
 
-func (x *NotifyTranscodingFailureRequest) GetExitCode() int64 {
+func (x *ArchivalRequest) GetCRF() uint32 {
 	if x != nil {
-		return x.ExitCode
+		return x.CRF
 	}
 	return 0
 }
 
-type NotifyTranscodingFailureResponse struct {
+func (x *ArchivalRequest) GetFallback() bool {
+	if x != nil {
+		return x.Fallback
+	}
+	return false
+}
+
+type ArchivalFinished struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	WorkerID     string `protobuf:"bytes,1,opt,name=WorkerID,proto3" json:"WorkerID,omitempty"`
+	FileID       uint32 `protobuf:"varint,2,opt,name=FileID,proto3" json:"FileID,omitempty"`
+	ArchivePath  string `protobuf:"bytes,3,opt,name=ArchivePath,proto3" json:"ArchivePath,omitempty"`
+	OriginalSize int64  `protobuf:"varint,4,opt,name=OriginalSize,proto3" json:"OriginalSize,omitempty"` // in bytes
+	ArchiveSize  int64  `protobuf:"varint,5,opt,name=ArchiveSize,proto3" json:"ArchiveSize,omitempty"`   // in bytes
+	FallbackSize int64  `protobuf:"varint,6,opt,name=FallbackSize,proto3" json:"FallbackSize,omitempty"` // in bytes, 0 if the original was removed
+	Error        string `protobuf:"bytes,7,opt,name=Error,proto3" json:"Error,omitempty"`                // empty if the VoD was archived
 }
 
-func (x *NotifyTranscodingFailureResponse) Reset() {
-	*x = NotifyTranscodingFailureResponse{}
+func (x *ArchivalFinished) Reset() {
+	*x = ArchivalFinished{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[30]
+		mi := &file_api_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *NotifyTranscodingFailureResponse) String() string {
+func (x *ArchivalFinished) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*NotifyTranscodingFailureResponse) ProtoMessage() {}
+func (*ArchivalFinished) ProtoMessage() {}
 
-func (x *NotifyTranscodingFailureResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[30]
+func (x *ArchivalFinished) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2184,99 +3781,87 @@ func (x *NotifyTranscodingFailureResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use NotifyTranscodingFailureResponse.ProtoReflect.Descriptor instead.
-func (*NotifyTranscodingFailureResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{30}
-}
-
-type CombineThumbnailsRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	PrimaryThumbnail   string `protobuf:"bytes,1,opt,name=PrimaryThumbnail,proto3" json:"PrimaryThumbnail,omitempty"`
-	SecondaryThumbnail string `protobuf:"bytes,2,opt,name=SecondaryThumbnail,proto3" json:"SecondaryThumbnail,omitempty"`
-	Path               string `protobuf:"bytes,3,opt,name=Path,proto3" json:"Path,omitempty"`
+// Deprecated: Use ArchivalFinished.ProtoReflect.Descriptor instead.
+func (*ArchivalFinished) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *CombineThumbnailsRequest) Reset() {
-	*x = CombineThumbnailsRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[31]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *ArchivalFinished) GetWorkerID() string {
+	if x != nil {
+		return x.WorkerID
 	}
+	return ""
 }
 
-func (x *CombineThumbnailsRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *ArchivalFinished) GetFileID() uint32 {
+	if x != nil {
+		return x.FileID
+	}
+	return 0
 }
 
-func (*CombineThumbnailsRequest) ProtoMessage() {}
-
-func (x *CombineThumbnailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[31]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *ArchivalFinished) GetArchivePath() string {
+	if x != nil {
+		return x.ArchivePath
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use CombineThumbnailsRequest.ProtoReflect.Descriptor instead.
-func (*CombineThumbnailsRequest) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{31}
+func (x *ArchivalFinished) GetOriginalSize() int64 {
+	if x != nil {
+		return x.OriginalSize
+	}
+	return 0
 }
 
-func (x *CombineThumbnailsRequest) GetPrimaryThumbnail() string {
+func (x *ArchivalFinished) GetArchiveSize() int64 {
 	if x != nil {
-		return x.PrimaryThumbnail
+		return x.ArchiveSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CombineThumbnailsRequest) GetSecondaryThumbnail() string {
+func (x *ArchivalFinished) GetFallbackSize() int64 {
 	if x != nil {
-		return x.SecondaryThumbnail
+		return x.FallbackSize
 	}
-	return ""
+	return 0
 }
 
-func (x *CombineThumbnailsRequest) GetPath() string {
+func (x *ArchivalFinished) GetError() string {
 	if x != nil {
-		return x.Path
+		return x.Error
 	}
 	return ""
 }
 
-type CombineThumbnailsResponse struct {
+type CutRequest_Segment struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	FilePath string `protobuf:"bytes,1,opt,name=FilePath,proto3" json:"FilePath,omitempty"`
+	StartTime int64 `protobuf:"varint,1,opt,name=Start_time,json=StartTime,proto3" json:"Start_time,omitempty"` // milliseconds
+	EndTime   int64 `protobuf:"varint,2,opt,name=End_time,json=EndTime,proto3" json:"End_time,omitempty"`
+	Discard   bool  `protobuf:"varint,3,opt,name=Discard,proto3" json:"Discard,omitempty"`
 }
 
-func (x *CombineThumbnailsResponse) Reset() {
-	*x = CombineThumbnailsResponse{}
+func (x *CutRequest_Segment) Reset() {
+	*x = CutRequest_Segment{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[32]
+		mi := &file_api_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CombineThumbnailsResponse) String() string {
+func (x *CutRequest_Segment) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CombineThumbnailsResponse) ProtoMessage() {}
+func (*CutRequest_Segment) ProtoMessage() {}
 
-func (x *CombineThumbnailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[32]
+func (x *CutRequest_Segment) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2287,45 +3872,59 @@ func (x *CombineThumbnailsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CombineThumbnailsResponse.ProtoReflect.Descriptor instead.
-func (*CombineThumbnailsResponse) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use CutRequest_Segment.ProtoReflect.Descriptor instead.
+func (*CutRequest_Segment) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{8, 0}
 }
 
-func (x *CombineThumbnailsResponse) GetFilePath() string {
+func (x *CutRequest_Segment) GetStartTime() int64 {
 	if x != nil {
-		return x.FilePath
+		return x.StartTime
 	}
-	return ""
+	return 0
 }
 
-type CutRequest_Segment struct {
+func (x *CutRequest_Segment) GetEndTime() int64 {
+	if x != nil {
+		return x.EndTime
+	}
+	return 0
+}
+
+func (x *CutRequest_Segment) GetDiscard() bool {
+	if x != nil {
+		return x.Discard
+	}
+	return false
+}
+
+type ProbeSourcesResponse_Result struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	StartTime int64 `protobuf:"varint,1,opt,name=Start_time,json=StartTime,proto3" json:"Start_time,omitempty"` // milliseconds
-	EndTime   int64 `protobuf:"varint,2,opt,name=End_time,json=EndTime,proto3" json:"End_time,omitempty"`
-	Discard   bool  `protobuf:"varint,3,opt,name=Discard,proto3" json:"Discard,omitempty"`
+	Source    string `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Reachable bool   `protobuf:"varint,2,opt,name=Reachable,proto3" json:"Reachable,omitempty"`
+	Error     string `protobuf:"bytes,3,opt,name=Error,proto3" json:"Error,omitempty"` // if Reachable == false: why the source could not be reached
 }
 
-func (x *CutRequest_Segment) Reset() {
-	*x = CutRequest_Segment{}
+func (x *ProbeSourcesResponse_Result) Reset() {
+	*x = ProbeSourcesResponse_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_api_proto_msgTypes[33]
+		mi := &file_api_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CutRequest_Segment) String() string {
+func (x *ProbeSourcesResponse_Result) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CutRequest_Segment) ProtoMessage() {}
+func (*ProbeSourcesResponse_Result) ProtoMessage() {}
 
-func (x *CutRequest_Segment) ProtoReflect() protoreflect.Message {
-	mi := &file_api_proto_msgTypes[33]
+func (x *ProbeSourcesResponse_Result) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2336,30 +3935,30 @@ func (x *CutRequest_Segment) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CutRequest_Segment.ProtoReflect.Descriptor instead.
-func (*CutRequest_Segment) Descriptor() ([]byte, []int) {
-	return file_api_proto_rawDescGZIP(), []int{5, 0}
+// Deprecated: Use ProbeSourcesResponse_Result.ProtoReflect.Descriptor instead.
+func (*ProbeSourcesResponse_Result) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{47, 0}
 }
 
-func (x *CutRequest_Segment) GetStartTime() int64 {
+func (x *ProbeSourcesResponse_Result) GetSource() string {
 	if x != nil {
-		return x.StartTime
+		return x.Source
 	}
-	return 0
+	return ""
 }
 
-func (x *CutRequest_Segment) GetEndTime() int64 {
+func (x *ProbeSourcesResponse_Result) GetReachable() bool {
 	if x != nil {
-		return x.EndTime
+		return x.Reachable
 	}
-	return 0
+	return false
 }
 
-func (x *CutRequest_Segment) GetDiscard() bool {
+func (x *ProbeSourcesResponse_Result) GetError() string {
 	if x != nil {
-		return x.Discard
+		return x.Error
 	}
-	return false
+	return ""
 }
 
 var File_api_proto protoreflect.FileDescriptor
@@ -2368,261 +3967,423 @@ var file_api_proto_rawDesc = []byte{
 	0x0a, 0x09, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x03, 0x61, 0x70, 0x69,
 	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x2f, 0x0a, 0x19, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
+	0x6f, 0x22, 0x46, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0x8a, 0x01, 0x0a, 0x1a, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c,
+	0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x81, 0x01, 0x0a, 0x11, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x56, 0x6f, 0x64, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
+	0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x22, 0x2f, 0x0a, 0x19, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x34, 0x0a, 0x1c, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d,
+	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50,
+	0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68,
+	0x73, 0x22, 0xa2, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12,
 	0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61,
-	0x74, 0x68, 0x22, 0x34, 0x0a, 0x1c, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x05, 0x50, 0x61, 0x74, 0x68, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x18, 0x47, 0x65, 0x6e,
-	0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x65, 0x61, 0x63, 0x68,
-	0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54,
-	0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x30, 0x0a, 0x05, 0x73,
-	0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x53, 0x0a,
-	0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x72,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18,
-	0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x53, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x52,
-	0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73,
-	0x74, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65,
-	0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x59, 0x65, 0x61, 0x72, 0x12, 0x2e, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65,
-	0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x12, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
-	0x54, 0x65, 0x72, 0x6d, 0x12, 0x28, 0x0a, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6,
-	0x01, 0x0a, 0x0a, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x6c,
-	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12,
-	0x33, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
-	0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0x5d, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x53, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45, 0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a,
-	0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x22, 0x3d, 0x0a, 0x0b, 0x43, 0x75, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x41, 0x0a, 0x0f, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f,
-	0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x57, 0x61, 0x76,
-	0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a,
-	0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
-	0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0xc1, 0x03, 0x0a, 0x0d, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x24, 0x0a, 0x0d, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67,
+	0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72,
+	0x12, 0x22, 0x0a, 0x0c, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67,
+	0x54, 0x65, 0x72, 0x6d, 0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x22, 0x53, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x4d, 0x69, 0x6e, 0x75, 0x74, 0x65,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x07, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd9, 0x01, 0x0a, 0x1b,
+	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x50,
+	0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x50, 0x6c, 0x61, 0x79, 0x6c, 0x69, 0x73, 0x74, 0x55, 0x52, 0x4c, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x2e, 0x0a,
+	0x12, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x54,
+	0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x43, 0x6f, 0x75, 0x72, 0x73,
+	0x65, 0x54, 0x65, 0x61, 0x63, 0x68, 0x69, 0x6e, 0x67, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x28, 0x0a,
+	0x08, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x0c, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xf6, 0x01, 0x0a, 0x0a, 0x43, 0x75, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x05, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x08, 0x73, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x53, 0x65, 0x67, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x08, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x22, 0x0a,
+	0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x75, 0x6c,
+	0x74, 0x1a, 0x5d, 0x0a, 0x07, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x53, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x45,
+	0x6e, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x45,
+	0x6e, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64,
+	0x22, 0x3d, 0x0a, 0x0b, 0x43, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22,
+	0x41, 0x0a, 0x0f, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x46, 0x69,
+	0x6c, 0x65, 0x22, 0x2e, 0x0a, 0x10, 0x57, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f,
+	0x72, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f,
+	0x72, 0x6d, 0x22, 0xb7, 0x05, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1e,
+	0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x30,
+	0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e,
+	0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f,
+	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e,
+	0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16,
+	0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62,
+	0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
+	0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x4d, 0x69,
+	0x6e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x75,
+	0x64, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4e, 0x6f, 0x72,
+	0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x44, 0x65, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x44, 0x65, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x6f,
+	0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x14, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x0f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x45, 0x78, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c,
+	0x45, 0x78, 0x74, 0x72, 0x61, 0x63, 0x74, 0x41, 0x75, 0x64, 0x69, 0x6f, 0x22, 0xe9, 0x01, 0x0a,
+	0x0f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x12, 0x16, 0x0a, 0x06, 0x50, 0x72,
+	0x65, 0x73, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03,
+	0x47, 0x4f, 0x50, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x47, 0x4f, 0x50, 0x12, 0x10,
+	0x0a, 0x03, 0x46, 0x50, 0x53, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x46, 0x50, 0x53,
+	0x12, 0x1e, 0x0a, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x52, 0x65, 0x73, 0x6f, 0x6c, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x24, 0x0a, 0x0d, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72, 0x65, 0x46, 0x6c, 0x61, 0x67,
+	0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x48, 0x61, 0x72, 0x64, 0x77, 0x61, 0x72,
+	0x65, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x52, 0x46, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x03, 0x43, 0x52, 0x46, 0x22, 0x69, 0x0a, 0x0f, 0x54, 0x61, 0x6b, 0x65,
+	0x6f, 0x76, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63,
 	0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75,
-	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x6f, 0x75, 0x72, 0x63,
-	0x65, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
-	0x6c, 0x75, 0x67, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x30, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
-	0x56, 0x6f, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69,
-	0x73, 0x68, 0x56, 0x6f, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72,
-	0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61,
-	0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d,
+	0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x22, 0x93, 0x03, 0x0a, 0x0d, 0x53, 0x74, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a,
+	0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a,
+	0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12,
+	0x2c, 0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1e, 0x0a,
+	0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x12, 0x20, 0x0a,
+	0x0b, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12,
+	0x28, 0x0a, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72,
+	0x79, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x0f, 0x50, 0x72,
+	0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c,
+	0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d,
 	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
+	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18,
-	0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0xc1, 0x01,
-	0x0a, 0x0f, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x22, 0x6a, 0x0a,
+	0x10, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a,
-	0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53,
-	0x65, 0x72, 0x76, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67,
-	0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74,
-	0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72,
-	0x6c, 0x22, 0x6a, 0x0a, 0x10, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a,
-	0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0a, 0x44, 0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x22, 0x18, 0x0a,
-	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x02, 0x6f, 0x6b, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
-	0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a,
-	0x0a, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05,
-	0x52, 0x08, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x12, 0x4a, 0x6f,
-	0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61,
-	0x6d, 0x65, 0x22, 0x32, 0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x64, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57,
+	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x44, 0x69, 0x73,
+	0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x44,
+	0x69, 0x73, 0x63, 0x61, 0x72, 0x64, 0x56, 0x6f, 0x44, 0x22, 0x18, 0x0a, 0x06, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x6f, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x02, 0x6f, 0x6b, 0x22, 0xae, 0x02, 0x0a, 0x0c, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65,
+	0x61, 0x6c, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x07,
+	0x42, 0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x01, 0x52, 0x07, 0x42,
+	0x69, 0x74, 0x72, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x03, 0x46, 0x50, 0x53, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x03, 0x46, 0x50, 0x53, 0x12, 0x14, 0x0a, 0x05, 0x53, 0x70, 0x65, 0x65,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x53, 0x70, 0x65, 0x65, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06,
+	0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x44, 0x72, 0x6f, 0x70, 0x70, 0x65,
+	0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x44,
+	0x72, 0x6f, 0x70, 0x70, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x2a, 0x0a, 0x10,
+	0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x44, 0x75, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74,
+	0x65, 0x64, 0x46, 0x72, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x52, 0x65, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x73, 0x22, 0xac, 0x01, 0x0a, 0x0c, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a,
+	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x42, 0x6c, 0x61, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x42, 0x6c,
+	0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x46, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x53,
+	0x69, 0x6c, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x53, 0x69, 0x6c,
+	0x65, 0x6e, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x20, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x64,
+	0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x22, 0x46, 0x0a, 0x12, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x32,
+	0x0a, 0x13, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72,
+	0x49, 0x64, 0x22, 0x6d, 0x0a, 0x11, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4b, 0x65,
+	0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75,
+	0x67, 0x22, 0x80, 0x04, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c,
+	0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65,
+	0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
+	0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74,
+	0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65,
+	0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x44, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x56, 0x6f, 0x44,
+	0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x2e, 0x0a, 0x12,
+	0x4d, 0x69, 0x6e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x4d, 0x69, 0x6e, 0x53, 0x69, 0x6c,
+	0x65, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73,
+	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65,
+	0x6e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6e,
+	0x6f, 0x69, 0x73, 0x65, 0x12, 0x3e, 0x0a, 0x0f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x66,
+	0x69, 0x6c, 0x65, 0x52, 0x0f, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f,
+	0x66, 0x69, 0x6c, 0x65, 0x22, 0xfd, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65,
+	0x61, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x6f,
+	0x62, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65,
+	0x6d, 0x6f, 0x72, 0x79, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x6f,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x12, 0x34,
+	0x0a, 0x0c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x49, 0x6e, 0x46, 0x6c, 0x69,
+	0x67, 0x68, 0x74, 0x4a, 0x6f, 0x62, 0x52, 0x0c, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4a, 0x6f, 0x62, 0x73, 0x22, 0x73, 0x0a, 0x0b, 0x49, 0x6e, 0x46, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x4a, 0x6f, 0x62, 0x12, 0x12, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x34, 0x0a, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x07, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x74, 0x72,
+	0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57,
 	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x4b, 0x65, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53,
-	0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x22, 0xc8, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f,
-	0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65,
-	0x54, 0x65, 0x72, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72,
-	0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x56, 0x6f, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x56, 0x6f, 0x44, 0x12, 0x22, 0x0a, 0x0c, 0x49, 0x6e, 0x67, 0x65, 0x73, 0x74, 0x53, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x49, 0x6e, 0x67, 0x65,
-	0x73, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x55,
-	0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4f, 0x75, 0x74, 0x55, 0x72, 0x6c,
-	0x22, 0xc7, 0x01, 0x0a, 0x09, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x1a,
-	0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x4a, 0x6f, 0x62, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72,
-	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x50, 0x55, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x43, 0x50, 0x55, 0x12, 0x16, 0x0a, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x4d, 0x65, 0x6d, 0x6f, 0x72, 0x79, 0x12, 0x12,
-	0x0a, 0x04, 0x44, 0x69, 0x73, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x44, 0x69,
-	0x73, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x55, 0x70, 0x74, 0x69, 0x6d, 0x65, 0x22, 0x48, 0x0a, 0x0e, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a,
+	0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4c, 0x61,
+	0x72, 0x67, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x54, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0x83, 0x01, 0x0a, 0x0d, 0x41,
+	0x75, 0x64, 0x69, 0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
 	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
 	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65,
 	0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x44, 0x22, 0xd4, 0x01, 0x0a, 0x12, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61,
-	0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1a, 0x0a, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2e, 0x0a, 0x12, 0x4c,
-	0x61, 0x72, 0x67, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x4c, 0x61, 0x72, 0x67, 0x65, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x22, 0xa5, 0x01, 0x0a, 0x13,
-	0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73,
-	0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18,
+	0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68,
+	0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65,
+	0x22, 0xd0, 0x01, 0x0a, 0x13, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
+	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b,
+	0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x29, 0x0a, 0x08, 0x4c, 0x6f, 0x75, 0x64,
+	0x6e, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x52, 0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e,
+	0x65, 0x73, 0x73, 0x22, 0x5c, 0x0a, 0x08, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x12,
+	0x1e, 0x0a, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x01, 0x52, 0x0a, 0x49, 0x6e, 0x74, 0x65, 0x67, 0x72, 0x61, 0x74, 0x65, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x54, 0x72, 0x75, 0x65, 0x50, 0x65, 0x61,
+	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x01, 0x52, 0x08, 0x54, 0x72, 0x75, 0x65, 0x50, 0x65, 0x61,
+	0x6b, 0x22, 0xbe, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69,
+	0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06,
+	0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x4c,
+	0x53, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79,
+	0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x61, 0x73, 0x68,
+	0x55, 0x72, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x61, 0x73, 0x68, 0x55,
+	0x72, 0x6c, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12,
 	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46,
-	0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x44, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
+	0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48,
+	0x6c, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6c, 0x73,
+	0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70,
 	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54,
-	0x79, 0x70, 0x65, 0x22, 0xa4, 0x01, 0x0a, 0x0e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72,
-	0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x16,
-	0x0a, 0x06, 0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x48, 0x4c, 0x53, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x53, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x55, 0x72, 0x6c, 0x22, 0x7f, 0x0a, 0x0d, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57,
-	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x48, 0x6c, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x53,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x53,
-	0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a,
-	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18,
-	0x03, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74,
-	0x73, 0x12, 0x16, 0x0a, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42,
-	0x02, 0x10, 0x01, 0x52, 0x04, 0x65, 0x6e, 0x64, 0x73, 0x22, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f,
-	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61,
-	0x64, 0x4b, 0x65, 0x79, 0x22, 0x94, 0x02, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73,
-	0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75,
-	0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45,
-	0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12,
-	0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x22, 0x48, 0x0a, 0x12, 0x4c,
+	0x79, 0x70, 0x65, 0x22, 0x7c, 0x0a, 0x0e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1a, 0x0a,
+	0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10,
+	0x01, 0x52, 0x06, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x12, 0x16, 0x0a, 0x04, 0x65, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x42, 0x02, 0x10, 0x01, 0x52, 0x04, 0x65, 0x6e, 0x64,
+	0x73, 0x22, 0x59, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e,
+	0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1c,
+	0x0a, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4b, 0x65, 0x79, 0x22, 0x8c, 0x03, 0x0a,
+	0x1e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f,
+	0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12,
+	0x1e, 0x0a, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12,
+	0x3c, 0x0a, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x0b, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x38, 0x0a,
+	0x09, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x53, 0x74,
+	0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61,
+	0x6d, 0x49, 0x44, 0x12, 0x2e, 0x0a, 0x12, 0x4d, 0x69, 0x6e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63,
+	0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x12, 0x4d, 0x69, 0x6e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x11, 0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11,
+	0x4e, 0x6f, 0x72, 0x6d, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4c, 0x6f, 0x75, 0x64, 0x6e, 0x65, 0x73,
+	0x73, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x65, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x44, 0x65, 0x6e, 0x6f, 0x69, 0x73, 0x65, 0x22, 0x48, 0x0a, 0x12, 0x4c,
 	0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20,
 	0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a,
@@ -2645,118 +4406,247 @@ var file_api_proto_rawDesc = []byte{
 	0x28, 0x03, 0x52, 0x08, 0x45, 0x78, 0x69, 0x74, 0x43, 0x6f, 0x64, 0x65, 0x22, 0x22, 0x0a, 0x20,
 	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e,
 	0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x8a, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d,
-	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a,
+	0x22, 0xbc, 0x01, 0x0a, 0x1a, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x1a, 0x0a, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x18, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72,
+	0x72, 0x6f, 0x72, 0x12, 0x1a, 0x0a, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x73, 0x22,
+	0xa6, 0x03, 0x0a, 0x10, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x69, 0x70, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49, 0x44,
+	0x12, 0x1a, 0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x53, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x54, 0x65, 0x72, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
+	0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0a, 0x43, 0x6f, 0x75, 0x72, 0x73, 0x65, 0x59, 0x65, 0x61, 0x72, 0x12, 0x30, 0x0a, 0x05,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x05, 0x53, 0x74, 0x61, 0x72, 0x74, 0x12, 0x2c,
+	0x0a, 0x03, 0x45, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x03, 0x45, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a,
+	0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x56, 0x6f, 0x44, 0x12, 0x2a, 0x0a, 0x10,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x74, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x0a, 0x0a, 0x43, 0x61, 0x6d, 0x65,
+	0x72, 0x61, 0x50, 0x61, 0x74, 0x68, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x43, 0x61,
+	0x6d, 0x65, 0x72, 0x61, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x50, 0x6f, 0x73, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x53, 0x69, 0x7a, 0x65, 0x22, 0x8a, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x6d,
+	0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
 	0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
-	0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79,
-	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79,
-	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74,
-	0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a,
-	0x19, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69,
-	0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x32, 0xb0, 0x05, 0x0a, 0x08, 0x54, 0x6f, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74,
-	0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61,
-	0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6c, 0x12, 0x2e, 0x0a, 0x12, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x68,
+	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x61, 0x72, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x50, 0x61, 0x74, 0x68, 0x22, 0x37, 0x0a, 0x19, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65,
+	0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x50, 0x61, 0x74, 0x68, 0x22, 0x4b,
+	0x0a, 0x13, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x49,
+	0x44, 0x12, 0x18, 0x0a, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x14,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3a, 0x0a, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x62,
+	0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x1a, 0x54, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x52, 0x65, 0x61, 0x63, 0x68, 0x61, 0x62, 0x6c, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xb9, 0x01, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f,
+	0x72, 0x6b, 0x65, 0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x1a,
+	0x0a, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x08, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x44, 0x12, 0x12, 0x0a, 0x04, 0x50, 0x61,
+	0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x50, 0x61, 0x74, 0x68, 0x12, 0x14,
+	0x0a, 0x05, 0x43, 0x6f, 0x64, 0x65, 0x63, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x43,
+	0x6f, 0x64, 0x65, 0x63, 0x12, 0x10, 0x0a, 0x03, 0x43, 0x52, 0x46, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x43, 0x52, 0x46, 0x12, 0x1a, 0x0a, 0x08, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61,
+	0x63, 0x6b, 0x22, 0xe8, 0x01, 0x0a, 0x10, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x46,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x49, 0x44, 0x12, 0x16, 0x0a, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x06, 0x46, 0x69, 0x6c, 0x65, 0x49, 0x44, 0x12, 0x20, 0x0a, 0x0b, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x74, 0x68, 0x12, 0x22, 0x0a,
+	0x0c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0c, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x61, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53, 0x69, 0x7a, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x53,
+	0x69, 0x7a, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x46, 0x61, 0x6c, 0x6c, 0x62,
+	0x61, 0x63, 0x6b, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x82, 0x09,
+	0x0a, 0x08, 0x54, 0x6f, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x0d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x12, 0x12, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x36,
+	0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72,
+	0x65, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x65, 0x6d, 0x69, 0x65, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x45, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
+	0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x57, 0x61, 0x76, 0x65, 0x66,
+	0x6f, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f,
+	0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x57, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x75, 0x74,
+	0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
+	0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69,
+	0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x43, 0x6f,
+	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75,
+	0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d,
+	0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x54, 0x61, 0x6b, 0x65, 0x6f,
+	0x76, 0x65, 0x72, 0x12, 0x14, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x61, 0x6b, 0x65, 0x6f, 0x76,
+	0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x35, 0x0a, 0x10, 0x53, 0x74, 0x69, 0x74,
+	0x63, 0x68, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x12, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x53, 0x74, 0x69, 0x74, 0x63, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
-	0x38, 0x0a, 0x10, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
-	0x45, 0x6e, 0x64, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x45, 0x6e, 0x64, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x40, 0x0a, 0x0f, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x12, 0x14, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x57, 0x61, 0x76, 0x65, 0x66, 0x6f, 0x72, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x57, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72,
-	0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x31, 0x0a, 0x0a, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x43, 0x75, 0x74, 0x12, 0x0f, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x43, 0x75, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x10, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x43, 0x75, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x42,
-	0x0a, 0x12, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e,
-	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x31, 0x0a, 0x09, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x69, 0x70, 0x12, 0x15, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x52, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x50, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x22, 0x00, 0x12, 0x4a, 0x0a, 0x13, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x4c, 0x69,
-	0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65, 0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4c, 0x69, 0x76, 0x65, 0x50, 0x72, 0x65,
-	0x76, 0x69, 0x65, 0x77, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e,
-	0x0a, 0x15, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x12, 0x20, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65,
-	0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6d, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x53, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x11, 0x43, 0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68,
-	0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43,
-	0x6f, 0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x43, 0x6f,
-	0x6d, 0x62, 0x69, 0x6e, 0x65, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xe9, 0x06, 0x0a, 0x0a, 0x46, 0x72,
-	0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f,
-	0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0d,
-	0x53, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x48, 0x65, 0x61, 0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x1a, 0x0b, 0x2e,
-	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e,
-	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-	0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x28,
-	0x01, 0x12, 0x44, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73,
-	0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x18,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-	0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12,
-	0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73,
-	0x75, 0x6c, 0x74, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x0b,
-	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a,
-	0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e,
-	0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69,
-	0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54,
-	0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
-	0x64, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
-	0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15, 0x53, 0x65, 0x6e,
-	0x64, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x61, 0x70, 0x69,
-	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
-	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x12,
-	0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49,
-	0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72,
-	0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x18, 0x4e, 0x6f,
+	0x22, 0x00, 0x12, 0x45, 0x0a, 0x0c, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63,
+	0x65, 0x73, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x19, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x12, 0x14, 0x2e, 0x61,
+	0x70, 0x69, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22,
+	0x00, 0x12, 0x35, 0x0a, 0x0b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x73,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x52, 0x61, 0x77, 0x52, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x61, 0x77, 0x52,
+	0x65, 0x63, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x31, 0x0a, 0x0a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x64, 0x73, 0x12, 0x16, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x56, 0x6f, 0x64, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x32, 0x9a, 0x09, 0x0a, 0x0a, 0x46, 0x72, 0x6f, 0x6d, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x42, 0x0a, 0x0b, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73,
+	0x12, 0x17, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x2e, 0x0a, 0x0d, 0x53, 0x65, 0x6e, 0x64, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x12, 0x0e, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x48, 0x65, 0x61,
+	0x72, 0x74, 0x42, 0x65, 0x61, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x53, 0x0a, 0x19, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x28, 0x01, 0x12, 0x44, 0x0a, 0x19, 0x4e, 0x6f,
 	0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61,
-	0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x61,
-	0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f,
-	0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x0b, 0x5a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f,
-	0x70, 0x62, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x18, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65,
+	0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00,
+	0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x69, 0x6c, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x69, 0x6c, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x1a, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x38, 0x0a, 0x13,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x53, 0x74, 0x61, 0x72,
+	0x74, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d,
+	0x53, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x13,
+	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x3a, 0x0a, 0x14, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x13, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a,
+	0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69,
+	0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x17, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x54, 0x68, 0x75, 0x6d, 0x62, 0x6e, 0x61, 0x69, 0x6c, 0x73, 0x46, 0x69, 0x6e, 0x69, 0x73,
+	0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x22, 0x00, 0x12, 0x38, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69,
+	0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x12, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x75, 0x64, 0x69, 0x6f, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x1a, 0x0b, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x4a, 0x0a, 0x15,
+	0x53, 0x65, 0x6e, 0x64, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x63, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x53,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x12, 0x22, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74, 0x72, 0x65,
+	0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x47, 0x65, 0x74,
+	0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x49, 0x6e, 0x66, 0x6f, 0x46, 0x6f, 0x72, 0x55, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x69, 0x0a,
+	0x18, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69,
+	0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x24, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e,
+	0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x54, 0x72, 0x61, 0x6e,
+	0x73, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x45, 0x0a, 0x13, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12,
+	0x1f, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x36, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x11, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x69, 0x67, 0x6e,
+	0x61, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x12, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x79, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68, 0x12, 0x11, 0x2e,
+	0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x48, 0x65, 0x61, 0x6c, 0x74, 0x68,
+	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x12,
+	0x3e, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61,
+	0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64, 0x12, 0x15, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x61, 0x6c, 0x46, 0x69, 0x6e, 0x69, 0x73, 0x68, 0x65, 0x64,
+	0x1a, 0x0b, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x00, 0x42,
+	0x0b, 0x5a, 0x09, 0x77, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x2f, 0x70, 0x62, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2771,102 +4661,158 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 34)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 52)
 var file_api_proto_goTypes = []interface{}{
-	(*DeleteSectionImageRequest)(nil),        // 0: api.DeleteSectionImageRequest
-	(*GenerateSectionImageResponse)(nil),     // 1: api.GenerateSectionImageResponse
-	(*GenerateThumbnailRequest)(nil),         // 2: api.GenerateThumbnailRequest
-	(*Section)(nil),                          // 3: api.Section
-	(*GenerateSectionImageRequest)(nil),      // 4: api.GenerateSectionImageRequest
-	(*CutRequest)(nil),                       // 5: api.CutRequest
-	(*CutResponse)(nil),                      // 6: api.CutResponse
-	(*WaveformRequest)(nil),                  // 7: api.WaveformRequest
-	(*WaveFormResponse)(nil),                 // 8: api.WaveFormResponse
-	(*StreamRequest)(nil),                    // 9: api.StreamRequest
-	(*PremiereRequest)(nil),                  // 10: api.PremiereRequest
-	(*EndStreamRequest)(nil),                 // 11: api.EndStreamRequest
-	(*Status)(nil),                           // 12: api.Status
-	(*NotifyTranscodingProgressRequest)(nil), // 13: api.NotifyTranscodingProgressRequest
-	(*JoinWorkersRequest)(nil),               // 14: api.JoinWorkersRequest
-	(*JoinWorkersResponse)(nil),              // 15: api.JoinWorkersResponse
-	(*SelfStreamRequest)(nil),                // 16: api.SelfStreamRequest
-	(*SelfStreamResponse)(nil),               // 17: api.SelfStreamResponse
-	(*HeartBeat)(nil),                        // 18: api.HeartBeat
-	(*StreamFinished)(nil),                   // 19: api.StreamFinished
-	(*ThumbnailsFinished)(nil),               // 20: api.ThumbnailsFinished
-	(*TranscodingFinished)(nil),              // 21: api.TranscodingFinished
-	(*UploadFinished)(nil),                   // 22: api.UploadFinished
-	(*StreamStarted)(nil),                    // 23: api.StreamStarted
-	(*SilenceResults)(nil),                   // 24: api.SilenceResults
-	(*GetStreamInfoForUploadRequest)(nil),    // 25: api.GetStreamInfoForUploadRequest
-	(*GetStreamInfoForUploadResponse)(nil),   // 26: api.GetStreamInfoForUploadResponse
-	(*LivePreviewRequest)(nil),               // 27: api.LivePreviewRequest
-	(*LivePreviewResponse)(nil),              // 28: api.LivePreviewResponse
-	(*NotifyTranscodingFailureRequest)(nil),  // 29: api.NotifyTranscodingFailureRequest
-	(*NotifyTranscodingFailureResponse)(nil), // 30: api.NotifyTranscodingFailureResponse
-	(*CombineThumbnailsRequest)(nil),         // 31: api.CombineThumbnailsRequest
-	(*CombineThumbnailsResponse)(nil),        // 32: api.CombineThumbnailsResponse
-	(*CutRequest_Segment)(nil),               // 33: api.CutRequest.Segment
-	(*timestamp.Timestamp)(nil),              // 34: google.protobuf.Timestamp
+	(*DeleteFilesRequest)(nil),               // 0: api.DeleteFilesRequest
+	(*DeleteRawRecordingsRequest)(nil),       // 1: api.DeleteRawRecordingsRequest
+	(*DeleteVodsRequest)(nil),                // 2: api.DeleteVodsRequest
+	(*DeleteSectionImageRequest)(nil),        // 3: api.DeleteSectionImageRequest
+	(*GenerateSectionImageResponse)(nil),     // 4: api.GenerateSectionImageResponse
+	(*GenerateThumbnailRequest)(nil),         // 5: api.GenerateThumbnailRequest
+	(*Section)(nil),                          // 6: api.Section
+	(*GenerateSectionImageRequest)(nil),      // 7: api.GenerateSectionImageRequest
+	(*CutRequest)(nil),                       // 8: api.CutRequest
+	(*CutResponse)(nil),                      // 9: api.CutResponse
+	(*WaveformRequest)(nil),                  // 10: api.WaveformRequest
+	(*WaveFormResponse)(nil),                 // 11: api.WaveFormResponse
+	(*StreamRequest)(nil),                    // 12: api.StreamRequest
+	(*EncodingProfile)(nil),                  // 13: api.EncodingProfile
+	(*TakeoverRequest)(nil),                  // 14: api.TakeoverRequest
+	(*StitchRequest)(nil),                    // 15: api.StitchRequest
+	(*PremiereRequest)(nil),                  // 16: api.PremiereRequest
+	(*EndStreamRequest)(nil),                 // 17: api.EndStreamRequest
+	(*Status)(nil),                           // 18: api.Status
+	(*StreamHealth)(nil),                     // 19: api.StreamHealth
+	(*SignalStatus)(nil),                     // 20: api.SignalStatus
+	(*NotifyTranscodingProgressRequest)(nil), // 21: api.NotifyTranscodingProgressRequest
+	(*JoinWorkersRequest)(nil),               // 22: api.JoinWorkersRequest
+	(*JoinWorkersResponse)(nil),              // 23: api.JoinWorkersResponse
+	(*SelfStreamRequest)(nil),                // 24: api.SelfStreamRequest
+	(*SelfStreamResponse)(nil),               // 25: api.SelfStreamResponse
+	(*HeartBeat)(nil),                        // 26: api.HeartBeat
+	(*InFlightJob)(nil),                      // 27: api.InFlightJob
+	(*StreamFinished)(nil),                   // 28: api.StreamFinished
+	(*ThumbnailsFinished)(nil),               // 29: api.ThumbnailsFinished
+	(*AudioFinished)(nil),                    // 30: api.AudioFinished
+	(*TranscodingFinished)(nil),              // 31: api.TranscodingFinished
+	(*Loudness)(nil),                         // 32: api.Loudness
+	(*UploadFinished)(nil),                   // 33: api.UploadFinished
+	(*StreamStarted)(nil),                    // 34: api.StreamStarted
+	(*SilenceResults)(nil),                   // 35: api.SilenceResults
+	(*GetStreamInfoForUploadRequest)(nil),    // 36: api.GetStreamInfoForUploadRequest
+	(*GetStreamInfoForUploadResponse)(nil),   // 37: api.GetStreamInfoForUploadResponse
+	(*LivePreviewRequest)(nil),               // 38: api.LivePreviewRequest
+	(*LivePreviewResponse)(nil),              // 39: api.LivePreviewResponse
+	(*NotifyTranscodingFailureRequest)(nil),  // 40: api.NotifyTranscodingFailureRequest
+	(*NotifyTranscodingFailureResponse)(nil), // 41: api.NotifyTranscodingFailureResponse
+	(*NotifyUploadFailureRequest)(nil),       // 42: api.NotifyUploadFailureRequest
+	(*RenderPipRequest)(nil),                 // 43: api.RenderPipRequest
+	(*CombineThumbnailsRequest)(nil),         // 44: api.CombineThumbnailsRequest
+	(*CombineThumbnailsResponse)(nil),        // 45: api.CombineThumbnailsResponse
+	(*ProbeSourcesRequest)(nil),              // 46: api.ProbeSourcesRequest
+	(*ProbeSourcesResponse)(nil),             // 47: api.ProbeSourcesResponse
+	(*ArchivalRequest)(nil),                  // 48: api.ArchivalRequest
+	(*ArchivalFinished)(nil),                 // 49: api.ArchivalFinished
+	(*CutRequest_Segment)(nil),               // 50: api.CutRequest.Segment
+	(*ProbeSourcesResponse_Result)(nil),      // 51: api.ProbeSourcesResponse.Result
+	(*timestamp.Timestamp)(nil),              // 52: google.protobuf.Timestamp
 }
 var file_api_proto_depIdxs = []int32{
-	34, // 0: api.GenerateThumbnailRequest.start:type_name -> google.protobuf.Timestamp
-	3,  // 1: api.GenerateSectionImageRequest.Sections:type_name -> api.Section
-	33, // 2: api.CutRequest.segments:type_name -> api.CutRequest.Segment
-	34, // 3: api.StreamRequest.Start:type_name -> google.protobuf.Timestamp
-	34, // 4: api.StreamRequest.End:type_name -> google.protobuf.Timestamp
-	34, // 5: api.SelfStreamResponse.StreamStart:type_name -> google.protobuf.Timestamp
-	34, // 6: api.GetStreamInfoForUploadResponse.StreamStart:type_name -> google.protobuf.Timestamp
-	34, // 7: api.GetStreamInfoForUploadResponse.StreamEnd:type_name -> google.protobuf.Timestamp
-	9,  // 8: api.ToWorker.RequestStream:input_type -> api.StreamRequest
-	10, // 9: api.ToWorker.RequestPremiere:input_type -> api.PremiereRequest
-	11, // 10: api.ToWorker.RequestStreamEnd:input_type -> api.EndStreamRequest
-	7,  // 11: api.ToWorker.RequestWaveform:input_type -> api.WaveformRequest
-	5,  // 12: api.ToWorker.RequestCut:input_type -> api.CutRequest
-	2,  // 13: api.ToWorker.GenerateThumbnails:input_type -> api.GenerateThumbnailRequest
-	27, // 14: api.ToWorker.GenerateLivePreview:input_type -> api.LivePreviewRequest
-	4,  // 15: api.ToWorker.GenerateSectionImages:input_type -> api.GenerateSectionImageRequest
-	0,  // 16: api.ToWorker.DeleteSectionImage:input_type -> api.DeleteSectionImageRequest
-	31, // 17: api.ToWorker.CombineThumbnails:input_type -> api.CombineThumbnailsRequest
-	14, // 18: api.FromWorker.JoinWorkers:input_type -> api.JoinWorkersRequest
-	18, // 19: api.FromWorker.SendHeartBeat:input_type -> api.HeartBeat
-	13, // 20: api.FromWorker.NotifyTranscodingProgress:input_type -> api.NotifyTranscodingProgressRequest
-	21, // 21: api.FromWorker.NotifyTranscodingFinished:input_type -> api.TranscodingFinished
-	24, // 22: api.FromWorker.NotifySilenceResults:input_type -> api.SilenceResults
-	23, // 23: api.FromWorker.NotifyStreamStarted:input_type -> api.StreamStarted
-	19, // 24: api.FromWorker.NotifyStreamFinished:input_type -> api.StreamFinished
-	22, // 25: api.FromWorker.NotifyUploadFinished:input_type -> api.UploadFinished
-	20, // 26: api.FromWorker.NotifyThumbnailsFinished:input_type -> api.ThumbnailsFinished
-	16, // 27: api.FromWorker.SendSelfStreamRequest:input_type -> api.SelfStreamRequest
-	25, // 28: api.FromWorker.GetStreamInfoForUpload:input_type -> api.GetStreamInfoForUploadRequest
-	29, // 29: api.FromWorker.NotifyTranscodingFailure:input_type -> api.NotifyTranscodingFailureRequest
-	12, // 30: api.ToWorker.RequestStream:output_type -> api.Status
-	12, // 31: api.ToWorker.RequestPremiere:output_type -> api.Status
-	12, // 32: api.ToWorker.RequestStreamEnd:output_type -> api.Status
-	8,  // 33: api.ToWorker.RequestWaveform:output_type -> api.WaveFormResponse
-	6,  // 34: api.ToWorker.RequestCut:output_type -> api.CutResponse
-	12, // 35: api.ToWorker.GenerateThumbnails:output_type -> api.Status
-	28, // 36: api.ToWorker.GenerateLivePreview:output_type -> api.LivePreviewResponse
-	1,  // 37: api.ToWorker.GenerateSectionImages:output_type -> api.GenerateSectionImageResponse
-	12, // 38: api.ToWorker.DeleteSectionImage:output_type -> api.Status
-	32, // 39: api.ToWorker.CombineThumbnails:output_type -> api.CombineThumbnailsResponse
-	15, // 40: api.FromWorker.JoinWorkers:output_type -> api.JoinWorkersResponse
-	12, // 41: api.FromWorker.SendHeartBeat:output_type -> api.Status
-	12, // 42: api.FromWorker.NotifyTranscodingProgress:output_type -> api.Status
-	12, // 43: api.FromWorker.NotifyTranscodingFinished:output_type -> api.Status
-	12, // 44: api.FromWorker.NotifySilenceResults:output_type -> api.Status
-	12, // 45: api.FromWorker.NotifyStreamStarted:output_type -> api.Status
-	12, // 46: api.FromWorker.NotifyStreamFinished:output_type -> api.Status
-	12, // 47: api.FromWorker.NotifyUploadFinished:output_type -> api.Status
-	12, // 48: api.FromWorker.NotifyThumbnailsFinished:output_type -> api.Status
-	17, // 49: api.FromWorker.SendSelfStreamRequest:output_type -> api.SelfStreamResponse
-	26, // 50: api.FromWorker.GetStreamInfoForUpload:output_type -> api.GetStreamInfoForUploadResponse
-	30, // 51: api.FromWorker.NotifyTranscodingFailure:output_type -> api.NotifyTranscodingFailureResponse
-	30, // [30:52] is the sub-list for method output_type
-	8,  // [8:30] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	52, // 0: api.DeleteRawRecordingsRequest.Start:type_name -> google.protobuf.Timestamp
+	52, // 1: api.DeleteVodsRequest.Start:type_name -> google.protobuf.Timestamp
+	52, // 2: api.GenerateThumbnailRequest.start:type_name -> google.protobuf.Timestamp
+	6,  // 3: api.GenerateSectionImageRequest.Sections:type_name -> api.Section
+	50, // 4: api.CutRequest.segments:type_name -> api.CutRequest.Segment
+	52, // 5: api.StreamRequest.Start:type_name -> google.protobuf.Timestamp
+	52, // 6: api.StreamRequest.End:type_name -> google.protobuf.Timestamp
+	13, // 7: api.StreamRequest.EncodingProfile:type_name -> api.EncodingProfile
+	52, // 8: api.StitchRequest.Start:type_name -> google.protobuf.Timestamp
+	52, // 9: api.StitchRequest.End:type_name -> google.protobuf.Timestamp
+	52, // 10: api.SelfStreamResponse.StreamStart:type_name -> google.protobuf.Timestamp
+	13, // 11: api.SelfStreamResponse.EncodingProfile:type_name -> api.EncodingProfile
+	27, // 12: api.HeartBeat.InFlightJobs:type_name -> api.InFlightJob
+	52, // 13: api.InFlightJob.Started:type_name -> google.protobuf.Timestamp
+	32, // 14: api.TranscodingFinished.Loudness:type_name -> api.Loudness
+	52, // 15: api.GetStreamInfoForUploadResponse.StreamStart:type_name -> google.protobuf.Timestamp
+	52, // 16: api.GetStreamInfoForUploadResponse.StreamEnd:type_name -> google.protobuf.Timestamp
+	52, // 17: api.RenderPipRequest.Start:type_name -> google.protobuf.Timestamp
+	52, // 18: api.RenderPipRequest.End:type_name -> google.protobuf.Timestamp
+	51, // 19: api.ProbeSourcesResponse.Results:type_name -> api.ProbeSourcesResponse.Result
+	12, // 20: api.ToWorker.RequestStream:input_type -> api.StreamRequest
+	16, // 21: api.ToWorker.RequestPremiere:input_type -> api.PremiereRequest
+	17, // 22: api.ToWorker.RequestStreamEnd:input_type -> api.EndStreamRequest
+	10, // 23: api.ToWorker.RequestWaveform:input_type -> api.WaveformRequest
+	8,  // 24: api.ToWorker.RequestCut:input_type -> api.CutRequest
+	5,  // 25: api.ToWorker.GenerateThumbnails:input_type -> api.GenerateThumbnailRequest
+	38, // 26: api.ToWorker.GenerateLivePreview:input_type -> api.LivePreviewRequest
+	7,  // 27: api.ToWorker.GenerateSectionImages:input_type -> api.GenerateSectionImageRequest
+	3,  // 28: api.ToWorker.DeleteSectionImage:input_type -> api.DeleteSectionImageRequest
+	44, // 29: api.ToWorker.CombineThumbnails:input_type -> api.CombineThumbnailsRequest
+	14, // 30: api.ToWorker.RequestTakeover:input_type -> api.TakeoverRequest
+	15, // 31: api.ToWorker.StitchRecordings:input_type -> api.StitchRequest
+	43, // 32: api.ToWorker.RenderPip:input_type -> api.RenderPipRequest
+	46, // 33: api.ToWorker.ProbeSources:input_type -> api.ProbeSourcesRequest
+	48, // 34: api.ToWorker.RequestArchival:input_type -> api.ArchivalRequest
+	0,  // 35: api.ToWorker.DeleteFiles:input_type -> api.DeleteFilesRequest
+	1,  // 36: api.ToWorker.DeleteRawRecordings:input_type -> api.DeleteRawRecordingsRequest
+	2,  // 37: api.ToWorker.DeleteVods:input_type -> api.DeleteVodsRequest
+	22, // 38: api.FromWorker.JoinWorkers:input_type -> api.JoinWorkersRequest
+	26, // 39: api.FromWorker.SendHeartBeat:input_type -> api.HeartBeat
+	21, // 40: api.FromWorker.NotifyTranscodingProgress:input_type -> api.NotifyTranscodingProgressRequest
+	31, // 41: api.FromWorker.NotifyTranscodingFinished:input_type -> api.TranscodingFinished
+	35, // 42: api.FromWorker.NotifySilenceResults:input_type -> api.SilenceResults
+	34, // 43: api.FromWorker.NotifyStreamStarted:input_type -> api.StreamStarted
+	28, // 44: api.FromWorker.NotifyStreamFinished:input_type -> api.StreamFinished
+	33, // 45: api.FromWorker.NotifyUploadFinished:input_type -> api.UploadFinished
+	29, // 46: api.FromWorker.NotifyThumbnailsFinished:input_type -> api.ThumbnailsFinished
+	30, // 47: api.FromWorker.NotifyAudioFinished:input_type -> api.AudioFinished
+	24, // 48: api.FromWorker.SendSelfStreamRequest:input_type -> api.SelfStreamRequest
+	36, // 49: api.FromWorker.GetStreamInfoForUpload:input_type -> api.GetStreamInfoForUploadRequest
+	40, // 50: api.FromWorker.NotifyTranscodingFailure:input_type -> api.NotifyTranscodingFailureRequest
+	42, // 51: api.FromWorker.NotifyUploadFailure:input_type -> api.NotifyUploadFailureRequest
+	20, // 52: api.FromWorker.NotifySignalStatus:input_type -> api.SignalStatus
+	19, // 53: api.FromWorker.NotifyStreamHealth:input_type -> api.StreamHealth
+	49, // 54: api.FromWorker.NotifyArchivalFinished:input_type -> api.ArchivalFinished
+	18, // 55: api.ToWorker.RequestStream:output_type -> api.Status
+	18, // 56: api.ToWorker.RequestPremiere:output_type -> api.Status
+	18, // 57: api.ToWorker.RequestStreamEnd:output_type -> api.Status
+	11, // 58: api.ToWorker.RequestWaveform:output_type -> api.WaveFormResponse
+	9,  // 59: api.ToWorker.RequestCut:output_type -> api.CutResponse
+	18, // 60: api.ToWorker.GenerateThumbnails:output_type -> api.Status
+	39, // 61: api.ToWorker.GenerateLivePreview:output_type -> api.LivePreviewResponse
+	4,  // 62: api.ToWorker.GenerateSectionImages:output_type -> api.GenerateSectionImageResponse
+	18, // 63: api.ToWorker.DeleteSectionImage:output_type -> api.Status
+	45, // 64: api.ToWorker.CombineThumbnails:output_type -> api.CombineThumbnailsResponse
+	18, // 65: api.ToWorker.RequestTakeover:output_type -> api.Status
+	18, // 66: api.ToWorker.StitchRecordings:output_type -> api.Status
+	18, // 67: api.ToWorker.RenderPip:output_type -> api.Status
+	47, // 68: api.ToWorker.ProbeSources:output_type -> api.ProbeSourcesResponse
+	18, // 69: api.ToWorker.RequestArchival:output_type -> api.Status
+	18, // 70: api.ToWorker.DeleteFiles:output_type -> api.Status
+	18, // 71: api.ToWorker.DeleteRawRecordings:output_type -> api.Status
+	18, // 72: api.ToWorker.DeleteVods:output_type -> api.Status
+	23, // 73: api.FromWorker.JoinWorkers:output_type -> api.JoinWorkersResponse
+	18, // 74: api.FromWorker.SendHeartBeat:output_type -> api.Status
+	18, // 75: api.FromWorker.NotifyTranscodingProgress:output_type -> api.Status
+	18, // 76: api.FromWorker.NotifyTranscodingFinished:output_type -> api.Status
+	18, // 77: api.FromWorker.NotifySilenceResults:output_type -> api.Status
+	18, // 78: api.FromWorker.NotifyStreamStarted:output_type -> api.Status
+	18, // 79: api.FromWorker.NotifyStreamFinished:output_type -> api.Status
+	18, // 80: api.FromWorker.NotifyUploadFinished:output_type -> api.Status
+	18, // 81: api.FromWorker.NotifyThumbnailsFinished:output_type -> api.Status
+	18, // 82: api.FromWorker.NotifyAudioFinished:output_type -> api.Status
+	25, // 83: api.FromWorker.SendSelfStreamRequest:output_type -> api.SelfStreamResponse
+	37, // 84: api.FromWorker.GetStreamInfoForUpload:output_type -> api.GetStreamInfoForUploadResponse
+	41, // 85: api.FromWorker.NotifyTranscodingFailure:output_type -> api.NotifyTranscodingFailureResponse
+	18, // 86: api.FromWorker.NotifyUploadFailure:output_type -> api.Status
+	18, // 87: api.FromWorker.NotifySignalStatus:output_type -> api.Status
+	18, // 88: api.FromWorker.NotifyStreamHealth:output_type -> api.Status
+	18, // 89: api.FromWorker.NotifyArchivalFinished:output_type -> api.Status
+	55, // [55:90] is the sub-list for method output_type
+	20, // [20:55] is the sub-list for method input_type
+	20, // [20:20] is the sub-list for extension type_name
+	20, // [20:20] is the sub-list for extension extendee
+	0,  // [0:20] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -2876,7 +4822,7 @@ func file_api_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteSectionImageRequest); i {
+			switch v := v.(*DeleteFilesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2888,7 +4834,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateSectionImageResponse); i {
+			switch v := v.(*DeleteRawRecordingsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2900,7 +4846,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateThumbnailRequest); i {
+			switch v := v.(*DeleteVodsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2912,7 +4858,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Section); i {
+			switch v := v.(*DeleteSectionImageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2924,7 +4870,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GenerateSectionImageRequest); i {
+			switch v := v.(*GenerateSectionImageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2936,7 +4882,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CutRequest); i {
+			switch v := v.(*GenerateThumbnailRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2948,7 +4894,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CutResponse); i {
+			switch v := v.(*Section); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2960,7 +4906,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaveformRequest); i {
+			switch v := v.(*GenerateSectionImageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2972,7 +4918,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*WaveFormResponse); i {
+			switch v := v.(*CutRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2984,7 +4930,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamRequest); i {
+			switch v := v.(*CutResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2996,7 +4942,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PremiereRequest); i {
+			switch v := v.(*WaveformRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3008,7 +4954,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EndStreamRequest); i {
+			switch v := v.(*WaveFormResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3020,7 +4966,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Status); i {
+			switch v := v.(*StreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3032,7 +4978,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingProgressRequest); i {
+			switch v := v.(*EncodingProfile); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3044,7 +4990,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinWorkersRequest); i {
+			switch v := v.(*TakeoverRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3056,7 +5002,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinWorkersResponse); i {
+			switch v := v.(*StitchRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3068,7 +5014,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfStreamRequest); i {
+			switch v := v.(*PremiereRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3080,7 +5026,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfStreamResponse); i {
+			switch v := v.(*EndStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3092,7 +5038,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*HeartBeat); i {
+			switch v := v.(*Status); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3104,7 +5050,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamFinished); i {
+			switch v := v.(*StreamHealth); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3116,7 +5062,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ThumbnailsFinished); i {
+			switch v := v.(*SignalStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3128,7 +5074,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TranscodingFinished); i {
+			switch v := v.(*NotifyTranscodingProgressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3140,7 +5086,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UploadFinished); i {
+			switch v := v.(*JoinWorkersRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3152,7 +5098,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StreamStarted); i {
+			switch v := v.(*JoinWorkersResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3164,7 +5110,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SilenceResults); i {
+			switch v := v.(*SelfStreamRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3176,7 +5122,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamInfoForUploadRequest); i {
+			switch v := v.(*SelfStreamResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3188,7 +5134,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetStreamInfoForUploadResponse); i {
+			switch v := v.(*HeartBeat); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3200,7 +5146,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LivePreviewRequest); i {
+			switch v := v.(*InFlightJob); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3212,7 +5158,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*LivePreviewResponse); i {
+			switch v := v.(*StreamFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3224,7 +5170,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingFailureRequest); i {
+			switch v := v.(*ThumbnailsFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3236,7 +5182,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyTranscodingFailureResponse); i {
+			switch v := v.(*AudioFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3248,7 +5194,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineThumbnailsRequest); i {
+			switch v := v.(*TranscodingFinished); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3260,7 +5206,7 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CombineThumbnailsResponse); i {
+			switch v := v.(*Loudness); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3272,6 +5218,210 @@ func file_api_proto_init() {
 			}
 		}
 		file_api_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UploadFinished); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StreamStarted); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SilenceResults); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamInfoForUploadRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStreamInfoForUploadResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivePreviewRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*LivePreviewResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyTranscodingFailureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyTranscodingFailureResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyUploadFailureRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenderPipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineThumbnailsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CombineThumbnailsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeSourcesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeSourcesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchivalFinished); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_api_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CutRequest_Segment); i {
 			case 0:
 				return &v.state
@@ -3283,6 +5433,18 @@ func file_api_proto_init() {
 				return nil
 			}
 		}
+		file_api_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ProbeSourcesResponse_Result); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -3290,7 +5452,7 @@ func file_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_api_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   34,
+			NumMessages:   52,
 			NumExtensions: 0,
 			NumServices:   2,
 		},
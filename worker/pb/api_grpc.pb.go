@@ -33,6 +33,21 @@ type ToWorkerClient interface {
 	GenerateSectionImages(ctx context.Context, in *GenerateSectionImageRequest, opts ...grpc.CallOption) (*GenerateSectionImageResponse, error)
 	DeleteSectionImage(ctx context.Context, in *DeleteSectionImageRequest, opts ...grpc.CallOption) (*Status, error)
 	CombineThumbnails(ctx context.Context, in *CombineThumbnailsRequest, opts ...grpc.CallOption) (*CombineThumbnailsResponse, error)
+	RequestTakeover(ctx context.Context, in *TakeoverRequest, opts ...grpc.CallOption) (*Status, error)
+	// Stitches the partial recording of a failed worker with the recording of the standby that took over
+	StitchRecordings(ctx context.Context, in *StitchRequest, opts ...grpc.CallOption) (*Status, error)
+	// Renders the presentation with the camera as inset into an additional vod
+	RenderPip(ctx context.Context, in *RenderPipRequest, opts ...grpc.CallOption) (*Status, error)
+	// Checks whether the sources of a lecture hall are reachable from the worker
+	ProbeSources(ctx context.Context, in *ProbeSourcesRequest, opts ...grpc.CallOption) (*ProbeSourcesResponse, error)
+	// Re-encodes a VoD to an efficient codec for long term storage
+	RequestArchival(ctx context.Context, in *ArchivalRequest, opts ...grpc.CallOption) (*Status, error)
+	// Deletes recordings from the storage, e.g. because of the retention policy of their course
+	DeleteFiles(ctx context.Context, in *DeleteFilesRequest, opts ...grpc.CallOption) (*Status, error)
+	// Deletes the raw recordings of a stream from the temporary directory of the worker, transcoded recordings are kept
+	DeleteRawRecordings(ctx context.Context, in *DeleteRawRecordingsRequest, opts ...grpc.CallOption) (*Status, error)
+	// Deletes the vods of a stream from the vod storage of the worker
+	DeleteVods(ctx context.Context, in *DeleteVodsRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
 type toWorkerClient struct {
@@ -133,6 +148,78 @@ func (c *toWorkerClient) CombineThumbnails(ctx context.Context, in *CombineThumb
 	return out, nil
 }
 
+func (c *toWorkerClient) RequestTakeover(ctx context.Context, in *TakeoverRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/RequestTakeover", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) StitchRecordings(ctx context.Context, in *StitchRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/StitchRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) RenderPip(ctx context.Context, in *RenderPipRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/RenderPip", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) ProbeSources(ctx context.Context, in *ProbeSourcesRequest, opts ...grpc.CallOption) (*ProbeSourcesResponse, error) {
+	out := new(ProbeSourcesResponse)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/ProbeSources", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) RequestArchival(ctx context.Context, in *ArchivalRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/RequestArchival", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) DeleteFiles(ctx context.Context, in *DeleteFilesRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/DeleteFiles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) DeleteRawRecordings(ctx context.Context, in *DeleteRawRecordingsRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/DeleteRawRecordings", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *toWorkerClient) DeleteVods(ctx context.Context, in *DeleteVodsRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.ToWorker/DeleteVods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ToWorkerServer is the server API for ToWorker service.
 // All implementations must embed UnimplementedToWorkerServer
 // for forward compatibility
@@ -148,6 +235,21 @@ type ToWorkerServer interface {
 	GenerateSectionImages(context.Context, *GenerateSectionImageRequest) (*GenerateSectionImageResponse, error)
 	DeleteSectionImage(context.Context, *DeleteSectionImageRequest) (*Status, error)
 	CombineThumbnails(context.Context, *CombineThumbnailsRequest) (*CombineThumbnailsResponse, error)
+	RequestTakeover(context.Context, *TakeoverRequest) (*Status, error)
+	// Stitches the partial recording of a failed worker with the recording of the standby that took over
+	StitchRecordings(context.Context, *StitchRequest) (*Status, error)
+	// Renders the presentation with the camera as inset into an additional vod
+	RenderPip(context.Context, *RenderPipRequest) (*Status, error)
+	// Checks whether the sources of a lecture hall are reachable from the worker
+	ProbeSources(context.Context, *ProbeSourcesRequest) (*ProbeSourcesResponse, error)
+	// Re-encodes a VoD to an efficient codec for long term storage
+	RequestArchival(context.Context, *ArchivalRequest) (*Status, error)
+	// Deletes recordings from the storage, e.g. because of the retention policy of their course
+	DeleteFiles(context.Context, *DeleteFilesRequest) (*Status, error)
+	// Deletes the raw recordings of a stream from the temporary directory of the worker, transcoded recordings are kept
+	DeleteRawRecordings(context.Context, *DeleteRawRecordingsRequest) (*Status, error)
+	// Deletes the vods of a stream from the vod storage of the worker
+	DeleteVods(context.Context, *DeleteVodsRequest) (*Status, error)
 	mustEmbedUnimplementedToWorkerServer()
 }
 
@@ -185,6 +287,30 @@ func (UnimplementedToWorkerServer) DeleteSectionImage(context.Context, *DeleteSe
 func (UnimplementedToWorkerServer) CombineThumbnails(context.Context, *CombineThumbnailsRequest) (*CombineThumbnailsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CombineThumbnails not implemented")
 }
+func (UnimplementedToWorkerServer) RequestTakeover(context.Context, *TakeoverRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestTakeover not implemented")
+}
+func (UnimplementedToWorkerServer) StitchRecordings(context.Context, *StitchRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StitchRecordings not implemented")
+}
+func (UnimplementedToWorkerServer) RenderPip(context.Context, *RenderPipRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RenderPip not implemented")
+}
+func (UnimplementedToWorkerServer) ProbeSources(context.Context, *ProbeSourcesRequest) (*ProbeSourcesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProbeSources not implemented")
+}
+func (UnimplementedToWorkerServer) RequestArchival(context.Context, *ArchivalRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestArchival not implemented")
+}
+func (UnimplementedToWorkerServer) DeleteFiles(context.Context, *DeleteFilesRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFiles not implemented")
+}
+func (UnimplementedToWorkerServer) DeleteRawRecordings(context.Context, *DeleteRawRecordingsRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRawRecordings not implemented")
+}
+func (UnimplementedToWorkerServer) DeleteVods(context.Context, *DeleteVodsRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteVods not implemented")
+}
 func (UnimplementedToWorkerServer) mustEmbedUnimplementedToWorkerServer() {}
 
 // UnsafeToWorkerServer may be embedded to opt out of forward compatibility for this service.
@@ -378,6 +504,150 @@ func _ToWorker_CombineThumbnails_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ToWorker_RequestTakeover_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TakeoverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).RequestTakeover(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/RequestTakeover",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).RequestTakeover(ctx, req.(*TakeoverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_StitchRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StitchRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).StitchRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/StitchRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).StitchRecordings(ctx, req.(*StitchRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_RenderPip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RenderPipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).RenderPip(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/RenderPip",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).RenderPip(ctx, req.(*RenderPipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_ProbeSources_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProbeSourcesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).ProbeSources(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/ProbeSources",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).ProbeSources(ctx, req.(*ProbeSourcesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_RequestArchival_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).RequestArchival(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/RequestArchival",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).RequestArchival(ctx, req.(*ArchivalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_DeleteFiles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFilesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).DeleteFiles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/DeleteFiles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).DeleteFiles(ctx, req.(*DeleteFilesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_DeleteRawRecordings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRawRecordingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).DeleteRawRecordings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/DeleteRawRecordings",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).DeleteRawRecordings(ctx, req.(*DeleteRawRecordingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ToWorker_DeleteVods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteVodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ToWorkerServer).DeleteVods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.ToWorker/DeleteVods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ToWorkerServer).DeleteVods(ctx, req.(*DeleteVodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // ToWorker_ServiceDesc is the grpc.ServiceDesc for ToWorker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -425,6 +695,38 @@ var ToWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CombineThumbnails",
 			Handler:    _ToWorker_CombineThumbnails_Handler,
 		},
+		{
+			MethodName: "RequestTakeover",
+			Handler:    _ToWorker_RequestTakeover_Handler,
+		},
+		{
+			MethodName: "StitchRecordings",
+			Handler:    _ToWorker_StitchRecordings_Handler,
+		},
+		{
+			MethodName: "RenderPip",
+			Handler:    _ToWorker_RenderPip_Handler,
+		},
+		{
+			MethodName: "ProbeSources",
+			Handler:    _ToWorker_ProbeSources_Handler,
+		},
+		{
+			MethodName: "RequestArchival",
+			Handler:    _ToWorker_RequestArchival_Handler,
+		},
+		{
+			MethodName: "DeleteFiles",
+			Handler:    _ToWorker_DeleteFiles_Handler,
+		},
+		{
+			MethodName: "DeleteRawRecordings",
+			Handler:    _ToWorker_DeleteRawRecordings_Handler,
+		},
+		{
+			MethodName: "DeleteVods",
+			Handler:    _ToWorker_DeleteVods_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api.proto",
@@ -444,9 +746,17 @@ type FromWorkerClient interface {
 	NotifyStreamFinished(ctx context.Context, in *StreamFinished, opts ...grpc.CallOption) (*Status, error)
 	NotifyUploadFinished(ctx context.Context, in *UploadFinished, opts ...grpc.CallOption) (*Status, error)
 	NotifyThumbnailsFinished(ctx context.Context, in *ThumbnailsFinished, opts ...grpc.CallOption) (*Status, error)
+	NotifyAudioFinished(ctx context.Context, in *AudioFinished, opts ...grpc.CallOption) (*Status, error)
 	SendSelfStreamRequest(ctx context.Context, in *SelfStreamRequest, opts ...grpc.CallOption) (*SelfStreamResponse, error)
 	GetStreamInfoForUpload(ctx context.Context, in *GetStreamInfoForUploadRequest, opts ...grpc.CallOption) (*GetStreamInfoForUploadResponse, error)
 	NotifyTranscodingFailure(ctx context.Context, in *NotifyTranscodingFailureRequest, opts ...grpc.CallOption) (*NotifyTranscodingFailureResponse, error)
+	NotifyUploadFailure(ctx context.Context, in *NotifyUploadFailureRequest, opts ...grpc.CallOption) (*Status, error)
+	// Sent when the live signal of a source changes, e.g. the presentation turns black
+	NotifySignalStatus(ctx context.Context, in *SignalStatus, opts ...grpc.CallOption) (*Status, error)
+	// Sent periodically while a worker streams a source
+	NotifyStreamHealth(ctx context.Context, in *StreamHealth, opts ...grpc.CallOption) (*Status, error)
+	// Reports the result of the re-encoding of a VoD for long term storage
+	NotifyArchivalFinished(ctx context.Context, in *ArchivalFinished, opts ...grpc.CallOption) (*Status, error)
 }
 
 type fromWorkerClient struct {
@@ -563,6 +873,15 @@ func (c *fromWorkerClient) NotifyThumbnailsFinished(ctx context.Context, in *Thu
 	return out, nil
 }
 
+func (c *fromWorkerClient) NotifyAudioFinished(ctx context.Context, in *AudioFinished, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.FromWorker/NotifyAudioFinished", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *fromWorkerClient) SendSelfStreamRequest(ctx context.Context, in *SelfStreamRequest, opts ...grpc.CallOption) (*SelfStreamResponse, error) {
 	out := new(SelfStreamResponse)
 	err := c.cc.Invoke(ctx, "/api.FromWorker/SendSelfStreamRequest", in, out, opts...)
@@ -590,6 +909,42 @@ func (c *fromWorkerClient) NotifyTranscodingFailure(ctx context.Context, in *Not
 	return out, nil
 }
 
+func (c *fromWorkerClient) NotifyUploadFailure(ctx context.Context, in *NotifyUploadFailureRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.FromWorker/NotifyUploadFailure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fromWorkerClient) NotifySignalStatus(ctx context.Context, in *SignalStatus, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.FromWorker/NotifySignalStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fromWorkerClient) NotifyStreamHealth(ctx context.Context, in *StreamHealth, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.FromWorker/NotifyStreamHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *fromWorkerClient) NotifyArchivalFinished(ctx context.Context, in *ArchivalFinished, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, "/api.FromWorker/NotifyArchivalFinished", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // FromWorkerServer is the server API for FromWorker service.
 // All implementations must embed UnimplementedFromWorkerServer
 // for forward compatibility
@@ -604,9 +959,17 @@ type FromWorkerServer interface {
 	NotifyStreamFinished(context.Context, *StreamFinished) (*Status, error)
 	NotifyUploadFinished(context.Context, *UploadFinished) (*Status, error)
 	NotifyThumbnailsFinished(context.Context, *ThumbnailsFinished) (*Status, error)
+	NotifyAudioFinished(context.Context, *AudioFinished) (*Status, error)
 	SendSelfStreamRequest(context.Context, *SelfStreamRequest) (*SelfStreamResponse, error)
 	GetStreamInfoForUpload(context.Context, *GetStreamInfoForUploadRequest) (*GetStreamInfoForUploadResponse, error)
 	NotifyTranscodingFailure(context.Context, *NotifyTranscodingFailureRequest) (*NotifyTranscodingFailureResponse, error)
+	NotifyUploadFailure(context.Context, *NotifyUploadFailureRequest) (*Status, error)
+	// Sent when the live signal of a source changes, e.g. the presentation turns black
+	NotifySignalStatus(context.Context, *SignalStatus) (*Status, error)
+	// Sent periodically while a worker streams a source
+	NotifyStreamHealth(context.Context, *StreamHealth) (*Status, error)
+	// Reports the result of the re-encoding of a VoD for long term storage
+	NotifyArchivalFinished(context.Context, *ArchivalFinished) (*Status, error)
 	mustEmbedUnimplementedFromWorkerServer()
 }
 
@@ -641,6 +1004,9 @@ func (UnimplementedFromWorkerServer) NotifyUploadFinished(context.Context, *Uplo
 func (UnimplementedFromWorkerServer) NotifyThumbnailsFinished(context.Context, *ThumbnailsFinished) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyThumbnailsFinished not implemented")
 }
+func (UnimplementedFromWorkerServer) NotifyAudioFinished(context.Context, *AudioFinished) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyAudioFinished not implemented")
+}
 func (UnimplementedFromWorkerServer) SendSelfStreamRequest(context.Context, *SelfStreamRequest) (*SelfStreamResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SendSelfStreamRequest not implemented")
 }
@@ -650,6 +1016,18 @@ func (UnimplementedFromWorkerServer) GetStreamInfoForUpload(context.Context, *Ge
 func (UnimplementedFromWorkerServer) NotifyTranscodingFailure(context.Context, *NotifyTranscodingFailureRequest) (*NotifyTranscodingFailureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NotifyTranscodingFailure not implemented")
 }
+func (UnimplementedFromWorkerServer) NotifyUploadFailure(context.Context, *NotifyUploadFailureRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyUploadFailure not implemented")
+}
+func (UnimplementedFromWorkerServer) NotifySignalStatus(context.Context, *SignalStatus) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifySignalStatus not implemented")
+}
+func (UnimplementedFromWorkerServer) NotifyStreamHealth(context.Context, *StreamHealth) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyStreamHealth not implemented")
+}
+func (UnimplementedFromWorkerServer) NotifyArchivalFinished(context.Context, *ArchivalFinished) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NotifyArchivalFinished not implemented")
+}
 func (UnimplementedFromWorkerServer) mustEmbedUnimplementedFromWorkerServer() {}
 
 // UnsafeFromWorkerServer may be embedded to opt out of forward compatibility for this service.
@@ -833,6 +1211,24 @@ func _FromWorker_NotifyThumbnailsFinished_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FromWorker_NotifyAudioFinished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AudioFinished)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifyAudioFinished(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FromWorker/NotifyAudioFinished",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifyAudioFinished(ctx, req.(*AudioFinished))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _FromWorker_SendSelfStreamRequest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SelfStreamRequest)
 	if err := dec(in); err != nil {
@@ -887,6 +1283,78 @@ func _FromWorker_NotifyTranscodingFailure_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _FromWorker_NotifyUploadFailure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NotifyUploadFailureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifyUploadFailure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FromWorker/NotifyUploadFailure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifyUploadFailure(ctx, req.(*NotifyUploadFailureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FromWorker_NotifySignalStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SignalStatus)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifySignalStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FromWorker/NotifySignalStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifySignalStatus(ctx, req.(*SignalStatus))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FromWorker_NotifyStreamHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StreamHealth)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifyStreamHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FromWorker/NotifyStreamHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifyStreamHealth(ctx, req.(*StreamHealth))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FromWorker_NotifyArchivalFinished_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivalFinished)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FromWorkerServer).NotifyArchivalFinished(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/api.FromWorker/NotifyArchivalFinished",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FromWorkerServer).NotifyArchivalFinished(ctx, req.(*ArchivalFinished))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // FromWorker_ServiceDesc is the grpc.ServiceDesc for FromWorker service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -926,6 +1394,10 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyThumbnailsFinished",
 			Handler:    _FromWorker_NotifyThumbnailsFinished_Handler,
 		},
+		{
+			MethodName: "NotifyAudioFinished",
+			Handler:    _FromWorker_NotifyAudioFinished_Handler,
+		},
 		{
 			MethodName: "SendSelfStreamRequest",
 			Handler:    _FromWorker_SendSelfStreamRequest_Handler,
@@ -938,6 +1410,22 @@ var FromWorker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "NotifyTranscodingFailure",
 			Handler:    _FromWorker_NotifyTranscodingFailure_Handler,
 		},
+		{
+			MethodName: "NotifyUploadFailure",
+			Handler:    _FromWorker_NotifyUploadFailure_Handler,
+		},
+		{
+			MethodName: "NotifySignalStatus",
+			Handler:    _FromWorker_NotifySignalStatus_Handler,
+		},
+		{
+			MethodName: "NotifyStreamHealth",
+			Handler:    _FromWorker_NotifyStreamHealth_Handler,
+		},
+		{
+			MethodName: "NotifyArchivalFinished",
+			Handler:    _FromWorker_NotifyArchivalFinished_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
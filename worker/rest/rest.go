@@ -25,6 +25,7 @@ func InitApi(addr string) {
 	http.HandleFunc("/on_publish", streams.onPublish)
 	// this endpoint should **not** be exposed to the public!
 	http.HandleFunc("/upload", handleUpload)
+	http.HandleFunc("/upload/tus/", handleResumableUpload)
 	log.Fatal(http.ListenAndServe(addr, nil))
 }
 
@@ -1,14 +1,42 @@
 package rest
 
 import (
-	"github.com/joschahenningsen/TUM-Live/worker/worker"
-	log "github.com/sirupsen/logrus"
+	"bytes"
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash"
 	"io"
 	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	"github.com/joschahenningsen/TUM-Live/worker/worker"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	tusVersion = "1.0.0"
+	// statusChecksumMismatch is returned by tus servers if the checksum of a chunk doesn't match
+	statusChecksumMismatch = 460
 )
 
+var uploadKeyRe = regexp.MustCompile(`^[a-zA-Z0-9-]+$`)
+
+// errUploadChecksumMismatch is returned if the checksum of a complete upload doesn't match, the upload is discarded
+var errUploadChecksumMismatch = errors.New("checksum of upload doesn't match")
+
 // handleUpload handles VOD upload requests proxied by TUM-Live.
+// Deprecated: TUM-Live uses the resumable upload (handleResumableUpload) instead.
 func handleUpload(w http.ResponseWriter, r *http.Request) {
 	log.Info(r.URL.String())
 	_, f, err := r.FormFile("file")
@@ -37,3 +65,310 @@ func handleUpload(w http.ResponseWriter, r *http.Request) {
 	}
 	go worker.HandleUploadRestReq(r.URL.Query().Get("key"), out.Name())
 }
+
+// uploadSession is the state of a resumable upload. It is stored next to the uploaded data, so uploads can be resumed
+// after a restart of the worker. The offset of the upload is the size of the data file.
+type uploadSession struct {
+	Length   int64  `json:"length"`
+	Filename string `json:"filename"`
+	Checksum string `json:"checksum"` // optional hex encoded sha256 of the whole file
+}
+
+// uploadLock serializes the requests of an upload
+type uploadLock struct {
+	sync.Mutex
+	refs int // number of requests holding or waiting for the lock
+}
+
+// uploadLocks prevents concurrent writes to the same upload
+var uploadLocks = struct {
+	sync.Mutex
+	locks map[string]*uploadLock
+}{locks: map[string]*uploadLock{}}
+
+// lockUpload locks the upload and returns the function that unlocks it. The lock is removed as soon as no request
+// holds or waits for it, e.g. when the upload is finished or aborted.
+func lockUpload(key string) func() {
+	uploadLocks.Lock()
+	l, ok := uploadLocks.locks[key]
+	if !ok {
+		l = &uploadLock{}
+		uploadLocks.locks[key] = l
+	}
+	l.refs++
+	uploadLocks.Unlock()
+	l.Lock()
+	return func() {
+		l.Unlock()
+		uploadLocks.Lock()
+		defer uploadLocks.Unlock()
+		l.refs--
+		if l.refs == 0 {
+			delete(uploadLocks.locks, key)
+		}
+	}
+}
+
+// processUpload processes a finished upload like any other recording, replaced in tests
+var processUpload = worker.HandleUploadRestReq
+
+func uploadDir() string {
+	return filepath.Join(cfg.TempDir, "uploads")
+}
+
+func uploadDataPath(key string) string {
+	return filepath.Join(uploadDir(), key+".part")
+}
+
+func uploadInfoPath(key string) string {
+	return filepath.Join(uploadDir(), key+".json")
+}
+
+// handleResumableUpload implements the core of the tus protocol (https://tus.io/protocols/resumable-upload.html)
+// with the creation and checksum extension for VOD uploads proxied by TUM-Live: /upload/tus/<upload key>
+func handleResumableUpload(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Tus-Resumable", tusVersion)
+	key := strings.TrimPrefix(r.URL.Path, "/upload/tus/")
+	if !uploadKeyRe.MatchString(key) {
+		http.Error(w, "invalid upload key", http.StatusBadRequest)
+		return
+	}
+	if r.Method != http.MethodOptions && r.Header.Get("Tus-Resumable") != tusVersion {
+		http.Error(w, "unsupported tus version", http.StatusPreconditionFailed)
+		return
+	}
+	unlock := lockUpload(key)
+	defer unlock()
+
+	switch r.Method {
+	case http.MethodOptions:
+		w.Header().Set("Tus-Version", tusVersion)
+		w.Header().Set("Tus-Extension", "creation,checksum")
+		w.Header().Set("Tus-Checksum-Algorithm", "sha1,sha256")
+		w.WriteHeader(http.StatusNoContent)
+	case http.MethodPost:
+		createUpload(w, r, key)
+	case http.MethodHead:
+		headUpload(w, key)
+	case http.MethodPatch:
+		patchUpload(w, r, key)
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// createUpload starts a new upload session with the length from the Upload-Length header. A retried creation of the
+// same upload returns the existing session, creating a different upload with the same key is a conflict.
+func createUpload(w http.ResponseWriter, r *http.Request, key string) {
+	length, err := strconv.ParseInt(r.Header.Get("Upload-Length"), 10, 64)
+	if err != nil || length <= 0 {
+		http.Error(w, "invalid Upload-Length", http.StatusBadRequest)
+		return
+	}
+	metadata := parseUploadMetadata(r.Header.Get("Upload-Metadata"))
+	session := uploadSession{Length: length, Filename: filepath.Base(metadata["filename"]), Checksum: metadata["checksum"]}
+
+	if existing, _, err := getUploadSession(key); err == nil {
+		if existing.Length != session.Length || existing.Checksum != session.Checksum {
+			http.Error(w, "upload already exists", http.StatusConflict)
+			return
+		}
+		w.Header().Set("Location", r.URL.Path)
+		w.WriteHeader(http.StatusCreated)
+		return
+	}
+
+	if err = os.MkdirAll(uploadDir(), 0750); err != nil {
+		log.WithError(err).Error("Can't create upload directory")
+		http.Error(w, "can't create upload", http.StatusInternalServerError)
+		return
+	}
+	// the session is written last, data of a session that couldn't be created before is discarded
+	var f *os.File
+	f, err = os.OpenFile(uploadDataPath(key), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err == nil {
+		err = f.Close()
+	}
+	var info []byte
+	if err == nil {
+		info, err = json.Marshal(session)
+	}
+	if err == nil {
+		err = os.WriteFile(uploadInfoPath(key), info, 0640)
+	}
+	if err != nil {
+		log.WithError(err).WithField("key", key).Error("Can't create upload")
+		http.Error(w, "can't create upload", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Location", r.URL.Path)
+	w.WriteHeader(http.StatusCreated)
+}
+
+// headUpload returns the offset at which the upload can be resumed.
+func headUpload(w http.ResponseWriter, key string) {
+	session, offset, err := getUploadSession(key)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	w.Header().Set("Upload-Length", strconv.FormatInt(session.Length, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+// patchUpload appends a chunk to the upload. Chunks with an Upload-Checksum that doesn't match are discarded.
+// When the upload is complete, the file is verified and processed like any other recording.
+func patchUpload(w http.ResponseWriter, r *http.Request, key string) {
+	if r.Header.Get("Content-Type") != "application/offset+octet-stream" {
+		http.Error(w, "invalid Content-Type", http.StatusUnsupportedMediaType)
+		return
+	}
+	session, offset, err := getUploadSession(key)
+	if err != nil {
+		http.Error(w, "upload not found", http.StatusNotFound)
+		return
+	}
+	if requested, err := strconv.ParseInt(r.Header.Get("Upload-Offset"), 10, 64); err != nil || requested != offset {
+		w.Header().Set("Upload-Offset", strconv.FormatInt(offset, 10))
+		http.Error(w, "invalid Upload-Offset", http.StatusConflict)
+		return
+	}
+	var chunkHash hash.Hash
+	var expectedSum []byte
+	if checksum := r.Header.Get("Upload-Checksum"); checksum != "" {
+		chunkHash, expectedSum, err = parseUploadChecksum(checksum)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+	}
+
+	f, err := os.OpenFile(uploadDataPath(key), os.O_WRONLY|os.O_APPEND, 0640)
+	if err != nil {
+		http.Error(w, "can't open upload", http.StatusInternalServerError)
+		return
+	}
+	var dst io.Writer = f
+	if chunkHash != nil {
+		dst = io.MultiWriter(f, chunkHash)
+	}
+	// a dropped connection is fine, the client resumes at the offset reached so far.
+	written, copyErr := io.Copy(dst, io.LimitReader(r.Body, session.Length-offset))
+	if chunkHash != nil && (copyErr != nil || !bytes.Equal(chunkHash.Sum(nil), expectedSum)) {
+		written = 0
+		if err = f.Truncate(offset); err != nil {
+			log.WithError(err).WithField("key", key).Error("Can't discard corrupted chunk")
+		}
+	}
+	if err = f.Close(); err != nil {
+		http.Error(w, "can't write upload", http.StatusInternalServerError)
+		return
+	}
+	offset += written
+	w.Header().Set("Upload-Offset", strconv.FormatInt(offset, 10))
+	if chunkHash != nil && written == 0 {
+		w.WriteHeader(statusChecksumMismatch)
+		return
+	}
+
+	if offset == session.Length {
+		if err = finishUpload(key, session); errors.Is(err, errUploadChecksumMismatch) {
+			log.WithError(err).WithField("key", key).Error("Upload verification failed")
+			http.Error(w, err.Error(), statusChecksumMismatch)
+			return
+		} else if err != nil {
+			log.WithError(err).WithField("key", key).Error("Can't finish upload")
+			http.Error(w, "can't finish upload", http.StatusInternalServerError)
+			return
+		}
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// finishUpload verifies the checksum of the complete upload and hands it over to be processed.
+func finishUpload(key string, session uploadSession) error {
+	if session.Checksum != "" {
+		f, err := os.Open(uploadDataPath(key))
+		if err != nil {
+			return err
+		}
+		h := sha256.New()
+		_, err = io.Copy(h, f)
+		_ = f.Close()
+		if err != nil {
+			return err
+		}
+		if !strings.EqualFold(hex.EncodeToString(h.Sum(nil)), session.Checksum) {
+			_ = os.Remove(uploadDataPath(key))
+			_ = os.Remove(uploadInfoPath(key))
+			return errUploadChecksumMismatch
+		}
+	}
+	file := filepath.Join(uploadDir(), key+filepath.Ext(session.Filename))
+	if err := os.Rename(uploadDataPath(key), file); err != nil {
+		return err
+	}
+	_ = os.Remove(uploadInfoPath(key))
+	log.WithFields(log.Fields{"key": key, "file": file}).Info("Upload finished")
+	go processUpload(key, file)
+	return nil
+}
+
+// getUploadSession returns the session of an upload and its current offset
+func getUploadSession(key string) (session uploadSession, offset int64, err error) {
+	info, err := os.ReadFile(uploadInfoPath(key))
+	if err != nil {
+		return session, 0, err
+	}
+	if err = json.Unmarshal(info, &session); err != nil {
+		return session, 0, err
+	}
+	stat, err := os.Stat(uploadDataPath(key))
+	if err != nil {
+		return session, 0, err
+	}
+	return session, stat.Size(), nil
+}
+
+// parseUploadMetadata parses the Upload-Metadata header, a comma separated list of keys and base64 encoded values.
+func parseUploadMetadata(header string) map[string]string {
+	metadata := map[string]string{}
+	for _, pair := range strings.Split(header, ",") {
+		parts := strings.SplitN(strings.TrimSpace(pair), " ", 2)
+		if parts[0] == "" {
+			continue
+		}
+		value := ""
+		if len(parts) == 2 {
+			decoded, err := base64.StdEncoding.DecodeString(parts[1])
+			if err != nil {
+				continue
+			}
+			value = string(decoded)
+		}
+		metadata[parts[0]] = value
+	}
+	return metadata
+}
+
+// parseUploadChecksum parses the Upload-Checksum header: "<algorithm> <base64 encoded checksum>"
+func parseUploadChecksum(header string) (hash.Hash, []byte, error) {
+	parts := strings.SplitN(header, " ", 2)
+	if len(parts) != 2 {
+		return nil, nil, errors.New("invalid Upload-Checksum")
+	}
+	sum, err := base64.StdEncoding.DecodeString(parts[1])
+	if err != nil {
+		return nil, nil, errors.New("invalid Upload-Checksum")
+	}
+	switch parts[0] {
+	case "sha1":
+		return sha1.New(), sum, nil
+	case "sha256":
+		return sha256.New(), sum, nil
+	default:
+		return nil, nil, fmt.Errorf("unsupported checksum algorithm %s", parts[0])
+	}
+}
@@ -0,0 +1,280 @@
+package rest
+
+import (
+	"crypto/sha1"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+)
+
+const testUploadKey = "abc-123"
+
+// setupUpload uses a temporary upload directory and records the uploads handed over for processing
+func setupUpload(t *testing.T) chan string {
+	cfg.TempDir = t.TempDir()
+	processed := make(chan string, 1)
+	original := processUpload
+	processUpload = func(key string, file string) { processed <- file }
+	t.Cleanup(func() { processUpload = original })
+	return processed
+}
+
+func tusRequest(method string, body string, headers map[string]string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(method, "/upload/tus/"+testUploadKey, strings.NewReader(body))
+	req.Header.Set("Tus-Resumable", tusVersion)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+	rec := httptest.NewRecorder()
+	handleResumableUpload(rec, req)
+	return rec
+}
+
+func createTestUpload(t *testing.T, length int, metadata string) {
+	rec := tusRequest(http.MethodPost, "", map[string]string{"Upload-Length": strconv.Itoa(length), "Upload-Metadata": metadata})
+	checkReturnCode(t, rec, http.StatusCreated)
+}
+
+func patchTestUpload(offset int, chunk string, checksum string) *httptest.ResponseRecorder {
+	headers := map[string]string{"Content-Type": "application/offset+octet-stream", "Upload-Offset": strconv.Itoa(offset)}
+	if checksum != "" {
+		headers["Upload-Checksum"] = checksum
+	}
+	return tusRequest(http.MethodPatch, chunk, headers)
+}
+
+func checkOffset(t *testing.T, rec *httptest.ResponseRecorder, offset int) {
+	if got := rec.Header().Get("Upload-Offset"); got != strconv.Itoa(offset) {
+		t.Errorf("Upload-Offset = %s, want %d", got, offset)
+	}
+}
+
+func sha1Checksum(chunk string) string {
+	sum := sha1.Sum([]byte(chunk))
+	return "sha1 " + base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestCreateUpload(t *testing.T) {
+	t.Run("invalid length", func(t *testing.T) {
+		setupUpload(t)
+		rec := tusRequest(http.MethodPost, "", map[string]string{"Upload-Length": "-1"})
+		checkReturnCode(t, rec, http.StatusBadRequest)
+	})
+
+	t.Run("unsupported tus version", func(t *testing.T) {
+		setupUpload(t)
+		req := httptest.NewRequest(http.MethodPost, "/upload/tus/"+testUploadKey, nil)
+		req.Header.Set("Upload-Length", "10")
+		rec := httptest.NewRecorder()
+		handleResumableUpload(rec, req)
+		checkReturnCode(t, rec, http.StatusPreconditionFailed)
+	})
+
+	t.Run("created", func(t *testing.T) {
+		setupUpload(t)
+		metadata := "filename " + base64.StdEncoding.EncodeToString([]byte("../lecture.mp4"))
+		rec := tusRequest(http.MethodPost, "", map[string]string{"Upload-Length": "10", "Upload-Metadata": metadata})
+		checkReturnCode(t, rec, http.StatusCreated)
+		if got := rec.Header().Get("Location"); got != "/upload/tus/"+testUploadKey {
+			t.Errorf("Location = %s, want /upload/tus/%s", got, testUploadKey)
+		}
+		session, offset, err := getUploadSession(testUploadKey)
+		if err != nil {
+			t.Fatalf("getUploadSession() error = %v", err)
+		}
+		if want := (uploadSession{Length: 10, Filename: "lecture.mp4"}); session != want || offset != 0 {
+			t.Errorf("getUploadSession() = %+v, %d, want %+v, 0", session, offset, want)
+		}
+	})
+
+	t.Run("retried", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 10, "")
+		checkReturnCode(t, patchTestUpload(0, "hello", ""), http.StatusNoContent)
+
+		rec := tusRequest(http.MethodPost, "", map[string]string{"Upload-Length": "10"})
+		checkReturnCode(t, rec, http.StatusCreated)
+		if got := rec.Header().Get("Location"); got != "/upload/tus/"+testUploadKey {
+			t.Errorf("Location = %s, want /upload/tus/%s", got, testUploadKey)
+		}
+		if _, offset, _ := getUploadSession(testUploadKey); offset != 5 {
+			t.Errorf("offset = %d after retried creation, want 5", offset)
+		}
+	})
+
+	t.Run("conflict", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 10, "")
+		rec := tusRequest(http.MethodPost, "", map[string]string{"Upload-Length": "11"})
+		checkReturnCode(t, rec, http.StatusConflict)
+		if session, _, _ := getUploadSession(testUploadKey); session.Length != 10 {
+			t.Errorf("session = %+v after conflicting creation, want length 10", session)
+		}
+	})
+}
+
+func TestPatchUpload(t *testing.T) {
+	t.Run("unknown upload", func(t *testing.T) {
+		setupUpload(t)
+		checkReturnCode(t, patchTestUpload(0, "data", ""), http.StatusNotFound)
+	})
+
+	t.Run("offset mismatch", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 10, "")
+		checkReturnCode(t, patchTestUpload(0, "hello", ""), http.StatusNoContent)
+
+		rec := patchTestUpload(2, "llo", "")
+		checkReturnCode(t, rec, http.StatusConflict)
+		checkOffset(t, rec, 5)
+		if _, offset, _ := getUploadSession(testUploadKey); offset != 5 {
+			t.Errorf("offset = %d after conflicting chunk, want 5", offset)
+		}
+	})
+
+	t.Run("checksum mismatch", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 10, "")
+		checkReturnCode(t, patchTestUpload(0, "hello", sha1Checksum("hello")), http.StatusNoContent)
+
+		rec := patchTestUpload(5, "wrold", sha1Checksum("world"))
+		checkReturnCode(t, rec, statusChecksumMismatch)
+		checkOffset(t, rec, 5)
+		if data, _ := os.ReadFile(uploadDataPath(testUploadKey)); string(data) != "hello" {
+			t.Errorf("upload = %q, corrupted chunk must be discarded", data)
+		}
+	})
+
+	t.Run("invalid checksum", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 10, "")
+		checkReturnCode(t, patchTestUpload(0, "hello", "md5 abc"), http.StatusBadRequest)
+	})
+
+	t.Run("resumed", func(t *testing.T) {
+		processed := setupUpload(t)
+		content := "hello world"
+		sum := sha256.Sum256([]byte(content))
+		metadata := "filename " + base64.StdEncoding.EncodeToString([]byte("lecture.mp4")) +
+			",checksum " + base64.StdEncoding.EncodeToString([]byte(hex.EncodeToString(sum[:])))
+		createTestUpload(t, len(content), metadata)
+		checkReturnCode(t, patchTestUpload(0, "hello", sha1Checksum("hello")), http.StatusNoContent)
+
+		// the client lost the connection and asks where to continue
+		rec := tusRequest(http.MethodHead, "", nil)
+		checkReturnCode(t, rec, http.StatusOK)
+		checkOffset(t, rec, 5)
+		if got := rec.Header().Get("Upload-Length"); got != strconv.Itoa(len(content)) {
+			t.Errorf("Upload-Length = %s, want %d", got, len(content))
+		}
+
+		rec = patchTestUpload(5, " world", sha1Checksum(" world"))
+		checkReturnCode(t, rec, http.StatusNoContent)
+		checkOffset(t, rec, len(content))
+		file := <-processed
+		if data, err := os.ReadFile(file); err != nil || string(data) != content {
+			t.Errorf("processed upload = %q, %v, want %q", data, err, content)
+		}
+		if !strings.HasSuffix(file, testUploadKey+".mp4") {
+			t.Errorf("processed upload = %s, want extension of the uploaded file", file)
+		}
+		checkReturnCode(t, tusRequest(http.MethodHead, "", nil), http.StatusNotFound)
+	})
+}
+
+func TestFinishUpload(t *testing.T) {
+	t.Run("checksum mismatch", func(t *testing.T) {
+		setupUpload(t)
+		sum := sha256.Sum256([]byte("hello world"))
+		createTestUpload(t, 11, "checksum "+base64.StdEncoding.EncodeToString([]byte(hex.EncodeToString(sum[:]))))
+
+		rec := patchTestUpload(0, "hello wrold", "")
+		checkReturnCode(t, rec, statusChecksumMismatch)
+		if _, err := os.Stat(uploadDataPath(testUploadKey)); !os.IsNotExist(err) {
+			t.Errorf("corrupted upload must be removed")
+		}
+		if _, err := os.Stat(uploadInfoPath(testUploadKey)); !os.IsNotExist(err) {
+			t.Errorf("session of corrupted upload must be removed")
+		}
+	})
+
+	t.Run("processing fails", func(t *testing.T) {
+		setupUpload(t)
+		createTestUpload(t, 4, "filename "+base64.StdEncoding.EncodeToString([]byte("lecture.mp4")))
+		// the data can't be moved to the file that is processed
+		if err := os.Mkdir(filepath.Join(uploadDir(), testUploadKey+".mp4"), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filepath.Join(uploadDir(), testUploadKey+".mp4", "f"), nil, 0640); err != nil {
+			t.Fatal(err)
+		}
+		checkReturnCode(t, patchTestUpload(0, "data", ""), http.StatusInternalServerError)
+	})
+
+	t.Run("without checksum", func(t *testing.T) {
+		processed := setupUpload(t)
+		if err := os.MkdirAll(uploadDir(), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(uploadDataPath(testUploadKey), []byte("data"), 0640); err != nil {
+			t.Fatal(err)
+		}
+		if err := finishUpload(testUploadKey, uploadSession{Length: 4, Filename: "lecture.mkv"}); err != nil {
+			t.Fatalf("finishUpload() error = %v", err)
+		}
+		if file := <-processed; !strings.HasSuffix(file, testUploadKey+".mkv") {
+			t.Errorf("processed upload = %s, want extension of the uploaded file", file)
+		}
+	})
+}
+
+func TestUploadLocksArePruned(t *testing.T) {
+	setupUpload(t)
+	createTestUpload(t, 4, "")
+	checkReturnCode(t, patchTestUpload(0, "data", ""), http.StatusNoContent)
+	uploadLocks.Lock()
+	defer uploadLocks.Unlock()
+	if len(uploadLocks.locks) != 0 {
+		t.Errorf("uploadLocks = %v, want no locks after the upload finished", uploadLocks.locks)
+	}
+}
+
+func TestParseUploadMetadata(t *testing.T) {
+	tests := map[string]map[string]string{
+		"":                          {},
+		"filename bGVjdHVyZS5tcDQ=": {"filename": "lecture.mp4"},
+		"filename bGVjdHVyZS5tcDQ=, checksum YWJj, is_confidential": {"filename": "lecture.mp4", "checksum": "abc", "is_confidential": ""},
+		"filename %%%, checksum YWJj":                               {"checksum": "abc"},
+	}
+	for header, want := range tests {
+		if got := parseUploadMetadata(header); !reflect.DeepEqual(got, want) {
+			t.Errorf("parseUploadMetadata(%q) = %v, want %v", header, got, want)
+		}
+	}
+}
+
+func TestParseUploadChecksum(t *testing.T) {
+	h, sum, err := parseUploadChecksum(sha1Checksum("hello"))
+	expected := sha1.Sum([]byte("hello"))
+	if err != nil || h.Size() != sha1.Size || !reflect.DeepEqual(sum, expected[:]) {
+		t.Errorf("parseUploadChecksum(sha1) = %v, %x, %v", h, sum, err)
+	}
+	if h, _, err = parseUploadChecksum("sha256 YWJj"); err != nil || h.Size() != sha256.Size {
+		t.Errorf("parseUploadChecksum(sha256) = %v, %v", h, err)
+	}
+	for _, header := range []string{"", "sha1", "sha1 %%%", "md5 YWJj"} {
+		if _, _, err = parseUploadChecksum(header); err == nil {
+			t.Errorf("parseUploadChecksum(%q) = nil error, want error", header)
+		}
+	}
+}
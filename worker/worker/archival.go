@@ -0,0 +1,246 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// fallbackCRF is the quality of the H.264 fallback of archived VoDs, the quality camera recordings are transcoded with
+const fallbackCRF = 26
+
+// archivalEncoders are the ffmpeg video encoders of the archival codecs with their default quality
+var archivalEncoders = map[string]struct {
+	args []string
+	crf  uint32
+}{
+	"av1":  {args: []string{"-c:v", "libsvtav1", "-preset", "8"}, crf: 35},
+	"hevc": {args: []string{"-c:v", "libx265", "-preset", "medium", "-tag:v", "hvc1"}, crf: 28},
+}
+
+const (
+	archivalNotifyAttempts   = 30
+	archivalNotifyRetryDelay = time.Minute * 10 // results are reported for five hours, then again after a restart
+)
+
+// PendingArchival is the result of an archival that TUM-Live didn't store yet. The VoD is only replaced by its fallback
+// or removed afterwards, so the file of the VoD exists until then. Pending archivals are persisted, so they are
+// reported again after a restart of the worker.
+type PendingArchival struct {
+	FileID       uint32
+	Original     string // Original is the archived VoD
+	Fallback     string // Fallback replaces Original, Original is removed if there is no fallback
+	ArchivePath  string
+	OriginalSize int64
+	ArchiveSize  int64
+	FallbackSize int64
+	Error        string
+}
+
+// result returns the message reporting the archival to TUM-Live
+func (a PendingArchival) result() *pb.ArchivalFinished {
+	return &pb.ArchivalFinished{
+		WorkerID:     cfg.WorkerID,
+		FileID:       a.FileID,
+		ArchivePath:  a.ArchivePath,
+		OriginalSize: a.OriginalSize,
+		ArchiveSize:  a.ArchiveSize,
+		FallbackSize: a.FallbackSize,
+		Error:        a.Error,
+	}
+}
+
+// HandleArchivalRequest re-encodes a VoD to the archival codec and reports the result to TUM-Live
+func HandleArchivalRequest(request *pb.ArchivalRequest) {
+	if persisted.hasPendingArchival(request.GetFileID()) {
+		log.WithField("file", request.GetPath()).Info("Archival requested again, it's still reported")
+		return
+	}
+	var a PendingArchival
+	if _, err := os.Stat(archivePath(request.GetPath())); err == nil {
+		// the archival was requested again while another worker runs it or didn't report it yet
+		a = PendingArchival{FileID: request.GetFileID(), Original: request.GetPath(), Error: "archive already exists"}
+	} else {
+		streamCtx := &StreamContext{streamId: request.GetStreamID()}
+		S.startArchival(streamCtx, request.GetPath())
+		a = archive(request)
+		S.endArchival(streamCtx, request.GetPath())
+	}
+	if a.Error != "" {
+		log.WithFields(log.Fields{"file": request.GetPath(), "error": a.Error}).Warn("Archival failed")
+	}
+	if err := persisted.AddPendingArchival(a); err != nil {
+		log.WithError(err).Warn("Can't persist pending archival")
+	}
+	finishArchival(a)
+}
+
+// resumePendingArchivals reports archivals again that weren't stored by TUM-Live before a restart of the worker
+func resumePendingArchivals() {
+	persisted.mutex.Lock()
+	pending := append([]PendingArchival{}, persisted.PendingArchivals...)
+	persisted.mutex.Unlock()
+	for _, a := range pending {
+		log.WithField("file", a.Original).Info("Resuming archival")
+		go finishArchival(a)
+	}
+}
+
+// finishArchival reports the archival to TUM-Live until it's stored and replaces the VoD afterwards
+func finishArchival(a PendingArchival) {
+	for attempt := 1; ; attempt++ {
+		err := notifyArchivalDone(a.result())
+		if err == nil {
+			break
+		}
+		log.WithError(err).WithFields(log.Fields{"file": a.Original, "attempt": attempt}).Warn("Could not notify archival done")
+		if attempt >= archivalNotifyAttempts {
+			return // reported again after a restart
+		}
+		time.Sleep(archivalNotifyRetryDelay)
+	}
+	if err := replaceArchived(a); err != nil {
+		log.WithError(err).WithField("file", a.Original).Error("Can't replace archived VoD")
+	}
+	if err := persisted.RemovePendingArchival(a.FileID); err != nil {
+		log.WithError(err).Warn("Can't remove pending archival")
+	}
+}
+
+// replaceArchived replaces the VoD with its fallback or removes it, the archive is the VoD file then. Replacing it
+// again after a restart is a no-op.
+func replaceArchived(a PendingArchival) error {
+	if a.Error != "" {
+		return nil
+	}
+	var err error
+	if a.Fallback != "" {
+		err = os.Rename(a.Fallback, a.Original)
+	} else {
+		err = os.Remove(a.Original)
+	}
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	return nil
+}
+
+// archive re-encodes the VoD of the request into the archive directory next to it and compresses the H.264 fallback
+// of the same resolution if requested. The VoD itself is left untouched, it's replaced once the result is stored.
+// The archive is removed if it doesn't save space.
+func archive(request *pb.ArchivalRequest) PendingArchival {
+	a := PendingArchival{FileID: request.GetFileID(), Original: request.GetPath()}
+	fail := func(err error) PendingArchival {
+		a.Error = err.Error()
+		return a
+	}
+	info, err := os.Stat(a.Original)
+	if err != nil {
+		return fail(fmt.Errorf("stat original: %w", err))
+	}
+	a.OriginalSize = info.Size()
+
+	archived := archivePath(a.Original)
+	args, err := archivalArgs(request.GetCodec(), request.GetCRF(), a.Original, archived)
+	if err != nil {
+		return fail(err)
+	}
+	if err = prepare(archived); err != nil {
+		return fail(err)
+	}
+	if a.ArchiveSize, err = encodeArchival(args, archived); err != nil {
+		return fail(fmt.Errorf("encode archive: %w", err))
+	}
+	if a.ArchiveSize >= a.OriginalSize {
+		_ = os.Remove(archived)
+		return fail(errors.New("archive is not smaller than the original"))
+	}
+
+	if !request.GetFallback() {
+		a.ArchivePath = archived
+		return a
+	}
+	fallback := strings.TrimSuffix(a.Original, ".mp4") + "_fallback.mp4"
+	if a.FallbackSize, err = encodeArchival(fallbackArgs(a.Original, fallback), fallback); err != nil {
+		_ = os.Remove(archived)
+		return fail(fmt.Errorf("encode fallback: %w", err))
+	}
+	if a.ArchiveSize+a.FallbackSize >= a.OriginalSize {
+		_ = os.Remove(archived)
+		_ = os.Remove(fallback)
+		return fail(errors.New("archive and fallback are not smaller than the original"))
+	}
+	a.ArchivePath, a.Fallback = archived, fallback
+	return a
+}
+
+// encodeArchival runs ffmpeg with the lowest priority and returns the size of out
+func encodeArchival(args []string, out string) (int64, error) {
+	cmd := exec.Command("nice", append([]string{"-n", "19", "ffmpeg"}, args...)...)
+	log.WithField("command", cmd.String()).Info("Archiving")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(out)
+		return 0, fmt.Errorf("%w: %s", err, output)
+	}
+	info, err := os.Stat(out)
+	if err != nil {
+		return 0, err
+	}
+	return info.Size(), nil
+}
+
+// archivePath returns the path of the archive of a VoD, e.g. /mass/eidi/2021.W/archive/eidi_2021-09-23_10-00COMB.mp4
+func archivePath(vod string) string {
+	return filepath.Join(filepath.Dir(vod), "archive", filepath.Base(vod))
+}
+
+// archivalArgs returns the ffmpeg arguments re-encoding in to the codec. The audio is kept as is.
+func archivalArgs(codec string, crf uint32, in string, out string) ([]string, error) {
+	encoder, ok := archivalEncoders[codec]
+	if !ok {
+		return nil, fmt.Errorf("unknown archival codec: %s", codec)
+	}
+	if crf == 0 {
+		crf = encoder.crf
+	}
+	args := []string{"-nostats", "-loglevel", "error", "-y", "-i", in, "-map", "0:v:0", "-map", "0:a?"}
+	args = append(args, encoder.args...)
+	return append(args, "-crf", fmt.Sprintf("%d", crf), "-movflags", "+faststart", "-c:a", "copy", out), nil
+}
+
+// fallbackArgs returns the ffmpeg arguments compressing in to an H.264 fallback for players without support for the
+// archival codec. The fallback keeps the resolution of in, it replaces the VoD.
+func fallbackArgs(in string, out string) []string {
+	return []string{
+		"-nostats", "-loglevel", "error", "-y", "-i", in, "-map", "0:v:0", "-map", "0:a?",
+		"-c:v", "libx264", "-preset", "slow", "-crf", fmt.Sprintf("%d", fallbackCRF),
+		"-movflags", "+faststart", "-c:a", "copy", out,
+	}
+}
+
+func notifyArchivalDone(res *pb.ArchivalFinished) error {
+	client, conn, err := GetClient()
+	if err != nil {
+		return fmt.Errorf("dial tumlive: %w", err)
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := client.NotifyArchivalFinished(ctx, res)
+	if err != nil {
+		return err
+	}
+	if !resp.Ok {
+		return errors.New("archival result not stored")
+	}
+	return nil
+}
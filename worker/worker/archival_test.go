@@ -0,0 +1,112 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestArchivePath(t *testing.T) {
+	if got := archivePath("/mass/eidi/2021.W/eidi_2021-09-23_10-00COMB.mp4"); got != "/mass/eidi/2021.W/archive/eidi_2021-09-23_10-00COMB.mp4" {
+		t.Errorf("archivePath() = %s", got)
+	}
+}
+
+func TestArchivalArgs(t *testing.T) {
+	tests := map[string]struct {
+		crf      uint32
+		expected string
+	}{
+		"av1":  {0, "-c:v libsvtav1 -preset 8 -crf 35"},
+		"hevc": {24, "-c:v libx265 -preset medium -tag:v hvc1 -crf 24"},
+	}
+	for codec, test := range tests {
+		args, err := archivalArgs(codec, test.crf, "in.mp4", "out.mp4")
+		if err != nil {
+			t.Fatalf("archivalArgs(%s) failed: %v", codec, err)
+		}
+		joined := strings.Join(args, " ")
+		if !strings.Contains(joined, test.expected) {
+			t.Errorf("archivalArgs(%s) = %s, want %s", codec, joined, test.expected)
+		}
+		if !strings.Contains(joined, "-c:a copy") || args[len(args)-1] != "out.mp4" {
+			t.Errorf("archivalArgs(%s) must keep the audio and write to the output: %s", codec, joined)
+		}
+	}
+	if _, err := archivalArgs("vp9", 0, "in.mp4", "out.mp4"); err == nil {
+		t.Errorf("archivalArgs accepted an unknown codec")
+	}
+}
+
+func TestFallbackArgs(t *testing.T) {
+	args := fallbackArgs("in.mp4", "out.mp4")
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-c:v libx264 -preset slow -crf 26") {
+		t.Errorf("fallbackArgs() = %s, want H.264 with crf 26", joined)
+	}
+	// the fallback replaces the VoD, space must not be saved by lowering its resolution
+	if strings.Contains(joined, "scale") {
+		t.Errorf("fallbackArgs() must keep the resolution: %s", joined)
+	}
+	if !strings.Contains(joined, "-c:a copy") || args[len(args)-1] != "out.mp4" {
+		t.Errorf("fallbackArgs() must keep the audio and write to the output: %s", joined)
+	}
+}
+
+func TestReplaceArchived(t *testing.T) {
+	dir := t.TempDir()
+	original := filepath.Join(dir, "a.mp4")
+	fallback := filepath.Join(dir, "a_fallback.mp4")
+	if err := os.WriteFile(original, []byte("original"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(fallback, []byte("fallback"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := replaceArchived(PendingArchival{Original: original, Fallback: fallback, Error: "encode fallback"}); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(original); string(content) != "original" {
+		t.Errorf("failed archival replaced the VoD: %s", content)
+	}
+	a := PendingArchival{Original: original, Fallback: fallback}
+	if err := replaceArchived(a); err != nil {
+		t.Fatal(err)
+	}
+	if content, _ := os.ReadFile(original); string(content) != "fallback" {
+		t.Errorf("VoD not replaced by the fallback: %s", content)
+	}
+	// resumed after a restart
+	if err := replaceArchived(a); err != nil {
+		t.Errorf("replacing the VoD again: %v", err)
+	}
+	if err := replaceArchived(PendingArchival{Original: original}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := os.Stat(original); !os.IsNotExist(err) {
+		t.Errorf("VoD without fallback not removed: %v", err)
+	}
+}
+
+func TestPendingArchivals(t *testing.T) {
+	setupPersistable(t)
+	if err := persisted.AddPendingArchival(PendingArchival{FileID: 1, Original: "/mass/a.mp4"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := persisted.AddPendingArchival(PendingArchival{FileID: 2, Original: "/mass/b.mp4"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := persisted.RemovePendingArchival(1); err != nil {
+		t.Fatal(err)
+	}
+	// reported again after a restart
+	reloaded, err := NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if reloaded.hasPendingArchival(1) || !reloaded.hasPendingArchival(2) {
+		t.Errorf("unexpected pending archivals: %v", reloaded.PendingArchivals)
+	}
+}
@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+)
+
+// encoding of live streams for fields the encoding profile doesn't set
+const (
+	defaultCodec   = "libx264"
+	defaultPreset  = "veryfast" // only used with defaultCodec, presets differ between encoders
+	defaultBitrate = 2500       // kbit/s
+	defaultGOP     = 60
+	defaultFPS     = 30
+)
+
+// liveEncodingArgs returns the ffmpeg output arguments encoding the live stream for the ingest server
+func liveEncodingArgs(profile *pb.EncodingProfile) []string {
+	codec, preset := profile.GetCodec(), profile.GetPreset()
+	if codec == "" {
+		codec = defaultCodec
+		if preset == "" {
+			preset = defaultPreset
+		}
+	}
+	bitrate := profile.GetBitrate()
+	if bitrate == 0 {
+		bitrate = defaultBitrate
+	}
+	gop := profile.GetGOP()
+	if gop == 0 {
+		gop = defaultGOP
+	}
+	fps := profile.GetFPS()
+	if fps == 0 {
+		fps = defaultFPS
+	}
+
+	args := []string{"-c:v", codec}
+	if preset != "" {
+		args = append(args, "-preset", preset)
+	}
+	if codec == "libx264" {
+		args = append(args, "-tune", "zerolatency")
+	}
+	args = append(args,
+		"-maxrate", fmt.Sprintf("%dk", bitrate),
+		"-bufsize", fmt.Sprintf("%dk", bitrate*6/5),
+		"-g", fmt.Sprintf("%d", gop),
+		"-r", fmt.Sprintf("%d", fps))
+	if codec == "libx264" {
+		args = append(args, "-x264-params", fmt.Sprintf("keyint=%d:scenecut=0", gop))
+	}
+	if profile.GetResolution() != "" {
+		args = append(args, "-s", profile.GetResolution())
+	}
+	return append(args, "-c:a", "aac", "-ar", "44100", "-b:a", "128k")
+}
+
+// streamArgs returns the ffmpeg arguments recording the source to stdout for duration and, unless the worker is on
+// standby, pushing it to the ingest server.
+func streamArgs(streamCtx *StreamContext, duration time.Duration) []string {
+	args := []string{"-hide_banner", "-nostats", "-progress", "pipe:2"}
+	if !streamCtx.standby.Load() {
+		args = append(args, streamCtx.encoding.GetHardwareFlags()...)
+	}
+	if strings.Contains(streamCtx.sourceUrl, "rtsp") {
+		args = append(args, "-rtsp_transport", "tcp")
+	} else {
+		args = append(args, "-rw_timeout", "5000000")
+	}
+	// timeout ffmpeg when stream is finished
+	args = append(args, "-t", fmt.Sprintf("%.0f", duration.Seconds()), "-i", streamCtx.sourceUrl,
+		"-map", "0", "-c", "copy", "-f", "mpegts", "-")
+	if streamCtx.standby.Load() {
+		// standby workers only record the source until they take over the ingest
+		return args
+	}
+	args = append(args, liveEncodingArgs(streamCtx.encoding)...)
+	return append(args, "-f", "flv", fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName))
+}
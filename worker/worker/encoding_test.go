@@ -0,0 +1,52 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+)
+
+func TestLiveEncodingArgs(t *testing.T) {
+	tests := map[*pb.EncodingProfile]string{
+		nil: "-c:v libx264 -preset veryfast -tune zerolatency -maxrate 2500k -bufsize 3000k -g 60 -r 30 " +
+			"-x264-params keyint=60:scenecut=0 -c:a aac -ar 44100 -b:a 128k",
+		{Bitrate: 4000, GOP: 50, FPS: 25, Resolution: "1280x720"}: "-c:v libx264 -preset veryfast -tune zerolatency " +
+			"-maxrate 4000k -bufsize 4800k -g 50 -r 25 -x264-params keyint=50:scenecut=0 -s 1280x720 -c:a aac -ar 44100 -b:a 128k",
+		{Codec: "h264_nvenc"}: "-c:v h264_nvenc -maxrate 2500k -bufsize 3000k -g 60 -r 30 -c:a aac -ar 44100 -b:a 128k",
+		{Codec: "h264_nvenc", Preset: "p4"}: "-c:v h264_nvenc -preset p4 -maxrate 2500k -bufsize 3000k -g 60 -r 30 " +
+			"-c:a aac -ar 44100 -b:a 128k",
+	}
+	for profile, expected := range tests {
+		if got := strings.Join(liveEncodingArgs(profile), " "); got != expected {
+			t.Errorf("liveEncodingArgs(%v) = %s, want %s", profile, got, expected)
+		}
+	}
+}
+
+func TestStreamArgs(t *testing.T) {
+	streamCtx := &StreamContext{
+		sourceUrl:    "rtsp://10.0.0.4/extron3",
+		ingestServer: "rtmp://ingest.tum.live/live",
+		streamName:   "abc",
+		encoding:     &pb.EncodingProfile{Codec: "h264_nvenc", HardwareFlags: []string{"-hwaccel", "cuda"}},
+	}
+	joined := strings.Join(streamArgs(streamCtx, time.Hour), " ")
+	if !strings.HasPrefix(joined, "-hide_banner -nostats -progress pipe:2 -hwaccel cuda -rtsp_transport tcp -t 3600 -i rtsp://10.0.0.4/extron3 ") {
+		t.Errorf("hardware flags and input options must precede the input: %s", joined)
+	}
+	if !strings.Contains(joined, "-map 0 -c copy -f mpegts - -c:v h264_nvenc") {
+		t.Errorf("recording must be written to stdout: %s", joined)
+	}
+	if !strings.HasSuffix(joined, "-f flv rtmp://ingest.tum.live/live/abc") {
+		t.Errorf("stream must be pushed to the ingest server: %s", joined)
+	}
+
+	streamCtx.standby.Store(true)
+	streamCtx.sourceUrl = "https://10.0.0.4/stream.m3u8"
+	joined = strings.Join(streamArgs(streamCtx, time.Hour), " ")
+	if joined != "-hide_banner -nostats -progress pipe:2 -rw_timeout 5000000 -t 3600 -i https://10.0.0.4/stream.m3u8 -map 0 -c copy -f mpegts -" {
+		t.Errorf("standby workers must only record the source: %s", joined)
+	}
+}
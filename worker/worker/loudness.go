@@ -0,0 +1,123 @@
+package worker
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	loudnessTarget   = -23 // integrated loudness target in LUFS (EBU R128)
+	truePeakTarget   = -1  // maximum true peak in dBTP
+	loudnessRange    = 11  // loudness range target in LU
+	denoiseFilter    = "highpass=f=80,afftdn=nf=-25"
+	normalizedSRate  = "48000" // loudnorm upsamples to 192kHz, resample to the rate of the sources
+	loudnormSettings = "I=%d:TP=%d:LRA=%d"
+)
+
+var (
+	errNoLoudness      = errors.New("no loudness measurement in ffmpeg output")
+	errSilentRecording = errors.New("recording is silent")
+)
+
+// loudness is the EBU R128 loudness of a recording measured by ffmpeg's loudnorm filter
+type loudness struct {
+	Integrated float64 // integrated loudness in LUFS
+	Range      float64 // loudness range in LU
+	TruePeak   float64 // maximum true peak in dBTP
+	Threshold  float64 // gating threshold in LUFS
+	Offset     float64 // gain offset to reach the target in LU
+}
+
+// loudnormOutput is the json printed by the first pass of the loudnorm filter. ffmpeg prints all values as strings.
+type loudnormOutput struct {
+	InputI       string `json:"input_i"`
+	InputLRA     string `json:"input_lra"`
+	InputTP      string `json:"input_tp"`
+	InputThresh  string `json:"input_thresh"`
+	TargetOffset string `json:"target_offset"`
+}
+
+// measureLoudness measures the loudness of the audio of in. If denoise is set, the loudness is measured after noise
+// reduction, so it can be used to normalize the denoised audio.
+func measureLoudness(in string, denoise bool) (*loudness, error) {
+	filter := fmt.Sprintf("loudnorm="+loudnormSettings+":print_format=json", loudnessTarget, truePeakTarget, loudnessRange)
+	if denoise {
+		filter = denoiseFilter + "," + filter
+	}
+	cmd := exec.Command("nice", "-n", "10", "ffmpeg", "-nostats", "-hide_banner", "-i", in, "-vn", "-af", filter, "-f", "null", "-")
+	log.WithField("command", cmd.String()).Info("Measuring loudness")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("measure loudness: %w", fmt.Errorf("%w: %s", err, output))
+	}
+	return parseLoudness(string(output))
+}
+
+// parseLoudness parses the json that loudnorm prints at the end of ffmpeg's output
+func parseLoudness(output string) (*loudness, error) {
+	start, end := strings.LastIndex(output, "{"), strings.LastIndex(output, "}")
+	if start == -1 || end < start {
+		return nil, errNoLoudness
+	}
+	var o loudnormOutput
+	if err := json.Unmarshal([]byte(output[start:end+1]), &o); err != nil {
+		return nil, fmt.Errorf("parse loudness: %w", err)
+	}
+	var l loudness
+	for _, v := range []struct {
+		s string
+		f *float64
+	}{{o.InputI, &l.Integrated}, {o.InputLRA, &l.Range}, {o.InputTP, &l.TruePeak}, {o.InputThresh, &l.Threshold}, {o.TargetOffset, &l.Offset}} {
+		f, err := strconv.ParseFloat(v.s, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse loudness: %w", err)
+		}
+		*v.f = f
+	}
+	if math.IsInf(l.Integrated, 0) || math.IsInf(l.TruePeak, 0) {
+		return nil, errSilentRecording // loudnorm reports -inf for silent recordings
+	}
+	return &l, nil
+}
+
+// toProto returns the loudness as sent to TUM-Live, nil if l is nil
+func (l *loudness) toProto() *pb.Loudness {
+	if l == nil {
+		return nil
+	}
+	return &pb.Loudness{Integrated: l.Integrated, Range: l.Range, TruePeak: l.TruePeak}
+}
+
+// audioFilterArgs returns the ffmpeg arguments for the audio processing of streamCtx. Loudness normalization uses
+// the measured loudness of the recording for linear normalization and falls back to dynamic normalization if the
+// loudness couldn't be measured.
+func audioFilterArgs(streamCtx *StreamContext) []string {
+	var filters []string
+	if streamCtx.denoise {
+		filters = append(filters, denoiseFilter)
+	}
+	if streamCtx.normalizeLoudness {
+		loudnorm := fmt.Sprintf("loudnorm="+loudnormSettings, loudnessTarget, truePeakTarget, loudnessRange)
+		if l := streamCtx.loudness; l != nil {
+			loudnorm += fmt.Sprintf(":measured_I=%.2f:measured_LRA=%.2f:measured_TP=%.2f:measured_thresh=%.2f:offset=%.2f:linear=true",
+				l.Integrated, l.Range, l.TruePeak, l.Threshold, l.Offset)
+		}
+		filters = append(filters, loudnorm)
+	}
+	if len(filters) == 0 {
+		return nil
+	}
+	args := []string{"-af", strings.Join(filters, ",")}
+	if streamCtx.normalizeLoudness {
+		args = append(args, "-ar", normalizedSRate)
+	}
+	return args
+}
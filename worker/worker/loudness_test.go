@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+)
+
+const loudnormTestOutput = `[Parsed_loudnorm_0 @ 0x5581c0c0c0c0]
+{
+	"input_i" : "-31.42",
+	"input_tp" : "-6.10",
+	"input_lra" : "8.30",
+	"input_thresh" : "-41.80",
+	"output_i" : "-23.05",
+	"output_tp" : "-1.00",
+	"output_lra" : "7.20",
+	"output_thresh" : "-33.40",
+	"normalization_type" : "dynamic",
+	"target_offset" : "0.05"
+}
+`
+
+func TestParseLoudness(t *testing.T) {
+	l, err := parseLoudness("Input #0, mpegts, from 'in.ts':\n" + loudnormTestOutput)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := loudness{Integrated: -31.42, Range: 8.3, TruePeak: -6.1, Threshold: -41.8, Offset: 0.05}
+	if *l != want {
+		t.Errorf("parseLoudness() = %+v, want %+v", *l, want)
+	}
+
+	if _, err = parseLoudness("no json here"); err != errNoLoudness {
+		t.Errorf("expected errNoLoudness, got %v", err)
+	}
+	silent := `{"input_i" : "-inf", "input_tp" : "-inf", "input_lra" : "0.00", "input_thresh" : "-70.00", "target_offset" : "0.00"}`
+	if _, err = parseLoudness(silent); err != errSilentRecording {
+		t.Errorf("expected errSilentRecording, got %v", err)
+	}
+}
+
+func TestAudioFilterArgs(t *testing.T) {
+	measured := &loudness{Integrated: -31.42, Range: 8.3, TruePeak: -6.1, Threshold: -41.8, Offset: 0.05}
+	tests := []struct {
+		name      string
+		streamCtx *StreamContext
+		want      []string
+	}{
+		{name: "disabled", streamCtx: &StreamContext{loudness: measured}, want: nil},
+		{name: "denoise", streamCtx: &StreamContext{denoise: true}, want: []string{"-af", denoiseFilter}},
+		{
+			name:      "normalize without measurement",
+			streamCtx: &StreamContext{normalizeLoudness: true},
+			want:      []string{"-af", "loudnorm=I=-23:TP=-1:LRA=11", "-ar", "48000"},
+		},
+		{
+			name:      "denoise and normalize",
+			streamCtx: &StreamContext{normalizeLoudness: true, denoise: true, loudness: measured},
+			want: []string{"-af", denoiseFilter + ",loudnorm=I=-23:TP=-1:LRA=11:measured_I=-31.42:measured_LRA=8.30:measured_TP=-6.10:measured_thresh=-41.80:offset=0.05:linear=true",
+				"-ar", "48000"},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			if got := audioFilterArgs(test.streamCtx); !reflect.DeepEqual(got, test.want) {
+				t.Errorf("audioFilterArgs() = %v, want %v", got, test.want)
+			}
+		})
+	}
+}
@@ -18,7 +18,7 @@ func closeConnection(conn *grpc.ClientConn) {
 	}
 }
 
-func NotifyTranscodingFailure(streamContext StreamContext, transcodingError error) {
+func NotifyTranscodingFailure(streamContext *StreamContext, transcodingError error) {
 	client, conn, err := GetClient()
 	if err != nil {
 		log.WithError(err).Error("Unable to dial tumlive")
@@ -124,6 +124,7 @@ func notifyTranscodingDone(streamCtx *StreamContext) {
 		FilePath:   streamCtx.getTranscodingFileName(),
 		Duration:   streamCtx.duration,
 		SourceType: streamCtx.streamVersion,
+		Loudness:   streamCtx.loudness.toProto(),
 	})
 	if err != nil || !resp.Ok {
 		log.WithError(err).Error("Could not notify stream finished")
@@ -131,6 +132,10 @@ func notifyTranscodingDone(streamCtx *StreamContext) {
 }
 
 func notifyUploadDone(streamCtx *StreamContext) {
+	notifyUploadFinished(newPendingUpload(streamCtx))
+}
+
+func notifyUploadFinished(u PendingUpload) {
 	client, conn, err := GetClient()
 	if err != nil {
 		log.WithError(err).Error("Unable to dial tumlive")
@@ -141,16 +146,40 @@ func notifyUploadDone(streamCtx *StreamContext) {
 	defer cancel()
 	resp, err := client.NotifyUploadFinished(ctx, &pb.UploadFinished{
 		WorkerID:     cfg.WorkerID,
-		StreamID:     streamCtx.streamId,
-		HLSUrl:       fmt.Sprintf(cfg.VodURLTemplate, streamCtx.getStreamNameVoD()),
-		SourceType:   streamCtx.streamVersion,
-		ThumbnailUrl: streamCtx.thumbnailSpritePath,
+		StreamID:     u.StreamID,
+		HLSUrl:       u.HLSUrl,
+		DashUrl:      u.DashUrl,
+		SourceType:   u.SourceType,
+		ThumbnailUrl: u.ThumbnailUrl,
 	})
 	if err != nil || !resp.Ok {
 		log.WithError(err).Error("Could not notify upload finished")
 	}
 }
 
+// notifyUploadFailure reports an upload that failed after all retries to TUM-Live
+func notifyUploadFailure(u PendingUpload, uploadError error) {
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = client.NotifyUploadFailure(ctx, &pb.NotifyUploadFailureRequest{
+		WorkerID: cfg.WorkerID,
+		StreamID: u.StreamID,
+		Version:  u.SourceType,
+		FilePath: u.File,
+		Error:    uploadError.Error(),
+		Attempts: uint32(u.Attempts),
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not send upload error")
+	}
+}
+
 func notifyThumbnailDone(streamCtx *StreamContext) {
 	client, conn, err := GetClient()
 	if err != nil {
@@ -174,6 +203,27 @@ func notifyThumbnailDone(streamCtx *StreamContext) {
 	}
 }
 
+// notifyAudioDone notifies the server about the audio only version of the vod
+func notifyAudioDone(streamCtx *StreamContext) {
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	resp, err := client.NotifyAudioFinished(ctx, &pb.AudioFinished{
+		WorkerID:   cfg.WorkerID,
+		StreamID:   streamCtx.streamId,
+		FilePath:   streamCtx.getAudioTranscodingFileName(),
+		SourceType: streamCtx.streamVersion,
+	})
+	if err != nil || !resp.Ok {
+		log.WithError(err).Error("Could not notify audio done")
+	}
+}
+
 func GetClient() (pb.FromWorkerClient, *grpc.ClientConn, error) {
 	conn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(insecure.NewCredentials()))
 	if err != nil {
@@ -17,15 +17,18 @@ type Deletable struct { // Deletable is a file that can safely be deleted
 }
 
 type Persistable struct { // Persistable is a struct for all persistable objects
-	Deletable []Deletable // Deletable are all files that can safely be deleted
-	mutex     *sync.Mutex
+	Deletable        []Deletable       // Deletable are all files that can safely be deleted
+	PendingUploads   []PendingUpload   // PendingUploads are vods that are not uploaded yet
+	Jobs             []Job             // Jobs are unfinished post-processing jobs of recordings
+	PendingArchivals []PendingArchival // PendingArchivals are archivals whose results TUM-Live didn't store yet
+	mutex            *sync.Mutex
 }
 
 const persistFileName = "/persist.gob"
 
 // writeOut writes out the persistable object to disk
 func (p *Persistable) writeOut() error {
-	f, err := os.OpenFile(cfg.PersistDir+persistFileName, os.O_RDWR|os.O_CREATE, 0666)
+	f, err := os.OpenFile(cfg.PersistDir+persistFileName, os.O_RDWR|os.O_CREATE|os.O_TRUNC, 0666)
 	if err != nil {
 		return err
 	}
@@ -74,3 +77,111 @@ func (p *Persistable) SetDeletables(d []Deletable) error {
 	p.Deletable = d
 	return p.writeOut()
 }
+
+// AddPendingUpload adds an upload that is not finished yet
+func (p *Persistable) AddPendingUpload(u PendingUpload) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.PendingUploads = append(p.PendingUploads, u)
+	return p.writeOut()
+}
+
+// UpdatePendingUpload replaces the pending upload of the same file with u
+func (p *Persistable) UpdatePendingUpload(u PendingUpload) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for i := range p.PendingUploads {
+		if p.PendingUploads[i].File == u.File {
+			p.PendingUploads[i] = u
+		}
+	}
+	return p.writeOut()
+}
+
+// RemovePendingUpload removes the pending upload of file
+func (p *Persistable) RemovePendingUpload(file string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var pending []PendingUpload
+	for _, u := range p.PendingUploads {
+		if u.File != file {
+			pending = append(pending, u)
+		}
+	}
+	p.PendingUploads = pending
+	return p.writeOut()
+}
+
+// getPendingUpload returns the pending upload of file if there is one
+func (p *Persistable) getPendingUpload(file string) (PendingUpload, bool) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, u := range p.PendingUploads {
+		if u.File == file {
+			return u, true
+		}
+	}
+	return PendingUpload{}, false
+}
+
+// AddPendingArchival adds an archival whose result isn't stored by TUM-Live yet
+func (p *Persistable) AddPendingArchival(a PendingArchival) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.PendingArchivals = append(p.PendingArchivals, a)
+	return p.writeOut()
+}
+
+// RemovePendingArchival removes the pending archivals of the file with fileID
+func (p *Persistable) RemovePendingArchival(fileID uint32) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var pending []PendingArchival
+	for _, a := range p.PendingArchivals {
+		if a.FileID != fileID {
+			pending = append(pending, a)
+		}
+	}
+	p.PendingArchivals = pending
+	return p.writeOut()
+}
+
+// hasPendingArchival returns true if the result of the archival of the file with fileID isn't stored by TUM-Live yet
+func (p *Persistable) hasPendingArchival(fileID uint32) bool {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for _, a := range p.PendingArchivals {
+		if a.FileID == fileID {
+			return true
+		}
+	}
+	return false
+}
+
+// SaveJob adds the job or replaces the job with the same ID
+func (p *Persistable) SaveJob(job Job) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	for i := range p.Jobs {
+		if p.Jobs[i].ID == job.ID {
+			p.Jobs[i] = job
+			return p.writeOut()
+		}
+	}
+	p.Jobs = append(p.Jobs, job)
+	return p.writeOut()
+}
+
+// RemoveJob removes the job with the given ID
+func (p *Persistable) RemoveJob(id string) error {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	var jobs []Job
+	for _, job := range p.Jobs {
+		if job.ID != id {
+			jobs = append(jobs, job)
+		}
+	}
+	p.Jobs = jobs
+	return p.writeOut()
+}
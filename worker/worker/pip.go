@@ -0,0 +1,98 @@
+package worker
+
+import (
+	"fmt"
+	"os/exec"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	pipVersion = "PIP"
+	pipMargin  = 2 // distance of the camera to the edges in percent of the presentation's width
+)
+
+// pipLayout is the picture-in-picture composite of the presentation with the camera as inset
+type pipLayout struct {
+	Presentation string // transcoded presentation
+	Camera       string // transcoded camera
+	Position     string // corner of the camera: top-left, top-right, bottom-left or bottom-right
+	Size         uint32 // width of the camera in percent of the presentation's width
+}
+
+// HandleRenderPip renders the picture-in-picture composite of a stream and uploads it as additional version
+func HandleRenderPip(request *pb.RenderPipRequest) {
+	streamCtx := &StreamContext{
+		streamId:      request.GetStreamID(),
+		courseSlug:    request.GetCourseSlug(),
+		teachingTerm:  request.GetCourseTerm(),
+		teachingYear:  request.GetCourseYear(),
+		startTime:     request.GetStart().AsTime().Local(),
+		endTime:       request.GetEnd().AsTime().Local(),
+		streamVersion: pipVersion,
+		publishVoD:    request.GetPublishVoD(),
+		pip: pipLayout{
+			Presentation: request.GetPresentationPath(),
+			Camera:       request.GetCameraPath(),
+			Position:     request.GetPosition(),
+			Size:         request.GetSize(),
+		},
+	}
+	runJob(streamCtx, newJob(streamCtx, StageRenderPip, StageUpload))
+}
+
+// renderPip renders the composite of streamCtx into its transcoding file
+func renderPip(streamCtx *StreamContext) error {
+	out := streamCtx.getTranscodingFileName()
+	if err := prepare(out); err != nil {
+		return err
+	}
+	cmd := exec.Command("nice", append([]string{"-n", "10", "ffmpeg"}, pipArgs(streamCtx.pip, out)...)...)
+	log.WithFields(log.Fields{"stream": streamCtx.getStreamName(), "command": cmd.String()}).Info("Rendering picture-in-picture")
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("render picture-in-picture: %w", fmt.Errorf("%w: %s", err, output))
+	}
+	duration, err := getDuration(out)
+	if err != nil {
+		return fmt.Errorf("probe duration: %w", err)
+	}
+	streamCtx.duration = uint32(duration)
+	return nil
+}
+
+// pipArgs returns the ffmpeg arguments that scale the camera to the size of the layout and overlay it on the
+// presentation. The audio of the presentation is kept.
+func pipArgs(layout pipLayout, out string) []string {
+	filter := fmt.Sprintf("[1:v][0:v]scale2ref=w=trunc(main_w*%d/200)*2:h=-2[cam][pres];[pres][cam]overlay=%s:shortest=1[v]",
+		layout.Size, pipOverlayPosition(layout.Position))
+	return []string{
+		"-nostats", "-loglevel", "error", "-y",
+		"-i", layout.Presentation,
+		"-i", layout.Camera,
+		"-filter_complex", filter,
+		"-map", "[v]", "-map", "0:a?",
+		"-c:v", "libx264", "-level", "4.0", "-crf", "22", "-movflags", "+faststart",
+		"-c:a", "copy",
+		out,
+	}
+}
+
+// pipOverlayPosition returns the x and y coordinates of the overlay filter for the corner of the camera.
+// The camera is placed in the bottom right corner if the position is unknown.
+func pipOverlayPosition(position string) string {
+	margin := fmt.Sprintf("main_w*%d/100", pipMargin)
+	left, right := margin, "main_w-overlay_w-"+margin
+	top, bottom := margin, "main_h-overlay_h-"+margin
+	switch position {
+	case "top-left":
+		return fmt.Sprintf("x=%s:y=%s", left, top)
+	case "top-right":
+		return fmt.Sprintf("x=%s:y=%s", right, top)
+	case "bottom-left":
+		return fmt.Sprintf("x=%s:y=%s", left, bottom)
+	default:
+		return fmt.Sprintf("x=%s:y=%s", right, bottom)
+	}
+}
@@ -0,0 +1,38 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestPipOverlayPosition(t *testing.T) {
+	tests := map[string]string{
+		"top-left":     "x=main_w*2/100:y=main_w*2/100",
+		"top-right":    "x=main_w-overlay_w-main_w*2/100:y=main_w*2/100",
+		"bottom-left":  "x=main_w*2/100:y=main_h-overlay_h-main_w*2/100",
+		"bottom-right": "x=main_w-overlay_w-main_w*2/100:y=main_h-overlay_h-main_w*2/100",
+		"":             "x=main_w-overlay_w-main_w*2/100:y=main_h-overlay_h-main_w*2/100",
+	}
+	for position, expected := range tests {
+		if got := pipOverlayPosition(position); got != expected {
+			t.Errorf("pipOverlayPosition(%q) = %s, want %s", position, got, expected)
+		}
+	}
+}
+
+func TestPipArgs(t *testing.T) {
+	args := pipArgs(pipLayout{Presentation: "pres.mp4", Camera: "cam.mp4", Position: "top-left", Size: 30}, "out.mp4")
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-i pres.mp4 -i cam.mp4") {
+		t.Errorf("presentation must be the first input: %s", joined)
+	}
+	if !strings.Contains(joined, "scale2ref=w=trunc(main_w*30/200)*2:h=-2") {
+		t.Errorf("camera not scaled to the layout's size: %s", joined)
+	}
+	if !strings.Contains(joined, "overlay=x=main_w*2/100:y=main_w*2/100") {
+		t.Errorf("camera not placed in the layout's corner: %s", joined)
+	}
+	if args[len(args)-1] != "out.mp4" {
+		t.Errorf("output must be the last argument: %s", joined)
+	}
+}
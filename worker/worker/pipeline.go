@@ -0,0 +1,258 @@
+package worker
+
+import (
+	"os"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// Stage is a step of the post-processing of a recording
+type Stage string
+
+const (
+	StageTranscode       Stage = "transcode"         // transcode the recording
+	StageMove            Stage = "move"              // move an upload that doesn't need to be transcoded
+	StageTranscodeAudio  Stage = "transcode_audio"   // extract the audio track
+	StageThumbnails      Stage = "thumbnails"        // create the thumbnail sprite and the video thumbnail
+	StageUpload          Stage = "upload"            // upload the vod if it should be published
+	StageSilence         Stage = "silence"           // detect silences
+	StageMarkForDeletion Stage = "mark_for_deletion" // move the recording to the trash if transcoding succeeded
+	StageRemoveRecording Stage = "remove_recording"  // remove the recording immediately
+	StageRenderPip       Stage = "render_pip"        // render the picture-in-picture composite of presentation and camera
+	StageStitch          Stage = "stitch"            // stitch the recording of a failed worker with the one of its standby
+)
+
+// Job is the post-processing of a recording. Jobs are persisted after every stage, so a worker that was restarted
+// continues with the first unfinished stage.
+type Job struct {
+	ID string // ID is the recording file of the job
+
+	StreamID              uint32
+	CourseSlug            string
+	TeachingTerm          string
+	TeachingYear          uint32
+	StartTime             time.Time
+	EndTime               time.Time
+	StreamVersion         string
+	PublishVoD            bool
+	IsSelfStream          bool
+	RecordingPath         string
+	Duration              uint32
+	ThumbInterval         uint32
+	TranscodingSuccessful bool
+	ThumbnailSpritePath   string
+	Pip                   pipLayout
+	Backup                bool
+	Stitch                stitchSource
+	MinSilence            uint32
+	NormalizeLoudness     bool
+	Denoise               bool
+	Encoding              *pb.EncodingProfile
+
+	Stages []Stage // Stages are the remaining stages of the job
+}
+
+// newJob creates a job for the recording of streamCtx
+func newJob(streamCtx *StreamContext, stages ...Stage) Job {
+	job := Job{ID: streamCtx.getRecordingFileName(), Stages: stages}
+	job.update(streamCtx)
+	return job
+}
+
+// update saves the state of streamCtx in the job
+func (j *Job) update(streamCtx *StreamContext) {
+	j.StreamID = streamCtx.streamId
+	j.CourseSlug = streamCtx.courseSlug
+	j.TeachingTerm = streamCtx.teachingTerm
+	j.TeachingYear = streamCtx.teachingYear
+	j.StartTime = streamCtx.startTime
+	j.EndTime = streamCtx.endTime
+	j.StreamVersion = streamCtx.streamVersion
+	j.PublishVoD = streamCtx.publishVoD
+	j.IsSelfStream = streamCtx.isSelfStream
+	j.RecordingPath = ""
+	if streamCtx.recordingPath != nil {
+		j.RecordingPath = *streamCtx.recordingPath
+	}
+	j.Duration = streamCtx.duration
+	j.ThumbInterval = streamCtx.thumbInterval
+	j.TranscodingSuccessful = streamCtx.TranscodingSuccessful
+	j.ThumbnailSpritePath = streamCtx.thumbnailSpritePath
+	j.Pip = streamCtx.pip
+	j.Backup = streamCtx.backup
+	j.Stitch = streamCtx.stitch
+	j.MinSilence = streamCtx.minSilence
+	j.NormalizeLoudness = streamCtx.normalizeLoudness
+	j.Denoise = streamCtx.denoise
+	j.Encoding = streamCtx.encoding
+}
+
+// streamContext restores the stream context of the job
+func (j Job) streamContext() *StreamContext {
+	streamCtx := &StreamContext{
+		streamId:              j.StreamID,
+		courseSlug:            j.CourseSlug,
+		teachingTerm:          j.TeachingTerm,
+		teachingYear:          j.TeachingYear,
+		startTime:             j.StartTime,
+		endTime:               j.EndTime,
+		streamVersion:         j.StreamVersion,
+		publishVoD:            j.PublishVoD,
+		isSelfStream:          j.IsSelfStream,
+		duration:              j.Duration,
+		thumbInterval:         j.ThumbInterval,
+		TranscodingSuccessful: j.TranscodingSuccessful,
+		thumbnailSpritePath:   j.ThumbnailSpritePath,
+		pip:                   j.Pip,
+		backup:                j.Backup,
+		stitch:                j.Stitch,
+		minSilence:            j.MinSilence,
+		normalizeLoudness:     j.NormalizeLoudness,
+		denoise:               j.Denoise,
+		encoding:              j.Encoding,
+	}
+	if j.RecordingPath != "" {
+		recordingPath := j.RecordingPath
+		streamCtx.recordingPath = &recordingPath
+	}
+	return streamCtx
+}
+
+// runJob runs all stages of a job. Canceled stream contexts stop the job after the current stage.
+func runJob(streamCtx *StreamContext, job Job) {
+	if err := persisted.SaveJob(job); err != nil {
+		log.WithError(err).Warn("Can't persist job")
+	}
+	for len(job.Stages) > 0 {
+		runStage(streamCtx, job.Stages[0])
+		if streamCtx.canceled {
+			log.WithField("stream", streamCtx.getStreamName()).Info("Job canceled")
+			break
+		}
+		job.Stages = job.Stages[1:]
+		job.update(streamCtx)
+		if err := persisted.SaveJob(job); err != nil {
+			log.WithError(err).Warn("Can't persist job")
+		}
+	}
+	if err := persisted.RemoveJob(job.ID); err != nil {
+		log.WithError(err).Warn("Can't remove job")
+	}
+}
+
+// resumeJobs continues jobs that were interrupted by a restart of the worker
+func resumeJobs() {
+	persisted.mutex.Lock()
+	jobs := append([]Job{}, persisted.Jobs...)
+	persisted.mutex.Unlock()
+	for _, job := range jobs {
+		log.WithFields(log.Fields{"stream": job.StreamID, "file": job.ID, "stages": job.Stages}).Info("Resuming job")
+		go runJob(job.streamContext(), job)
+	}
+}
+
+func runStage(streamCtx *StreamContext, stage Stage) {
+	switch stage {
+	case StageTranscode:
+		S.startTranscoding(streamCtx)
+		err := transcode(streamCtx)
+		if err != nil {
+			streamCtx.TranscodingSuccessful = false
+			NotifyTranscodingFailure(streamCtx, err)
+			log.Errorf("Error while transcoding: %v", err)
+		} else {
+			streamCtx.TranscodingSuccessful = true
+		}
+		S.endTranscoding(streamCtx)
+		if streamCtx.canceled {
+			// self stream restarted while transcoding
+			return
+		}
+		notifyTranscodingDone(streamCtx)
+	case StageMove:
+		log.WithField("transcodingFileName", streamCtx.getTranscodingFileName()).Debug("Creating output directory")
+		if err := prepare(streamCtx.getTranscodingFileName()); err != nil {
+			log.Error(err)
+		}
+		log.WithFields(log.Fields{"in": streamCtx.getRecordingFileName(), "out": streamCtx.getTranscodingFileName()}).Debug("Copying file")
+		if err := moveFile(streamCtx.getRecordingFileName(), streamCtx.getTranscodingFileName()); err != nil {
+			log.WithError(err).Error("Can't move upload to transcoding dir")
+		} else {
+			log.WithField("stream", streamCtx.streamId).Debug("Successfully moved upload to target dir")
+		}
+	case StageTranscodeAudio:
+		if err := transcodeAudio(streamCtx); err != nil {
+			log.WithError(err).Error("Error transcoding audio")
+			return
+		}
+		notifyAudioDone(streamCtx)
+	case StageThumbnails:
+		S.startThumbnailGeneration(streamCtx)
+		defer S.endThumbnailGeneration(streamCtx)
+		thumbSuccessful := true
+		if err := createThumbnailSprite(streamCtx, streamCtx.getTranscodingFileName()); err != nil {
+			log.WithField("File", streamCtx.getThumbnailSpriteFileName()).WithError(err).Error("Creating thumbnail sprite failed.")
+			thumbSuccessful = false
+		}
+		if err := createVideoThumbnail(streamCtx, streamCtx.getTranscodingFileName()); err != nil {
+			log.WithField("File", streamCtx.getLargeThumbnailSpriteFileName()).WithError(err).Error("Creating thumbnail failed.")
+			thumbSuccessful = false
+		}
+		if thumbSuccessful {
+			notifyThumbnailDone(streamCtx)
+		}
+	case StageUpload:
+		if !streamCtx.publishVoD {
+			return
+		}
+		if err := upload(streamCtx); err == nil {
+			notifyUploadDone(streamCtx)
+		}
+	case StageSilence:
+		S.startSilenceDetection(streamCtx)
+		defer S.endSilenceDetection(streamCtx)
+		sd := NewSilenceDetector(streamCtx.getTranscodingFileName(), streamCtx.minSilence)
+		if err := sd.ParseSilence(); err != nil {
+			log.WithField("File", streamCtx.getTranscodingFileName()).WithError(err).Error("Detecting silence failed.")
+			return
+		}
+		notifySilenceResults(sd.Silences, streamCtx.streamId)
+	case StageMarkForDeletion:
+		if !streamCtx.TranscodingSuccessful {
+			return
+		}
+		if err := markForDeletion(streamCtx); err != nil {
+			log.WithField("stream", streamCtx.streamId).WithError(err).Error("Error marking for deletion")
+		}
+	case StageRenderPip:
+		S.startTranscoding(streamCtx)
+		err := renderPip(streamCtx)
+		S.endTranscoding(streamCtx)
+		if err != nil {
+			log.WithField("stream", streamCtx.getStreamName()).WithError(err).Error("Rendering picture-in-picture failed")
+			NotifyTranscodingFailure(streamCtx, err)
+			streamCtx.publishVoD = false // nothing to upload
+			return
+		}
+		streamCtx.TranscodingSuccessful = true
+		notifyTranscodingDone(streamCtx)
+	case StageStitch:
+		S.startTranscoding(streamCtx)
+		err := stitchRecordings(streamCtx)
+		S.endTranscoding(streamCtx)
+		if err != nil {
+			log.WithField("stream", streamCtx.getStreamName()).WithError(err).Error("Stitching recordings failed")
+			NotifyTranscodingFailure(streamCtx, err)
+			streamCtx.publishVoD = false // keep the vod of the standby
+			return
+		}
+		streamCtx.TranscodingSuccessful = true
+		notifyTranscodingDone(streamCtx)
+	case StageRemoveRecording:
+		_ = os.Remove(streamCtx.getRecordingFileName())
+	default:
+		log.WithField("stage", stage).Error("Unknown job stage")
+	}
+}
@@ -0,0 +1,82 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestJobStreamContext(t *testing.T) {
+	recording := "/recordings/upload.mp4"
+	streamCtx := &StreamContext{
+		streamId:              1,
+		courseSlug:            "eidi",
+		teachingTerm:          "W",
+		teachingYear:          2021,
+		startTime:             time.Date(2021, 9, 23, 8, 0, 0, 0, time.UTC),
+		endTime:               time.Date(2021, 9, 23, 10, 0, 0, 0, time.UTC),
+		streamVersion:         "COMB",
+		publishVoD:            true,
+		duration:              5400,
+		thumbInterval:         60,
+		TranscodingSuccessful: true,
+		recordingPath:         &recording,
+		pip:                   pipLayout{Presentation: "/vods/eidiPRES.mp4", Camera: "/vods/eidiCAM.mp4", Position: "top-left", Size: 20},
+	}
+	job := newJob(streamCtx, StageUpload, StageSilence)
+	if job.ID != recording {
+		t.Fatalf("unexpected job id: %s", job.ID)
+	}
+	if restored := job.streamContext(); !reflect.DeepEqual(restored, streamCtx) {
+		t.Fatalf("restored context differs:\n%+v\n%+v", restored, streamCtx)
+	}
+}
+
+func TestRunJob(t *testing.T) {
+	setupPersistable(t)
+	recording := filepath.Join(t.TempDir(), "upload.mp4")
+	if err := os.WriteFile(recording, []byte("video"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	streamCtx := &StreamContext{streamId: 1, recordingPath: &recording}
+	runJob(streamCtx, newJob(streamCtx, StageMarkForDeletion, StageRemoveRecording))
+
+	if _, err := os.Stat(recording); !os.IsNotExist(err) {
+		t.Fatal("recording should be removed")
+	}
+	if len(persisted.Jobs) != 0 {
+		t.Fatalf("finished job should be removed: %v", persisted.Jobs)
+	}
+}
+
+func TestPersistJobs(t *testing.T) {
+	setupPersistable(t)
+	job := Job{ID: "/recordings/a.ts", StreamID: 1, Stages: []Stage{StageThumbnails, StageUpload}}
+	if err := persisted.SaveJob(job); err != nil {
+		t.Fatal(err)
+	}
+	if err := persisted.SaveJob(Job{ID: "/recordings/b.ts", StreamID: 2, Stages: []Stage{StageSilence}}); err != nil {
+		t.Fatal(err)
+	}
+	// finishing a stage replaces the job
+	job.Stages = job.Stages[1:]
+	if err := persisted.SaveJob(job); err != nil {
+		t.Fatal(err)
+	}
+	if err := persisted.RemoveJob("/recordings/b.ts"); err != nil {
+		t.Fatal(err)
+	}
+
+	reloaded, err := NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.Jobs) != 1 || !reflect.DeepEqual(reloaded.Jobs[0].Stages, []Stage{StageUpload}) {
+		t.Fatalf("unexpected jobs: %+v", reloaded.Jobs)
+	}
+	if !hasUploadJob(reloaded.Jobs[0].streamContext().getTranscodingFileName()) {
+		t.Fatal("pending upload should be resumed by the job")
+	}
+}
@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"bufio"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const probeTimeout = time.Second * 5
+
+// HandleProbeSources checks whether the sources of a lecture hall can be reached from this worker
+func HandleProbeSources(request *pb.ProbeSourcesRequest) *pb.ProbeSourcesResponse {
+	resp := &pb.ProbeSourcesResponse{}
+	for _, source := range request.GetSources() {
+		result := &pb.ProbeSourcesResponse_Result{Source: source, Reachable: true}
+		if err := probeSource(source); err != nil {
+			log.WithError(err).WithField("source", source).Warn("source not reachable")
+			result.Reachable = false
+			result.Error = err.Error()
+		}
+		resp.Results = append(resp.Results, result)
+	}
+	return resp
+}
+
+// probeSource sends a request to the source and returns an error if it doesn't answer.
+// Sources without scheme are streamed via rtsp, see HandleStreamRequest.
+func probeSource(source string) error {
+	if !strings.Contains(source, "://") {
+		source = "rtsp://" + source
+	}
+	u, err := url.Parse(source)
+	if err != nil {
+		return err
+	}
+	switch u.Scheme {
+	case "rtsp":
+		return probeRTSP(u)
+	case "http", "https":
+		return probeHTTP(u)
+	default:
+		return fmt.Errorf("unsupported scheme %s", u.Scheme)
+	}
+}
+
+// probeRTSP sends an OPTIONS request to the rtsp server. Any rtsp response counts as reachable,
+// authentication and the stream itself are checked by ffmpeg once the stream starts.
+func probeRTSP(u *url.URL) error {
+	host := u.Host
+	if u.Port() == "" {
+		host = net.JoinHostPort(u.Hostname(), "554")
+	}
+	conn, err := net.DialTimeout("tcp", host, probeTimeout)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	if err = conn.SetDeadline(time.Now().Add(probeTimeout)); err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(conn, "OPTIONS %s RTSP/1.0\r\nCSeq: 1\r\n\r\n", u.String()); err != nil {
+		return err
+	}
+	status, err := bufio.NewReader(conn).ReadString('\n')
+	if err != nil {
+		return err
+	}
+	if !strings.HasPrefix(status, "RTSP/") {
+		return fmt.Errorf("unexpected response: %s", strings.TrimSpace(status))
+	}
+	return nil
+}
+
+// probeHTTP sends a GET request to the source and expects a non-error status.
+func probeHTTP(u *url.URL) error {
+	client := http.Client{Timeout: probeTimeout}
+	resp, err := client.Get(u.String())
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusBadRequest {
+		return fmt.Errorf("unexpected status: %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"bufio"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+)
+
+// fakeRTSPServer answers the first request of every connection with response
+func fakeRTSPServer(t *testing.T, response string) string {
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { lis.Close() })
+	go func() {
+		for {
+			conn, err := lis.Accept()
+			if err != nil {
+				return
+			}
+			_, _ = bufio.NewReader(conn).ReadString('\n')
+			_, _ = conn.Write([]byte(response))
+			conn.Close()
+		}
+	}()
+	return lis.Addr().String()
+}
+
+func TestProbeSources(t *testing.T) {
+	rtsp := fakeRTSPServer(t, "RTSP/1.0 200 OK\r\nCSeq: 1\r\n\r\n")
+	notRtsp := fakeRTSPServer(t, "SSH-2.0-OpenSSH\r\n")
+	httpOk := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer httpOk.Close()
+	httpErr := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer httpErr.Close()
+
+	// reserve a port and close it again to get a source that refuses connections
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closed := lis.Addr().String()
+	lis.Close()
+
+	tests := map[string]bool{
+		rtsp + "/extron3":         true,
+		"rtsp://" + rtsp + "/cam": true,
+		notRtsp + "/extron3":      false,
+		closed + "/extron3":       false,
+		httpOk.URL:                true,
+		httpErr.URL:               false,
+		"ftp://" + rtsp:           false,
+	}
+	sources := make([]string, 0, len(tests))
+	for source := range tests {
+		sources = append(sources, source)
+	}
+	resp := HandleProbeSources(&pb.ProbeSourcesRequest{Sources: sources})
+	if len(resp.Results) != len(sources) {
+		t.Fatalf("got %d results for %d sources", len(resp.Results), len(sources))
+	}
+	for _, result := range resp.Results {
+		if result.Reachable != tests[result.Source] {
+			t.Errorf("source %s: reachable = %v, want %v (%s)", result.Source, result.Reachable, tests[result.Source], result.Error)
+		}
+		if !result.Reachable && strings.TrimSpace(result.Error) == "" {
+			t.Errorf("source %s: missing error", result.Source)
+		}
+	}
+}
@@ -94,3 +94,22 @@ func TestStreamEndRequest(t *testing.T) {
 	case <-done:
 	}
 }
+
+func TestGetStandby(t *testing.T) {
+	streams := safeStreams{streams: make(map[uint32][]*StreamContext)}
+	primary := &StreamContext{streamId: 1, streamVersion: "CAM"}
+	standby := &StreamContext{streamId: 1, streamVersion: "PRES"}
+	standby.standby.Store(true)
+	streams.addContext(1, primary)
+	streams.addContext(1, standby)
+
+	if got := streams.getStandby(1, "PRES"); got != standby {
+		t.Errorf("getStandby(1, PRES) = %v, want %v", got, standby)
+	}
+	if got := streams.getStandby(1, "CAM"); got != nil {
+		t.Errorf("getStandby(1, CAM) = %v, want nil", got)
+	}
+	if got := streams.getStandby(2, "PRES"); got != nil {
+		t.Errorf("getStandby(2, PRES) = %v, want nil", got)
+	}
+}
@@ -0,0 +1,81 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	log "github.com/sirupsen/logrus"
+)
+
+// DeleteFiles removes recordings from the mass storage. Files that don't exist anymore are skipped, paths outside
+// the storage directory are rejected.
+func DeleteFiles(paths []string) error {
+	storage := filepath.Clean(cfg.StorageDir) + string(filepath.Separator)
+	for _, path := range paths {
+		if !strings.HasPrefix(filepath.Clean(path), storage) {
+			return fmt.Errorf("%s is outside of the storage directory", path)
+		}
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove %s: %w", path, err)
+		}
+		log.WithField("file", path).Info("Deleted recording")
+	}
+	return nil
+}
+
+// DeleteRawRecordings removes the raw recordings of the stream of the course starting at start from the temp directory,
+// including those already marked for deletion. Transcoded recordings in the storage directory are kept.
+func DeleteRawRecordings(courseSlug string, start time.Time) error {
+	selfStream := &StreamContext{courseSlug: courseSlug, startTime: start, isSelfStream: true}
+	names := []string{
+		// all versions of the stream, also those recorded as standby
+		fmt.Sprintf("%s-%s*.ts", courseSlug, start.Format("2006-01-02-15-04")),
+		filepath.Base(selfStream.getRecordingFileName()),
+	}
+	var paths []string
+	for _, dir := range []string{cfg.TempDir, filepath.Join(cfg.TempDir, ".trash")} {
+		for _, name := range names {
+			matches, err := filepath.Glob(filepath.Join(dir, name))
+			if err != nil {
+				return fmt.Errorf("find raw recordings: %w", err)
+			}
+			paths = append(paths, matches...)
+		}
+	}
+	for _, path := range paths {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return fmt.Errorf("remove %s: %w", path, err)
+		}
+		log.WithField("file", path).Info("Deleted raw recording")
+	}
+	return nil
+}
+
+// vodVersions are the stream versions that vods are stored for, each also recorded by standby workers
+var vodVersions = []string{"COMB", "PRES", "CAM", pipVersion}
+
+// DeleteVods removes the vods of all versions of the stream of the course starting at start from the vod storage
+func DeleteVods(courseSlug string, start time.Time) error {
+	storage, err := newVodStorage()
+	if err != nil {
+		return err
+	}
+	for _, version := range vodVersions {
+		for _, backup := range []bool{false, true} {
+			streamCtx := &StreamContext{courseSlug: courseSlug, startTime: start, streamVersion: version, backup: backup}
+			name := streamCtx.getStreamNameVoD() + ".mp4"
+			if err = storage.Delete(context.Background(), name); err != nil {
+				return fmt.Errorf("delete %s: %w", name, err)
+			}
+			log.WithField("vod", name).Info("Deleted vod")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+)
+
+func TestDeleteFiles(t *testing.T) {
+	storageDir := cfg.StorageDir
+	defer func() { cfg.StorageDir = storageDir }()
+	cfg.StorageDir = t.TempDir()
+
+	vod := filepath.Join(cfg.StorageDir, "eidi", "2021.W", "eidi_2021-09-23_10-00COMB.mp4")
+	if err := os.MkdirAll(filepath.Dir(vod), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(vod, []byte("vod"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := DeleteFiles([]string{vod, filepath.Join(cfg.StorageDir, "missing.mp4")}); err != nil {
+		t.Errorf("DeleteFiles() = %v, want nil", err)
+	}
+	if _, err := os.Stat(vod); !os.IsNotExist(err) {
+		t.Errorf("recording should be deleted")
+	}
+	if err := DeleteFiles([]string{filepath.Join(cfg.StorageDir, "..", "etc", "passwd")}); err == nil {
+		t.Errorf("paths outside of the storage directory must be rejected")
+	}
+}
+
+func TestDeleteRawRecordings(t *testing.T) {
+	tempDir, storageDir := cfg.TempDir, cfg.StorageDir
+	defer func() { cfg.TempDir, cfg.StorageDir = tempDir, storageDir }()
+	cfg.TempDir, cfg.StorageDir = t.TempDir(), t.TempDir()
+
+	raw := []string{
+		filepath.Join(cfg.TempDir, "eidi-2021-09-23-08-00PRES.ts"),
+		filepath.Join(cfg.TempDir, "eidi-2021-09-23-08-00standbyCAM.ts"),
+		filepath.Join(cfg.TempDir, ".trash", "eidi-2021-09-23-08-00COMB.ts"),
+		filepath.Join(cfg.TempDir, "eidi_23092021.flv"),
+	}
+	kept := []string{
+		filepath.Join(cfg.TempDir, "eidi-2021-09-30-08-00PRES.ts"),
+		filepath.Join(cfg.TempDir, "gbs-2021-09-23-08-00PRES.ts"),
+		filepath.Join(cfg.StorageDir, "2021", "W", "eidi", "2021-09-23_08-00", "eidi-2021-09-23-08-00PRES.mp4"),
+	}
+	for _, file := range append(raw, kept...) {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(file, []byte("recording"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := DeleteRawRecordings("eidi", time.Date(2021, 9, 23, 8, 0, 0, 0, time.Local)); err != nil {
+		t.Fatalf("DeleteRawRecordings() = %v, want nil", err)
+	}
+	for _, file := range raw {
+		if _, err := os.Stat(file); !os.IsNotExist(err) {
+			t.Errorf("raw recording %s should be deleted", file)
+		}
+	}
+	for _, file := range kept {
+		if _, err := os.Stat(file); err != nil {
+			t.Errorf("%s should be kept: %v", file, err)
+		}
+	}
+}
+
+func TestDeleteVods(t *testing.T) {
+	vodStorage, vodDir := cfg.VodStorage, cfg.LocalVodDir
+	defer func() { cfg.VodStorage, cfg.LocalVodDir = vodStorage, vodDir }()
+	cfg.VodStorage, cfg.LocalVodDir = cfg.VodStorageLocal, t.TempDir()
+
+	vods := []string{"eidi_2021_09_23_08_00PRES.mp4", "eidi_2021_09_23_08_00standbyCAM.mp4", "eidi_2021_09_23_08_00PIP.mp4"}
+	kept := []string{"eidi_2021_09_30_08_00PRES.mp4", "gbs_2021_09_23_08_00PRES.mp4"}
+	for _, name := range append(vods, kept...) {
+		if err := os.WriteFile(filepath.Join(cfg.LocalVodDir, name), []byte("vod"), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	start := time.Date(2021, 9, 23, 8, 0, 0, 0, time.Local)
+	if err := DeleteVods("eidi", start); err != nil {
+		t.Fatalf("DeleteVods() = %v, want nil", err)
+	}
+	for _, name := range vods {
+		if _, err := os.Stat(filepath.Join(cfg.LocalVodDir, name)); !os.IsNotExist(err) {
+			t.Errorf("%s should be deleted", name)
+		}
+	}
+	for _, name := range kept {
+		if _, err := os.Stat(filepath.Join(cfg.LocalVodDir, name)); err != nil {
+			t.Errorf("%s should be kept: %v", name, err)
+		}
+	}
+}
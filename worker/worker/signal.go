@@ -0,0 +1,128 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	signalCheckInterval = time.Minute
+	signalWindow        = time.Minute // duration at the end of the recording that is analyzed
+	signalMinDuration   = 50          // seconds of the window that must be black, frozen or silent to count as issue
+)
+
+// signalStatus is the result of the analysis of the live signal of a source
+type signalStatus struct {
+	Black  bool // the video was black
+	Frozen bool // the video didn't change
+	Silent bool // the audio was silent
+}
+
+// signalChecks returns whether the video and the audio of a source should be analyzed.
+// Only the presentation's video is checked, the camera is expected to show still images at times.
+func signalChecks(streamVersion string) (video bool, audio bool) {
+	switch streamVersion {
+	case "PRES":
+		return true, true
+	case "COMB":
+		return false, true
+	default:
+		return false, false
+	}
+}
+
+// monitorSignal analyzes the recording of a live stream periodically until done is closed
+// and notifies TUM-Live whenever the signal changes.
+func monitorSignal(streamCtx *StreamContext, done <-chan struct{}) {
+	video, audio := signalChecks(streamCtx.streamVersion)
+	if !video && !audio {
+		return
+	}
+	var last signalStatus
+	ticker := time.NewTicker(signalCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if streamCtx.standby.Load() || streamCtx.stopped.Load() {
+			continue
+		}
+		status, err := analyzeSignal(streamCtx.getRecordingFileName(), video, audio)
+		if err != nil {
+			log.WithError(err).WithField("stream", streamCtx.getStreamName()).Warn("Can't analyze live signal")
+			continue
+		}
+		if status == last {
+			continue
+		}
+		log.WithFields(log.Fields{"stream": streamCtx.getStreamName(), "status": status}).Info("Live signal changed")
+		if notifySignalStatus(streamCtx, status) {
+			last = status
+		}
+	}
+}
+
+// analyzeSignal detects black or frozen video and silent audio at the end of a recording
+func analyzeSignal(file string, video bool, audio bool) (signalStatus, error) {
+	args := []string{"-hide_banner", "-nostats", "-sseof", fmt.Sprintf("-%.0f", signalWindow.Seconds()), "-i", file}
+	if video {
+		args = append(args, "-vf", fmt.Sprintf("blackdetect=d=%d:pix_th=0.10,freezedetect=n=0.003:d=%d", signalMinDuration, signalMinDuration))
+	} else {
+		args = append(args, "-vn")
+	}
+	if audio {
+		args = append(args, "-af", fmt.Sprintf("silencedetect=n=-50dB:d=%d", signalMinDuration))
+	} else {
+		args = append(args, "-an")
+	}
+	args = append(args, "-f", "null", "-")
+	output, err := exec.Command("ffmpeg", args...).CombinedOutput()
+	if err != nil {
+		return signalStatus{}, fmt.Errorf("%v: %s", err, output)
+	}
+	return parseSignalAnalysis(string(output)), nil
+}
+
+// parseSignalAnalysis reads the results of ffmpeg's blackdetect, freezedetect and silencedetect filters
+func parseSignalAnalysis(output string) signalStatus {
+	return signalStatus{
+		Black:  strings.Contains(output, "black_start:"),
+		Frozen: strings.Contains(output, "freeze_start:"),
+		Silent: strings.Contains(output, "silence_start:"),
+	}
+}
+
+// notifySignalStatus sends the status of the live signal to TUM-Live and returns true on success
+func notifySignalStatus(streamCtx *StreamContext, status signalStatus) bool {
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return false
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	_, err = client.NotifySignalStatus(ctx, &pb.SignalStatus{
+		WorkerID:   cfg.WorkerID,
+		StreamID:   streamCtx.streamId,
+		SourceType: streamCtx.streamVersion,
+		Black:      status.Black,
+		Frozen:     status.Frozen,
+		Silent:     status.Silent,
+	})
+	if err != nil {
+		log.WithError(err).Error("Could not send signal status")
+		return false
+	}
+	return true
+}
@@ -0,0 +1,32 @@
+package worker
+
+import "testing"
+
+func TestParseSignalAnalysis(t *testing.T) {
+	tests := map[string]signalStatus{
+		"[blackdetect @ 0x55d5c8] black_start:0 black_end:60 black_duration:60\n" +
+			"[freezedetect @ 0x55d5c9] lavfi.freezedetect.freeze_start: 0\n": {Black: true, Frozen: true},
+		"[freezedetect @ 0x55d5c9] lavfi.freezedetect.freeze_start: 2.5\n" +
+			"[freezedetect @ 0x55d5c9] lavfi.freezedetect.freeze_duration: 57.5\n": {Frozen: true},
+		"[silencedetect @ 0x55d5ca] silence_start: 0.5\n":     {Silent: true},
+		"size=N/A time=00:01:00.00 bitrate=N/A speed= 120x\n": {},
+	}
+	for output, expected := range tests {
+		if got := parseSignalAnalysis(output); got != expected {
+			t.Errorf("parseSignalAnalysis(%q) = %+v, want %+v", output, got, expected)
+		}
+	}
+}
+
+func TestSignalChecks(t *testing.T) {
+	tests := map[string][2]bool{
+		"PRES": {true, true},
+		"COMB": {false, true},
+		"CAM":  {false, false},
+	}
+	for version, expected := range tests {
+		if video, audio := signalChecks(version); video != expected[0] || audio != expected[1] {
+			t.Errorf("signalChecks(%s) = %v, %v, want %v, %v", version, video, audio, expected[0], expected[1])
+		}
+	}
+}
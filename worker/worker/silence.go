@@ -1,15 +1,21 @@
 package worker
 
 import (
+	"fmt"
+
 	log "github.com/sirupsen/logrus"
 	"os/exec"
 	"strconv"
 	"strings"
 )
 
+// defaultMinSilence is the minimum duration of a silence in seconds if the course doesn't configure one
+const defaultMinSilence = 30
+
 type SilenceDetect struct {
-	Input    string
-	Silences *[]silence
+	Input       string
+	MinDuration uint // minimum duration of silences and of sound between them in seconds
+	Silences    *[]silence
 }
 
 type silence struct {
@@ -17,13 +23,17 @@ type silence struct {
 	End   uint
 }
 
-func NewSilenceDetector(input string) *SilenceDetect {
-	return &SilenceDetect{Input: input}
+// NewSilenceDetector creates a detector for silences of at least minDuration seconds, defaultMinSilence if 0
+func NewSilenceDetector(input string, minDuration uint32) *SilenceDetect {
+	if minDuration == 0 {
+		minDuration = defaultMinSilence
+	}
+	return &SilenceDetect{Input: input, MinDuration: uint(minDuration)}
 }
 
 func (s *SilenceDetect) ParseSilence() error {
 	log.WithField("File", s.Input).Info("Start detecting silence")
-	cmd := exec.Command("nice", "ffmpeg", "-nostats", "-i", s.Input, "-af", "silencedetect=n=-15dB:d=30", "-f", "null", "-")
+	cmd := exec.Command("nice", "ffmpeg", "-nostats", "-i", s.Input, "-af", fmt.Sprintf("silencedetect=n=-15dB:d=%d", s.MinDuration), "-f", "null", "-")
 	output, err := cmd.CombinedOutput()
 	if err != nil {
 		return err
@@ -61,13 +71,13 @@ func (s *SilenceDetect) postprocess() {
 	if len(oldSilences) < 2 {
 		return
 	}
-	if oldSilences[0].Start < 30 {
+	if oldSilences[0].Start < s.MinDuration {
 		oldSilences[0].Start = 0
 	}
 	newSilences := []silence{{Start: oldSilences[0].Start, End: oldSilences[0].Start}}
 	oldPtr := 0
 	for oldPtr < len(oldSilences) {
-		if oldSilences[oldPtr].Start-newSilences[len(newSilences)-1].End < 30 { // Ignore sound that's shorter than 30 seconds
+		if oldSilences[oldPtr].Start-newSilences[len(newSilences)-1].End < s.MinDuration { // Ignore sound that's shorter than a silence
 			newSilences[len(newSilences)-1].End = oldSilences[oldPtr].End
 		} else {
 			newSilences = append(newSilences, oldSilences[oldPtr])
@@ -0,0 +1,37 @@
+package worker
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestNewSilenceDetectorDefault(t *testing.T) {
+	if sd := NewSilenceDetector("a.mp4", 0); sd.MinDuration != defaultMinSilence {
+		t.Errorf("expected default min duration %d, got %d", defaultMinSilence, sd.MinDuration)
+	}
+	if sd := NewSilenceDetector("a.mp4", 10); sd.MinDuration != 10 {
+		t.Errorf("expected min duration 10, got %d", sd.MinDuration)
+	}
+}
+
+func TestPostprocessMinDuration(t *testing.T) {
+	silences := func() *[]silence {
+		return &[]silence{{Start: 15, End: 40}, {Start: 52, End: 100}, {Start: 200, End: 260}}
+	}
+	tests := []struct {
+		minDuration uint
+		want        []silence
+	}{
+		// sound of 12 seconds between the first silences is shorter than 30 seconds and merged
+		{minDuration: 30, want: []silence{{Start: 0, End: 100}, {Start: 200, End: 260}}},
+		// with 10 seconds it is kept and the first silence doesn't start at 0
+		{minDuration: 10, want: []silence{{Start: 15, End: 40}, {Start: 52, End: 100}, {Start: 200, End: 260}}},
+	}
+	for _, test := range tests {
+		sd := &SilenceDetect{MinDuration: test.minDuration, Silences: silences()}
+		sd.postprocess()
+		if !reflect.DeepEqual(*sd.Silences, test.want) {
+			t.Errorf("postprocess() with min duration %d = %v, want %v", test.minDuration, *sd.Silences, test.want)
+		}
+	}
+}
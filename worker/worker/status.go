@@ -13,6 +13,7 @@ import (
 	log "github.com/sirupsen/logrus"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 var (
@@ -27,6 +28,18 @@ const (
 	costTranscodingAudio    = 1
 	costSilenceDetection    = 1
 	costThumbnailGeneration = 1
+	costArchival            = 2
+)
+
+// types of jobs reported as in flight in heartbeats
+const (
+	jobTypeStream           = "stream"
+	jobTypeTranscoding      = "transcoding"
+	jobTypeTranscodingAudio = "transcoding_audio"
+	jobTypeSilenceDetection = "silence_detection"
+	jobTypeThumbnails       = "thumbnails"
+	jobTypeArchival         = "archival"
+	jobTypeUpload           = "upload"
 )
 
 type Status struct {
@@ -34,6 +47,9 @@ type Status struct {
 	Jobs      []string
 	StartTime time.Time
 
+	// inFlight contains all running jobs, reported to the server with every heartbeat
+	inFlight []*pb.InFlightJob
+
 	// VM Metrics are updated regularly
 	Stat *vmstat.VmStat
 }
@@ -43,32 +59,38 @@ func (s *Status) startSilenceDetection(streamCtx *StreamContext) {
 	statusLock.Lock()
 	s.workload += costSilenceDetection
 	s.Jobs = append(s.Jobs, fmt.Sprintf("detecting silence in %s", streamCtx.getStreamName()))
+	s.addInFlight(jobTypeSilenceDetection, streamCtx.streamId)
 	statusLock.Unlock()
 }
 
 func (s *Status) startStream(streamCtx *StreamContext) {
 	defer s.SendHeartbeat()
 	statusLock.Lock()
-	notifyStreamStart(streamCtx)
+	if !streamCtx.standby.Load() {
+		notifyStreamStart(streamCtx)
+	}
 	defer statusLock.Unlock()
 	s.workload += costStream
 	s.Jobs = append(s.Jobs, fmt.Sprintf("streaming %s", streamCtx.getStreamName()))
+	s.addInFlight(jobTypeStream, streamCtx.streamId)
 }
 
-func (s *Status) startTranscoding(name string) {
+func (s *Status) startTranscoding(streamCtx *StreamContext) {
 	defer s.SendHeartbeat()
 	statusLock.Lock()
 	defer statusLock.Unlock()
 	s.workload += costTranscoding
-	s.Jobs = append(s.Jobs, fmt.Sprintf("transcoding %s", name))
+	s.Jobs = append(s.Jobs, fmt.Sprintf("transcoding %s", streamCtx.getStreamName()))
+	s.addInFlight(jobTypeTranscoding, streamCtx.streamId)
 }
 
-func (s *Status) startTranscodingAudio(name string) {
+func (s *Status) startTranscodingAudio(streamCtx *StreamContext) {
 	defer s.SendHeartbeat()
 	statusLock.Lock()
 	defer statusLock.Unlock()
 	s.workload += costTranscodingAudio
-	s.Jobs = append(s.Jobs, fmt.Sprintf("transcoding audio %s", name))
+	s.Jobs = append(s.Jobs, fmt.Sprintf("transcoding audio %s", streamCtx.getStreamName()))
+	s.addInFlight(jobTypeTranscodingAudio, streamCtx.streamId)
 }
 
 func (s *Status) startThumbnailGeneration(streamCtx *StreamContext) {
@@ -77,6 +99,7 @@ func (s *Status) startThumbnailGeneration(streamCtx *StreamContext) {
 	defer statusLock.Unlock()
 	s.workload += costThumbnailGeneration
 	s.Jobs = append(s.Jobs, fmt.Sprintf("generating thumbnail for %s", streamCtx.getTranscodingFileName()))
+	s.addInFlight(jobTypeThumbnails, streamCtx.streamId)
 }
 
 func (s *Status) endStream(streamCtx *StreamContext) {
@@ -89,19 +112,21 @@ func (s *Status) endStream(streamCtx *StreamContext) {
 			break
 		}
 	}
+	s.removeInFlight(jobTypeStream, streamCtx.streamId)
 	statusLock.Unlock()
 }
 
-func (s *Status) endTranscoding(name string) {
+func (s *Status) endTranscoding(streamCtx *StreamContext) {
 	defer s.SendHeartbeat()
 	statusLock.Lock()
 	s.workload -= costTranscoding
 	for i := range s.Jobs {
-		if s.Jobs[i] == fmt.Sprintf("transcoding %s", name) {
+		if s.Jobs[i] == fmt.Sprintf("transcoding %s", streamCtx.getStreamName()) {
 			s.Jobs = append(s.Jobs[:i], s.Jobs[i+1:]...)
 			break
 		}
 	}
+	s.removeInFlight(jobTypeTranscoding, streamCtx.streamId)
 	statusLock.Unlock()
 }
 
@@ -115,6 +140,7 @@ func (s *Status) endThumbnailGeneration(streamContext *StreamContext) {
 			break
 		}
 	}
+	s.removeInFlight(jobTypeThumbnails, streamContext.streamId)
 	statusLock.Unlock()
 }
 
@@ -128,22 +154,88 @@ func (s *Status) endSilenceDetection(streamCtx *StreamContext) {
 			break
 		}
 	}
+	s.removeInFlight(jobTypeSilenceDetection, streamCtx.streamId)
 	statusLock.Unlock()
 }
 
-func (s *Status) endTranscodingAudio(name string) {
+func (s *Status) endTranscodingAudio(streamCtx *StreamContext) {
 	defer s.SendHeartbeat()
 	statusLock.Lock()
 	s.workload -= costTranscodingAudio
 	for i := range s.Jobs {
-		if s.Jobs[i] == fmt.Sprintf("transcoding audio %s", name) {
+		if s.Jobs[i] == fmt.Sprintf("transcoding audio %s", streamCtx.getStreamName()) {
+			s.Jobs = append(s.Jobs[:i], s.Jobs[i+1:]...)
+			break
+		}
+	}
+	s.removeInFlight(jobTypeTranscodingAudio, streamCtx.streamId)
+	statusLock.Unlock()
+}
+
+func (s *Status) startArchival(streamCtx *StreamContext, file string) {
+	defer s.SendHeartbeat()
+	statusLock.Lock()
+	defer statusLock.Unlock()
+	s.workload += costArchival
+	s.Jobs = append(s.Jobs, fmt.Sprintf("archiving %s", file))
+	s.addInFlight(jobTypeArchival, streamCtx.streamId)
+}
+
+func (s *Status) endArchival(streamCtx *StreamContext, file string) {
+	defer s.SendHeartbeat()
+	statusLock.Lock()
+	s.workload -= costArchival
+	for i := range s.Jobs {
+		if s.Jobs[i] == fmt.Sprintf("archiving %s", file) {
 			s.Jobs = append(s.Jobs[:i], s.Jobs[i+1:]...)
 			break
 		}
 	}
+	s.removeInFlight(jobTypeArchival, streamCtx.streamId)
 	statusLock.Unlock()
 }
 
+// startUpload records an upload of a vod. Uploads add no workload, but the vod isn't published until they are done.
+func (s *Status) startUpload(u PendingUpload) {
+	defer s.SendHeartbeat()
+	statusLock.Lock()
+	defer statusLock.Unlock()
+	s.Jobs = append(s.Jobs, fmt.Sprintf("uploading %s", u.File))
+	s.addInFlight(jobTypeUpload, u.StreamID)
+}
+
+func (s *Status) endUpload(u PendingUpload) {
+	defer s.SendHeartbeat()
+	statusLock.Lock()
+	for i := range s.Jobs {
+		if s.Jobs[i] == fmt.Sprintf("uploading %s", u.File) {
+			s.Jobs = append(s.Jobs[:i], s.Jobs[i+1:]...)
+			break
+		}
+	}
+	s.removeInFlight(jobTypeUpload, u.StreamID)
+	statusLock.Unlock()
+}
+
+// addInFlight records a running job of the stream. The caller must hold statusLock.
+func (s *Status) addInFlight(jobType string, streamID uint32) {
+	s.inFlight = append(s.inFlight, &pb.InFlightJob{
+		Type:     jobType,
+		StreamID: streamID,
+		Started:  timestamppb.Now(),
+	})
+}
+
+// removeInFlight removes a finished job of the stream. The caller must hold statusLock.
+func (s *Status) removeInFlight(jobType string, streamID uint32) {
+	for i, job := range s.inFlight {
+		if job.Type == jobType && job.StreamID == streamID {
+			s.inFlight = append(s.inFlight[:i], s.inFlight[i+1:]...)
+			return
+		}
+	}
+}
+
 func (s *Status) SendHeartbeat() {
 	// WithInsecure: workerId used for authentication, all servers are inside their own VLAN to further improve security
 	clientConn, err := grpc.Dial(fmt.Sprintf("%s:50052", cfg.MainBase), grpc.WithTransportCredentials(insecure.NewCredentials()))
@@ -162,15 +254,21 @@ func (s *Status) SendHeartbeat() {
 	ctx, cancel := context.WithTimeout(context.Background(), time.Second*10)
 	defer cancel()
 
+	statusLock.RLock()
+	inFlight := make([]*pb.InFlightJob, len(s.inFlight))
+	copy(inFlight, s.inFlight)
+	statusLock.RUnlock()
+
 	_, err = client.SendHeartBeat(ctx, &pb.HeartBeat{
-		WorkerID: cfg.WorkerID,
-		Workload: uint32(s.workload),
-		Jobs:     s.Jobs,
-		Version:  VersionTag,
-		CPU:      s.Stat.GetCpuStr(),
-		Memory:   s.Stat.GetMemStr(),
-		Disk:     s.Stat.GetDiskStr(),
-		Uptime:   strings.ReplaceAll(time.Since(s.StartTime).Round(time.Minute).String(), "0s", ""),
+		WorkerID:     cfg.WorkerID,
+		Workload:     uint32(s.workload),
+		Jobs:         s.Jobs,
+		Version:      VersionTag,
+		CPU:          s.Stat.GetCpuStr(),
+		Memory:       s.Stat.GetMemStr(),
+		Disk:         s.Stat.GetDiskStr(),
+		Uptime:       strings.ReplaceAll(time.Since(s.StartTime).Round(time.Minute).String(), "0s", ""),
+		InFlightJobs: inFlight,
 	})
 	if err != nil {
 		log.WithError(err).Error("Sending Heartbeat failed")
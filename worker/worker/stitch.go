@@ -0,0 +1,93 @@
+package worker
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+// stitchSource is the partial recording of a primary worker that failed during the stream
+type stitchSource struct {
+	Primary  string // transcoded recording of the primary worker
+	Duration uint32 // duration of the primary's recording in seconds, the standby's recording is continued from there
+}
+
+// HandleStitchRequest replaces the recording of a standby that took over with the recording of the failed primary
+// worker, continued by the recording of the standby, and uploads it in place of the standby's vod
+func HandleStitchRequest(request *pb.StitchRequest) {
+	streamCtx := &StreamContext{
+		streamId:      request.GetStreamID(),
+		courseSlug:    request.GetCourseSlug(),
+		teachingTerm:  request.GetCourseTerm(),
+		teachingYear:  request.GetCourseYear(),
+		startTime:     request.GetStart().AsTime().Local(),
+		endTime:       request.GetEnd().AsTime().Local(),
+		streamVersion: request.GetSourceType(),
+		publishVoD:    request.GetPublishVoD(),
+		backup:        true,
+		stitch: stitchSource{
+			Primary:  request.GetPrimaryPath(),
+			Duration: request.GetPrimaryDuration(),
+		},
+	}
+	runJob(streamCtx, newJob(streamCtx, StageStitch, StageUpload))
+}
+
+// stitchRecordings concatenates the recordings of primary and standby into the transcoding file of the standby
+func stitchRecordings(streamCtx *StreamContext) error {
+	standby := streamCtx.getTranscodingFileName()
+	list := filepath.Join(cfg.TempDir, streamCtx.getStreamName()+"-stitch.txt")
+	out := filepath.Join(cfg.TempDir, streamCtx.getStreamName()+"-stitched.mp4")
+	if err := os.WriteFile(list, []byte(stitchList(streamCtx.stitch, standby)), 0644); err != nil {
+		return fmt.Errorf("write concat list: %w", err)
+	}
+	defer os.Remove(list)
+	cmd := exec.Command("nice", append([]string{"-n", "10", "ffmpeg"}, stitchArgs(list, out)...)...)
+	log.WithFields(log.Fields{"stream": streamCtx.getStreamName(), "command": cmd.String()}).Info("Stitching recordings")
+	if output, err := cmd.CombinedOutput(); err != nil {
+		_ = os.Remove(out)
+		return fmt.Errorf("stitch recordings: %w", fmt.Errorf("%w: %s", err, output))
+	}
+	if err := moveFile(out, standby); err != nil {
+		return err
+	}
+	duration, err := getDuration(standby)
+	if err != nil {
+		return fmt.Errorf("probe duration: %w", err)
+	}
+	streamCtx.duration = uint32(duration)
+	// the partial recording is part of the stitched one now
+	if err = os.Remove(streamCtx.stitch.Primary); err != nil {
+		log.WithError(err).WithField("file", streamCtx.stitch.Primary).Warn("Can't remove partial recording")
+	}
+	return nil
+}
+
+// stitchList returns the concat script that plays the primary's recording and continues with the standby's recording
+// where the primary's recording ends. Both recordings started at the same time.
+func stitchList(source stitchSource, standby string) string {
+	return fmt.Sprintf("ffconcat version 1.0\nfile %s\nfile %s\ninpoint %d\n",
+		concatQuote(source.Primary), concatQuote(standby), source.Duration)
+}
+
+// concatQuote quotes a path for ffmpeg's concat demuxer
+func concatQuote(path string) string {
+	return "'" + strings.ReplaceAll(path, "'", `'\''`) + "'"
+}
+
+// stitchArgs returns the ffmpeg arguments that concatenate the recordings of list without transcoding them again
+func stitchArgs(list string, out string) []string {
+	return []string{
+		"-nostats", "-loglevel", "error", "-y",
+		"-f", "concat", "-safe", "0",
+		"-i", list,
+		"-c", "copy", "-movflags", "+faststart",
+		out,
+	}
+}
@@ -0,0 +1,49 @@
+package worker
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStitchList(t *testing.T) {
+	got := stitchList(stitchSource{Primary: "/mass/eidi-2021-09-23-08-00PRES.mp4", Duration: 1234}, "/mass/it's-standbyPRES.mp4")
+	want := "ffconcat version 1.0\n" +
+		"file '/mass/eidi-2021-09-23-08-00PRES.mp4'\n" +
+		"file '/mass/it'\\''s-standbyPRES.mp4'\n" +
+		"inpoint 1234\n"
+	if got != want {
+		t.Errorf("stitchList() = %q, want %q", got, want)
+	}
+}
+
+func TestStitchArgs(t *testing.T) {
+	args := stitchArgs("list.txt", "out.mp4")
+	joined := strings.Join(args, " ")
+	if !strings.Contains(joined, "-f concat -safe 0 -i list.txt") {
+		t.Errorf("recordings must be read from the concat list: %s", joined)
+	}
+	if !strings.Contains(joined, "-c copy") {
+		t.Errorf("recordings must not be transcoded again: %s", joined)
+	}
+	if args[len(args)-1] != "out.mp4" {
+		t.Errorf("output must be the last argument: %s", joined)
+	}
+}
+
+func TestStandbyFileNames(t *testing.T) {
+	setup()
+	standby := StreamContext{
+		courseSlug:    s.courseSlug,
+		teachingTerm:  s.teachingTerm,
+		teachingYear:  s.teachingYear,
+		startTime:     s.startTime,
+		streamVersion: "PRES",
+		backup:        true,
+	}
+	if got, want := standby.getTranscodingFileName(), "/2021/W/eidi/2021-09-23_08-00/eidi-2021-09-23-08-00standbyPRES.mp4"; got != want {
+		t.Errorf("getTranscodingFileName() = %s, want %s", got, want)
+	}
+	if got, want := standby.getStreamNameVoD(), "eidi_2021_09_23_08_00standbyPRES"; got != want {
+		t.Errorf("getStreamNameVoD() = %s, want %s", got, want)
+	}
+}
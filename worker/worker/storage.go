@@ -0,0 +1,84 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	log "github.com/sirupsen/logrus"
+)
+
+var (
+	storeAttempts      = 8
+	storeRetryDelay    = time.Second * 30 // doubled after every failed attempt
+	storeMaxRetryDelay = time.Hour
+)
+
+// errCorrupt is returned by VodStorage.Verify if the stored object doesn't match the file. It can't become valid by
+// waiting, so the file has to be stored again.
+var errCorrupt = errors.New("stored object is corrupt")
+
+// VodStorage stores transcoded recordings, so they can be served as VoD.
+type VodStorage interface {
+	// Store uploads file and makes it available as name, e.g. eidi_2021_09_23_10_00COMB.mp4
+	Store(ctx context.Context, file string, name string) error
+	// Verify checks whether the object stored as name is complete and matches file. Mismatches are reported as errCorrupt.
+	Verify(ctx context.Context, file string, name string) error
+	// Delete removes the object stored as name. Objects that don't exist are skipped.
+	Delete(ctx context.Context, name string) error
+}
+
+// newVodStorage returns the storage backend configured in cfg.VodStorage
+func newVodStorage() (VodStorage, error) {
+	switch cfg.VodStorage {
+	case cfg.VodStorageLrz, "":
+		return &lrzStorage{}, nil
+	case cfg.VodStorageLocal:
+		return &localStorage{dir: cfg.LocalVodDir}, nil
+	case cfg.VodStorageS3:
+		return &s3Storage{
+			endpoint:  cfg.S3Endpoint,
+			bucket:    cfg.S3Bucket,
+			region:    cfg.S3Region,
+			accessKey: cfg.S3AccessKey,
+			secretKey: cfg.S3SecretKey,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unknown vod storage: %s", cfg.VodStorage)
+	}
+}
+
+// storeAndVerify stores the file of u unless it was stored before and verifies that the stored object is complete.
+// Storing it again could create a duplicate vod, so it is only stored again if the stored object is corrupt.
+func storeAndVerify(ctx context.Context, storage VodStorage, u *PendingUpload) error {
+	if !u.Stored {
+		if err := storage.Store(ctx, u.File, u.Name); err != nil {
+			return fmt.Errorf("store: %w", err)
+		}
+		u.Stored = true
+		if err := persisted.UpdatePendingUpload(*u); err != nil {
+			log.WithError(err).Warn("Can't persist pending upload")
+		}
+	}
+	if err := storage.Verify(ctx, u.File, u.Name); err != nil {
+		if errors.Is(err, errCorrupt) {
+			u.Stored = false // store it again in the next attempt
+		}
+		return fmt.Errorf("verify: %w", err)
+	}
+	return nil
+}
+
+// storeBackoff returns the time to wait after the given number of failed attempts
+func storeBackoff(attempts int) time.Duration {
+	delay := storeRetryDelay
+	for i := 1; i < attempts && delay < storeMaxRetryDelay; i++ {
+		delay *= 2
+	}
+	if delay > storeMaxRetryDelay {
+		return storeMaxRetryDelay
+	}
+	return delay
+}
@@ -0,0 +1,78 @@
+package worker
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// localStorage copies recordings into a directory, e.g. one served by the vod-service
+type localStorage struct {
+	dir string
+}
+
+// Store copies file into the storage directory. The copy is written to a temporary file first,
+// so incomplete files are never served.
+func (s *localStorage) Store(ctx context.Context, file string, name string) error {
+	if err := os.MkdirAll(s.dir, 0755); err != nil {
+		return err
+	}
+	in, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.CreateTemp(s.dir, ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(out.Name()) // no-op after the rename succeeded
+	if _, err = io.Copy(out, in); err != nil {
+		_ = out.Close()
+		return err
+	}
+	if err = out.Close(); err != nil {
+		return err
+	}
+	return os.Rename(out.Name(), filepath.Join(s.dir, name))
+}
+
+// Delete removes the copy stored as name
+func (s *localStorage) Delete(ctx context.Context, name string) error {
+	if err := os.Remove(filepath.Join(s.dir, name)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	return nil
+}
+
+// Verify compares the sha256 checksums of file and the stored copy
+func (s *localStorage) Verify(ctx context.Context, file string, name string) error {
+	want, err := sha256File(file)
+	if err != nil {
+		return err
+	}
+	got, err := sha256File(filepath.Join(s.dir, name))
+	if err != nil {
+		return err
+	}
+	if got != want {
+		return fmt.Errorf("%w: checksum mismatch: %s != %s", errCorrupt, got, want)
+	}
+	return nil
+}
+
+func sha256File(file string) (string, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%x", h.Sum(nil)), nil
+}
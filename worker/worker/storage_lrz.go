@@ -0,0 +1,70 @@
+package worker
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+)
+
+var (
+	lrzVerifyTimeout  = time.Minute * 30 // time the LRZ may take to process an upload
+	lrzVerifyInterval = time.Second * 30
+)
+
+// lrzStorage uploads recordings to the LRZ video portal using its upload form (see post).
+type lrzStorage struct{}
+
+// Store uploads file with the LRZ upload form. The LRZ names the vod after the uploaded file, so name is unused.
+func (s *lrzStorage) Store(ctx context.Context, file string, name string) error {
+	return post(file)
+}
+
+// Verify waits until the playlist of the vod stored as name is served. The LRZ processes uploads asynchronously, so
+// the playlist is requested every lrzVerifyInterval until lrzVerifyTimeout.
+func (s *lrzStorage) Verify(ctx context.Context, file string, name string) error {
+	if cfg.VodURLTemplate == "" {
+		return errors.New("VodURLTemplate not set")
+	}
+	url := fmt.Sprintf(cfg.VodURLTemplate, strings.TrimSuffix(name, ".mp4"))
+	ctx, cancel := context.WithTimeout(ctx, lrzVerifyTimeout)
+	defer cancel()
+	for {
+		err := headPlaylist(ctx, url)
+		if err == nil {
+			return nil
+		}
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("playlist not available: %w", err)
+		case <-time.After(lrzVerifyInterval):
+		}
+	}
+}
+
+// Delete fails, the upload form of the LRZ offers no way to delete vods. They have to be deleted in the LRZ video
+// portal instead.
+func (s *lrzStorage) Delete(ctx context.Context, name string) error {
+	return fmt.Errorf("the LRZ can't delete vods, delete %s in the video portal", name)
+}
+
+// headPlaylist returns an error if the playlist at url isn't served
+func headPlaylist(ctx context.Context, url string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, url, nil)
+	if err != nil {
+		return err
+	}
+	rsp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	_ = rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with response code: %d", rsp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package worker
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/md5"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// emptyPayloadHash is the sha256 of an empty request body
+const emptyPayloadHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
+// s3Storage uploads recordings to an S3 compatible object storage (e.g. MinIO or Ceph) using path style urls.
+// Objects are uploaded in a single PUT request, so they must be smaller than 5GB.
+type s3Storage struct {
+	endpoint  string // e.g. https://s3.example.com
+	bucket    string
+	region    string
+	accessKey string
+	secretKey string
+
+	client *http.Client
+}
+
+// Store uploads file with its MD5 checksum. The storage rejects the upload if the received data doesn't match.
+func (s *s3Storage) Store(ctx context.Context, file string, name string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	md5Sum, sha256Sum, size, err := hashFile(f)
+	if err != nil {
+		return err
+	}
+	if _, err = f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectURL(name), f)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = size
+	req.Header.Set("Content-Type", "video/mp4")
+	req.Header.Set("Content-MD5", base64.StdEncoding.EncodeToString(md5Sum))
+	resp, err := s.do(req, hex.EncodeToString(sha256Sum))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("upload failed with status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+// Verify compares size and ETag (the MD5 checksum for objects uploaded in a single request) of the stored object with file.
+func (s *s3Storage) Verify(ctx context.Context, file string, name string) error {
+	f, err := os.Open(file)
+	if err != nil {
+		return err
+	}
+	md5Sum, _, size, err := hashFile(f)
+	_ = f.Close()
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, s.objectURL(name), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("object not found, status %d", resp.StatusCode)
+	}
+	if length, _ := strconv.ParseInt(resp.Header.Get("Content-Length"), 10, 64); length != size {
+		return fmt.Errorf("%w: size mismatch: %d != %d", errCorrupt, length, size)
+	}
+	if etag := strings.Trim(resp.Header.Get("ETag"), `"`); etag != hex.EncodeToString(md5Sum) {
+		return fmt.Errorf("%w: etag mismatch: %s != %x", errCorrupt, etag, md5Sum)
+	}
+	return nil
+}
+
+// Delete removes the object stored as name. The storage also confirms the deletion of objects that don't exist.
+func (s *s3Storage) Delete(ctx context.Context, name string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectURL(name), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := s.do(req, emptyPayloadHash)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("deletion failed with status %d: %s", resp.StatusCode, body)
+	}
+	return nil
+}
+
+func (s *s3Storage) objectURL(name string) string {
+	return strings.TrimSuffix(s.endpoint, "/") + "/" + url.PathEscape(s.bucket) + "/" + url.PathEscape(name)
+}
+
+// do signs and sends req
+func (s *s3Storage) do(req *http.Request, payloadHash string) (*http.Response, error) {
+	s.sign(req, payloadHash, time.Now())
+	client := s.client
+	if client == nil {
+		client = &http.Client{Timeout: time.Hour}
+	}
+	return client.Do(req)
+}
+
+// sign adds an AWS signature version 4 to req, signing the host and all headers already set.
+// See https://docs.aws.amazon.com/AmazonS3/latest/API/sig-v4-header-based-auth.html
+func (s *s3Storage) sign(req *http.Request, payloadHash string, t time.Time) {
+	amzDate := t.UTC().Format("20060102T150405Z")
+	date := amzDate[:8]
+	req.Header.Set("x-amz-date", amzDate)
+	req.Header.Set("x-amz-content-sha256", payloadHash)
+
+	headers := map[string]string{"host": req.URL.Host}
+	for name, values := range req.Header {
+		headers[strings.ToLower(name)] = strings.TrimSpace(strings.Join(values, ","))
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + headers[name] + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.Query().Encode(),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	scope := date + "/" + s.region + "/s3/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" + sha256Hex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), date)
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, "s3")
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("AWS4-HMAC-SHA256 Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s.accessKey, scope, signedHeaders, signature))
+}
+
+// hashFile returns the md5 and sha256 checksums and the size of f in one pass
+func hashFile(f io.Reader) (md5Sum []byte, sha256Sum []byte, size int64, err error) {
+	m, sh := md5.New(), sha256.New()
+	size, err = io.Copy(io.MultiWriter(m, sh), f)
+	return m.Sum(nil), sh.Sum(nil), size, err
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(data))
+	return h.Sum(nil)
+}
+
+func sha256Hex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
@@ -2,6 +2,7 @@ package worker
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"os/exec"
 	"strings"
@@ -20,47 +21,52 @@ func stream(streamCtx *StreamContext) {
 		Info("streaming lecture hall")
 	S.startStream(streamCtx)
 	defer S.endStream(streamCtx)
+	// watch the signal for black or frozen slides and silence and report the stream's health while live
+	streamCtx.health = &streamHealth{}
+	monitorDone := make(chan struct{})
+	defer close(monitorDone)
+	go monitorSignal(streamCtx, monitorDone)
+	go reportStreamHealth(streamCtx, monitorDone)
 	// in case ffmpeg dies retry until stream should be done.
 	lastErr := time.Now().Add(time.Minute * -1)
 	errCount := 0
-	for time.Now().Before(streamUntil) && !streamCtx.stopped {
-		var cmd *exec.Cmd
-
-		// todo: too much duplication
-		if strings.Contains(streamCtx.sourceUrl, "rtsp") {
-			cmd = exec.Command(
-				"sh", "-c",
-				`ffmpeg -hide_banner -nostats -rtsp_transport tcp -t `+fmt.Sprintf("%.0f", time.Until(streamUntil).Seconds())+ // timeout ffmpeg when stream is finished
-					" -i "+fmt.Sprintf(streamCtx.sourceUrl)+
-					` -map 0 -c copy -f mpegts - -c:v libx264 -preset veryfast -tune zerolatency -maxrate 2500k -bufsize 3000k -g 60 -r 30 -x264-params keyint=60:scenecut=0 -c:a aac -ar 44100 -b:a 128k `+
-					`-f flv `+fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName)+" >> "+streamCtx.getRecordingFileName())
-		} else {
-			cmd = exec.Command(
-				"sh", "-c",
-				`ffmpeg -hide_banner -nostats -rw_timeout 5000000 -t `+fmt.Sprintf("%.0f", time.Until(streamUntil).Seconds())+ // timeout ffmpeg when stream is finished
-					" -i "+fmt.Sprintf(streamCtx.sourceUrl)+
-					` -map 0 -c copy -f mpegts - -c:v libx264 -preset veryfast -tune zerolatency -maxrate 2500k -bufsize 3000k -g 60 -r 30 -x264-params keyint=60:scenecut=0 -c:a aac -ar 44100 -b:a 128k `+
-					`-f flv `+fmt.Sprintf("%s/%s", streamCtx.ingestServer, streamCtx.streamName)+" >> "+streamCtx.getRecordingFileName())
+	for time.Now().Before(streamUntil) && !streamCtx.stopped.Load() {
+		cmd := exec.Command("ffmpeg", streamArgs(streamCtx, time.Until(streamUntil))...)
+		recording, err := os.OpenFile(streamCtx.getRecordingFileName(), os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
+		if err != nil {
+			errorWithBackoff(&lastErr, "Can't open recording", err)
+			continue
 		}
+		cmd.Stdout = recording
 		// persist stream command in context, so it can be killed later
 		streamCtx.streamCmd = cmd
 		log.WithField("cmd", cmd.String()).Info("Starting stream")
 		ffmpegErr, errFfmpegErrFile := os.OpenFile(fmt.Sprintf("%s/ffmpeg_%s.log", cfg.LogDir, streamCtx.getStreamName()), os.O_APPEND|os.O_WRONLY|os.O_CREATE, 0644)
 		if errFfmpegErrFile == nil {
-			cmd.Stderr = ffmpegErr
+			cmd.Stderr = newProgressWriter(streamCtx.health, ffmpegErr)
 		} else {
 			log.WithError(errFfmpegErrFile).Error("Could not create file for ffmpeg stdErr")
+			cmd.Stderr = newProgressWriter(streamCtx.health, io.Discard)
 		}
 		// Create a new pgid for the new process, so we don't kill the parent process when ending the stream
 		cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
-		err := cmd.Run()
-		if err != nil && !streamCtx.stopped {
+		err = cmd.Run()
+		_ = recording.Close()
+		if streamCtx.takingOver.CompareAndSwap(true, false) {
+			// ffmpeg was stopped to restart it with the ingest, the recording continues in the same file.
+			if errFfmpegErrFile == nil {
+				_ = ffmpegErr.Close()
+			}
+			continue
+		}
+		if err != nil && !streamCtx.stopped.Load() {
 			errCount++
 			if errCount > 20 && strings.Contains(streamCtx.sourceUrl, "localhost") {
 				// assume 20 seconds of inactivity by self - streamer as offline
-				streamCtx.stopped = true
+				streamCtx.stopped.Store(true)
 				return
 			}
+			streamCtx.health.restarted()
 			errorWithBackoff(&lastErr, "Error while streaming (run)", err)
 			if errFfmpegErrFile == nil {
 				_ = ffmpegErr.Close()
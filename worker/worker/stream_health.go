@@ -0,0 +1,144 @@
+package worker
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/joschahenningsen/TUM-Live/worker/cfg"
+	"github.com/joschahenningsen/TUM-Live/worker/pb"
+	log "github.com/sirupsen/logrus"
+)
+
+const healthReportInterval = time.Second * 30
+
+// streamHealth are the metrics of a running stream, parsed from ffmpeg's progress output
+type streamHealth struct {
+	mutex      sync.Mutex
+	bitrate    float64 // kbit/s
+	fps        float64
+	speed      float64
+	frames     uint64
+	dropped    uint64
+	duplicated uint64
+	restarts   uint32
+}
+
+// restarted counts a restart of ffmpeg and resets the metrics of the previous run
+func (h *streamHealth) restarted() {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	h.restarts++
+	h.bitrate, h.fps, h.speed = 0, 0, 0
+	h.frames, h.dropped, h.duplicated = 0, 0, 0
+}
+
+// update sets a metric from a key=value line of ffmpeg's -progress output and returns false if the key is unknown
+func (h *streamHealth) update(key string, value string) bool {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	value = strings.TrimSpace(value) // ffmpeg pads values, e.g. "bitrate= 512.3kbits/s"
+	switch key {
+	case "bitrate":
+		h.bitrate, _ = strconv.ParseFloat(strings.TrimSuffix(value, "kbits/s"), 64)
+	case "fps":
+		h.fps, _ = strconv.ParseFloat(value, 64)
+	case "speed":
+		h.speed, _ = strconv.ParseFloat(strings.TrimSuffix(value, "x"), 64)
+	case "frame":
+		h.frames, _ = strconv.ParseUint(value, 10, 64)
+	case "drop_frames":
+		h.dropped, _ = strconv.ParseUint(value, 10, 64)
+	case "dup_frames":
+		h.duplicated, _ = strconv.ParseUint(value, 10, 64)
+	case "stream_0_0_q", "total_size", "out_time_us", "out_time_ms", "out_time", "progress":
+		// known but not of interest
+	default:
+		return false
+	}
+	return true
+}
+
+// report returns the current metrics as report for TUM-Live
+func (h *streamHealth) report(streamCtx *StreamContext) *pb.StreamHealth {
+	h.mutex.Lock()
+	defer h.mutex.Unlock()
+	return &pb.StreamHealth{
+		WorkerID:         cfg.WorkerID,
+		StreamID:         streamCtx.streamId,
+		SourceType:       streamCtx.streamVersion,
+		Bitrate:          h.bitrate,
+		FPS:              h.fps,
+		Speed:            h.speed,
+		Frames:           h.frames,
+		DroppedFrames:    h.dropped,
+		DuplicatedFrames: h.duplicated,
+		Restarts:         h.restarts,
+	}
+}
+
+// progressWriter takes ffmpeg's stderr with -progress pipe:2, updates the health with progress lines
+// and writes all other lines to out.
+type progressWriter struct {
+	health *streamHealth
+	out    io.Writer
+	buf    []byte
+}
+
+func newProgressWriter(health *streamHealth, out io.Writer) *progressWriter {
+	return &progressWriter{health: health, out: out}
+}
+
+func (w *progressWriter) Write(p []byte) (int, error) {
+	w.buf = append(w.buf, p...)
+	for {
+		i := bytes.IndexByte(w.buf, '\n')
+		if i == -1 {
+			break
+		}
+		line := w.buf[:i+1]
+		w.buf = w.buf[i+1:]
+		if key, value, ok := strings.Cut(strings.TrimSpace(string(line)), "="); ok && !strings.Contains(key, " ") && w.health.update(key, value) {
+			continue
+		}
+		if _, err := w.out.Write(line); err != nil {
+			return len(p), err
+		}
+	}
+	return len(p), nil
+}
+
+// reportStreamHealth sends the metrics of a stream to TUM-Live periodically until done is closed
+func reportStreamHealth(streamCtx *StreamContext, done <-chan struct{}) {
+	ticker := time.NewTicker(healthReportInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-done:
+			return
+		case <-ticker.C:
+		}
+		if streamCtx.standby.Load() || streamCtx.stopped.Load() {
+			continue
+		}
+		notifyStreamHealth(streamCtx.health.report(streamCtx))
+	}
+}
+
+func notifyStreamHealth(health *pb.StreamHealth) {
+	client, conn, err := GetClient()
+	if err != nil {
+		log.WithError(err).Error("Unable to dial tumlive")
+		return
+	}
+	defer closeConnection(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err = client.NotifyStreamHealth(ctx, health); err != nil {
+		log.WithError(err).Error("Could not send stream health")
+	}
+}
@@ -0,0 +1,48 @@
+package worker
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestProgressWriter(t *testing.T) {
+	health := &streamHealth{}
+	var log bytes.Buffer
+	w := newProgressWriter(health, &log)
+
+	output := "[rtsp @ 0x5600] method SETUP failed: 461 Unsupported transport\n" +
+		"frame=1800\nfps=29.97\nstream_0_0_q=-1.0\nbitrate=2510.4kbits/s\ntotal_size=18874368\n" +
+		"out_time_us=60000000\ndup_frames=2\ndrop_frames=7\nspeed=1.01x\nprogress=continue\n" +
+		"Input #0, rtsp, from 'rtsp://10.0.0.1/extron3':\n"
+	// ffmpeg doesn't write whole lines at once
+	for _, chunk := range []string{output[:20], output[20:95], output[95:]} {
+		if _, err := w.Write([]byte(chunk)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expectedLog := "[rtsp @ 0x5600] method SETUP failed: 461 Unsupported transport\nInput #0, rtsp, from 'rtsp://10.0.0.1/extron3':\n"
+	if log.String() != expectedLog {
+		t.Errorf("log = %q, want %q", log.String(), expectedLog)
+	}
+	report := health.report(&StreamContext{streamId: 3, streamVersion: "PRES"})
+	if report.Bitrate != 2510.4 || report.FPS != 29.97 || report.Speed != 1.01 || report.Frames != 1800 ||
+		report.DroppedFrames != 7 || report.DuplicatedFrames != 2 || report.StreamID != 3 || report.SourceType != "PRES" {
+		t.Errorf("unexpected report %+v", report)
+	}
+
+	// ffmpeg left-pads values to a fixed width
+	if _, err := w.Write([]byte("frame= 1860\nfps= 5.0\nbitrate= 512.3kbits/s\nspeed=0.998x\nprogress=continue\n")); err != nil {
+		t.Fatal(err)
+	}
+	report = health.report(&StreamContext{})
+	if report.Bitrate != 512.3 || report.FPS != 5 || report.Speed != 0.998 || report.Frames != 1860 {
+		t.Errorf("padded values not parsed: %+v", report)
+	}
+
+	health.restarted()
+	report = health.report(&StreamContext{})
+	if report.Restarts != 1 || report.Bitrate != 0 || report.DroppedFrames != 0 {
+		t.Errorf("metrics not reset after restart: %+v", report)
+	}
+}
@@ -15,7 +15,7 @@ import (
 	"time"
 )
 
-func buildCommand(niceness int, infile string, outfile string, tune string, crf int) *exec.Cmd {
+func buildCommand(niceness int, infile string, outfile string, tune string, crf int, audioFilter []string) *exec.Cmd {
 	c := []string{
 		"-n", fmt.Sprintf("%d", niceness),
 		"ffmpeg", "-nostats", "-loglevel", "error", "-y",
@@ -25,6 +25,7 @@ func buildCommand(niceness int, infile string, outfile string, tune string, crf
 	if tune != "" {
 		c = append(c, "-tune", tune)
 	}
+	c = append(c, audioFilter...)
 	c = append(c, "-c:a", "aac", "-b:a", "128k", "-crf", fmt.Sprintf("%d", crf), outfile)
 	return exec.Command("nice", c...)
 }
@@ -55,7 +56,6 @@ func transcode(streamCtx *StreamContext) error {
 	if err != nil {
 		return err
 	}
-	var cmd *exec.Cmd
 	// create command fitting its content with appropriate niceness:
 	in := streamCtx.getRecordingFileName()
 	inputTime, err := getDuration(in)
@@ -63,19 +63,35 @@ func transcode(streamCtx *StreamContext) error {
 		inputTime = 1
 	}
 
+	if streamCtx.normalizeLoudness {
+		measured, err := measureLoudness(in, streamCtx.denoise)
+		if err != nil {
+			log.WithError(err).WithField("stream", streamCtx.getStreamName()).Warn("Can't measure loudness")
+		}
+		streamCtx.loudness = measured
+	}
+	af := audioFilterArgs(streamCtx)
+
 	out := streamCtx.getTranscodingFileName()
+	var niceness, crf int
+	var tune string
 	switch streamCtx.streamVersion {
 	case "CAM":
 		// compress camera image slightly more
-		cmd = buildCommand(10, in, out, "", 26)
+		niceness, tune, crf = 10, "", 26
 	case "PRES":
-		cmd = buildCommand(9, in, out, "stillimage", 20)
+		niceness, tune, crf = 9, "stillimage", 20
 	case "COMB":
-		cmd = buildCommand(8, in, out, "", 24)
+		niceness, tune, crf = 8, "", 24
 	default:
 		//unknown source, use higher compression and less priority
-		cmd = buildCommand(10, in, out, "", 26)
+		niceness, tune, crf = 10, "", 26
+	}
+	// the encoding profile of the course or lecture hall overrides the quality
+	if streamCtx.encoding.GetCRF() != 0 {
+		crf = int(streamCtx.encoding.GetCRF())
 	}
+	cmd := buildCommand(niceness, in, out, tune, crf, af)
 	log.WithFields(log.Fields{"input": in, "output": out, "command": cmd.String()}).Info("Transcoding")
 	streamCtx.transcodingCmd = cmd
 	stderr, err := cmd.StderrPipe()
@@ -196,8 +212,8 @@ func reportProgress(stream *StreamContext, p chan int32) error {
 }
 
 func transcodeAudio(ctx *StreamContext) error {
-	S.startTranscodingAudio(ctx.getStreamName())
-	defer S.endTranscodingAudio(ctx.getStreamName())
+	S.startTranscodingAudio(ctx)
+	defer S.endTranscodingAudio(ctx)
 
 	input := ctx.getTranscodingFileName()
 	output := ctx.getAudioTranscodingFileName()
@@ -1,6 +1,8 @@
 package worker
 
 import (
+	"context"
+	"fmt"
 	"github.com/joschahenningsen/TUM-Live/worker/cfg"
 	log "github.com/sirupsen/logrus"
 	"io"
@@ -11,13 +13,125 @@ import (
 	"time"
 )
 
-func upload(streamCtx *StreamContext) {
+// PendingUpload is a vod that isn't uploaded yet. Pending uploads are persisted, so they are continued after a
+// restart of the worker.
+type PendingUpload struct {
+	StreamID     uint32
+	SourceType   string
+	File         string // File is the transcoded recording
+	Name         string // Name is the name of the vod in the storage
+	HLSUrl       string
+	DashUrl      string
+	ThumbnailUrl string
+	Attempts     int
+	Stored       bool // Stored is true once the file was stored, later attempts only verify it
+}
+
+func newPendingUpload(streamCtx *StreamContext) PendingUpload {
+	u := PendingUpload{
+		StreamID:     streamCtx.streamId,
+		SourceType:   streamCtx.streamVersion,
+		File:         streamCtx.getTranscodingFileName(),
+		Name:         streamCtx.getStreamNameVoD() + ".mp4",
+		HLSUrl:       fmt.Sprintf(cfg.VodURLTemplate, streamCtx.getStreamNameVoD()),
+		ThumbnailUrl: streamCtx.thumbnailSpritePath,
+	}
+	if cfg.DashURLTemplate != "" {
+		u.DashUrl = fmt.Sprintf(cfg.DashURLTemplate, streamCtx.getStreamNameVoD())
+	}
+	return u
+}
+
+// upload uploads the transcoded recording of streamCtx to the vod storage. If the upload still fails after all
+// retries, the failure is reported to TUM-Live and an error is returned.
+func upload(streamCtx *StreamContext) error {
 	log.WithField("stream", streamCtx.getStreamName()).Info("Uploading stream")
-	err := post(streamCtx.getTranscodingFileName())
+	u := newPendingUpload(streamCtx)
+	if pending, ok := persisted.getPendingUpload(u.File); ok {
+		// resumed job, continue with the attempts made before the restart
+		u.Attempts, u.Stored = pending.Attempts, pending.Stored
+	} else if err := persisted.AddPendingUpload(u); err != nil {
+		log.WithError(err).Warn("Can't persist pending upload")
+	}
+	return runUpload(u)
+}
+
+// resumePendingUploads continues uploads that were interrupted by a restart of the worker
+func resumePendingUploads() {
+	persisted.mutex.Lock()
+	pending := append([]PendingUpload{}, persisted.PendingUploads...)
+	persisted.mutex.Unlock()
+	for _, u := range pending {
+		if hasUploadJob(u.File) {
+			continue // resumed by the job
+		}
+		log.WithFields(log.Fields{"stream": u.StreamID, "file": u.File}).Info("Resuming upload")
+		go func(u PendingUpload) {
+			if runUpload(u) == nil {
+				notifyUploadFinished(u)
+			}
+		}(u)
+	}
+}
+
+// hasUploadJob returns true if a persisted job will upload file
+func hasUploadJob(file string) bool {
+	persisted.mutex.Lock()
+	defer persisted.mutex.Unlock()
+	for _, job := range persisted.Jobs {
+		for _, stage := range job.Stages {
+			if stage == StageUpload && job.streamContext().getTranscodingFileName() == file {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// runUpload uploads u and removes it from the pending uploads once it succeeded or ultimately failed. The upload is in
+// flight until then, so a draining worker isn't stopped before the vod is published.
+func runUpload(u PendingUpload) error {
+	S.startUpload(u)
+	defer S.endUpload(u)
+	storage, err := newVodStorage()
+	if err == nil {
+		err = uploadWithRetry(context.Background(), storage, &u)
+	}
+	if rmErr := persisted.RemovePendingUpload(u.File); rmErr != nil {
+		log.WithError(rmErr).Warn("Can't remove pending upload")
+	}
 	if err != nil {
-		log.WithField("stream", streamCtx.getStreamName()).WithError(err).Error("Error uploading stream")
+		log.WithFields(log.Fields{"stream": u.StreamID, "file": u.File}).WithError(err).Error("Error uploading stream")
+		notifyUploadFailure(u, err)
+		return err
+	}
+	log.WithFields(log.Fields{"stream": u.StreamID, "file": u.File}).Info("Uploaded stream")
+	return nil
+}
+
+// uploadWithRetry stores and verifies u, retrying with exponential backoff up to storeAttempts times in total.
+// Once the file is stored, failed attempts only verify it again instead of uploading it another time, unless the stored
+// object is corrupt.
+func uploadWithRetry(ctx context.Context, storage VodStorage, u *PendingUpload) error {
+	for {
+		u.Attempts++
+		err := storeAndVerify(ctx, storage, u)
+		if err == nil {
+			return nil
+		}
+		log.WithError(err).WithFields(log.Fields{"file": u.File, "attempt": u.Attempts}).Warn("Uploading vod failed")
+		if u.Attempts >= storeAttempts {
+			return err
+		}
+		if err = persisted.UpdatePendingUpload(*u); err != nil {
+			log.WithError(err).Warn("Can't persist pending upload")
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(storeBackoff(u.Attempts)):
+		}
 	}
-	log.WithField("stream", streamCtx.getStreamName()).Info("Uploaded stream")
 }
 
 func post(file string) error {
@@ -54,17 +168,28 @@ func post(file string) error {
 			}
 		}
 	}()
-	rsp, err := client.Post(cfg.LrzUploadUrl, writer.FormDataContentType(), r)
-	if err == nil && rsp.StatusCode != http.StatusOK {
-		log.Error("Request failed with response code: ", rsp.StatusCode)
+	req, err := http.NewRequest(http.MethodPost, cfg.LrzUploadUrl, r)
+	if err != nil {
+		_ = r.Close() // stops the writer
+		return err
 	}
-	if err == nil && rsp != nil {
-		all, err := io.ReadAll(rsp.Body)
-		if err == nil {
-			log.WithField("fileUploaded", file).Debug(string(all))
-		}
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	if cfg.LrzUploadToken != "" {
+		req.Header.Set("Authorization", "Bearer "+cfg.LrzUploadToken)
 	}
-	return err
+	rsp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer rsp.Body.Close()
+	all, err := io.ReadAll(rsp.Body)
+	if err == nil {
+		log.WithField("fileUploaded", file).Debug(string(all))
+	}
+	if rsp.StatusCode != http.StatusOK {
+		return fmt.Errorf("request failed with response code: %d", rsp.StatusCode)
+	}
+	return nil
 }
 
 func writeField(writer *multipart.Writer, name string, value string) error {
@@ -1,11 +1,14 @@
 package worker
 
 import (
+	"context"
+	"errors"
 	"github.com/joschahenningsen/TUM-Live/worker/cfg"
 	"net/http"
 	"os"
 	"sync"
 	"testing"
+	"time"
 )
 
 func TestUpload(t *testing.T) {
@@ -66,6 +69,135 @@ func TestUpload(t *testing.T) {
 	finished.Wait()
 }
 
+// flakyStorage fails the first failures calls of Store and the first verifyFailures calls of Verify
+type flakyStorage struct {
+	failures       int
+	verifyFailures int
+	stored         int
+}
+
+func (s *flakyStorage) Store(ctx context.Context, file string, name string) error {
+	if s.failures > 0 {
+		s.failures--
+		return errors.New("connection reset")
+	}
+	s.stored++
+	return nil
+}
+
+func (s *flakyStorage) Delete(ctx context.Context, name string) error {
+	return nil
+}
+
+func (s *flakyStorage) Verify(ctx context.Context, file string, name string) error {
+	if s.verifyFailures > 0 {
+		s.verifyFailures--
+		return errors.New("playlist not found")
+	}
+	return nil
+}
+
+func setupPersistable(t *testing.T) {
+	cfg.PersistDir = t.TempDir()
+	var err error
+	persisted, err = NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestUploadWithRetry(t *testing.T) {
+	setupPersistable(t)
+	storeRetryDelay = time.Millisecond
+	defer func() { storeRetryDelay = time.Second * 30 }()
+
+	u := PendingUpload{StreamID: 1, File: "/mass/test.mp4", Name: "test.mp4"}
+	if err := persisted.AddPendingUpload(u); err != nil {
+		t.Fatal(err)
+	}
+	storage := &flakyStorage{failures: 2}
+	if err := uploadWithRetry(context.Background(), storage, &u); err != nil {
+		t.Fatal(err)
+	}
+	if u.Attempts != 3 || storage.stored != 1 {
+		t.Fatalf("unexpected attempts: %d, stored: %d", u.Attempts, storage.stored)
+	}
+	// attempts are persisted, so they count after a restart
+	reloaded, err := NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.PendingUploads) != 1 || reloaded.PendingUploads[0].Attempts != 3 {
+		t.Fatalf("unexpected pending uploads: %v", reloaded.PendingUploads)
+	}
+
+	u = PendingUpload{StreamID: 2, File: "/mass/failing.mp4", Name: "failing.mp4"}
+	if err = uploadWithRetry(context.Background(), &flakyStorage{failures: storeAttempts}, &u); err == nil {
+		t.Fatal("upload should fail after all attempts")
+	}
+	if u.Attempts != storeAttempts {
+		t.Fatalf("unexpected attempts: %d", u.Attempts)
+	}
+}
+
+func TestUploadWithRetryStoresOnce(t *testing.T) {
+	setupPersistable(t)
+	storeRetryDelay = time.Millisecond
+	defer func() { storeRetryDelay = time.Second * 30 }()
+
+	u := PendingUpload{StreamID: 1, File: "/mass/test.mp4", Name: "test.mp4"}
+	if err := persisted.AddPendingUpload(u); err != nil {
+		t.Fatal(err)
+	}
+	storage := &flakyStorage{failures: 1, verifyFailures: 2}
+	if err := uploadWithRetry(context.Background(), storage, &u); err != nil {
+		t.Fatal(err)
+	}
+	// a failed verification must not upload the file again
+	if u.Attempts != 4 || storage.stored != 1 {
+		t.Fatalf("unexpected attempts: %d, stored: %d", u.Attempts, storage.stored)
+	}
+	// after a restart only the verification is resumed
+	reloaded, err := NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.PendingUploads) != 1 || !reloaded.PendingUploads[0].Stored {
+		t.Fatalf("unexpected pending uploads: %v", reloaded.PendingUploads)
+	}
+}
+
+func TestStoreBackoff(t *testing.T) {
+	if d := storeBackoff(1); d != storeRetryDelay {
+		t.Fatalf("unexpected first backoff: %v", d)
+	}
+	if d := storeBackoff(3); d != storeRetryDelay*4 {
+		t.Fatalf("unexpected third backoff: %v", d)
+	}
+	if d := storeBackoff(100); d != storeMaxRetryDelay {
+		t.Fatalf("backoff should be capped: %v", d)
+	}
+}
+
+func TestPersistPendingUploads(t *testing.T) {
+	setupPersistable(t)
+	for _, f := range []string{"/mass/a.mp4", "/mass/b.mp4"} {
+		if err := persisted.AddPendingUpload(PendingUpload{File: f}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := persisted.RemovePendingUpload("/mass/a.mp4"); err != nil {
+		t.Fatal(err)
+	}
+	reloaded, err := NewPersistable()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reloaded.PendingUploads) != 1 || reloaded.PendingUploads[0].File != "/mass/b.mp4" {
+		t.Fatalf("unexpected pending uploads: %v", reloaded.PendingUploads)
+	}
+}
+
 func createDummyFile(filesize uint) (string, error) {
 	file, err := os.CreateTemp("/tmp", "recording")
 	if err != nil {
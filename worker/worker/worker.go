@@ -25,6 +25,9 @@ func Setup() {
 	if err != nil {
 		log.WithError(err).Fatal("Failed to create persistable")
 	}
+	resumePendingUploads()
+	resumeJobs()
+	resumePendingArchivals()
 
 	c := cron.New()
 	_, _ = c.AddFunc("* * * * *", S.SendHeartbeat)
@@ -40,7 +43,7 @@ func Setup() {
 		for i, deletable := range persisted.Deletable {
 			if time.Since(deletable.Time) >= time.Hour*24 {
 				err := os.Remove(deletable.File)
-				if err != nil {
+				if err != nil && !os.IsNotExist(err) { // e.g. deleted by the retention policy of the course
 					log.WithError(err).Error("Failed to delete old recording")
 				}
 			} else {